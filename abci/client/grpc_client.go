@@ -331,6 +331,44 @@ func (cli *grpcClient) ProcessProposalAsync(
 	)
 }
 
+func (cli *grpcClient) ExtendVoteAsync(
+	params types.RequestExtendVote,
+) *ReqRes {
+
+	req := types.ToRequestExtendVote(params)
+	res, err := cli.client.ExtendVote(context.Background(), req.GetExtendVote(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(
+		req,
+		&types.Response{
+			Value: &types.Response_ExtendVote{
+				ExtendVote: res,
+			},
+		},
+	)
+}
+
+func (cli *grpcClient) VerifyVoteExtensionAsync(
+	params types.RequestVerifyVoteExtension,
+) *ReqRes {
+
+	req := types.ToRequestVerifyVoteExtension(params)
+	res, err := cli.client.VerifyVoteExtension(context.Background(), req.GetVerifyVoteExtension(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(
+		req,
+		&types.Response{
+			Value: &types.Response_VerifyVoteExtension{
+				VerifyVoteExtension: res,
+			},
+		},
+	)
+}
+
 // finishAsyncCall creates a ReqRes for an async call, and immediately populates it
 // with the response. We don't complete it until it's been ordered via the channel.
 func (cli *grpcClient) finishAsyncCall(req *types.Request, res *types.Response) *ReqRes {
@@ -459,3 +497,17 @@ func (cli *grpcClient) ProcessProposalSync(
 	reqres := cli.ProcessProposalAsync(params)
 	return cli.finishSyncCall(reqres).GetProcessProposal(), cli.Error()
 }
+
+func (cli *grpcClient) ExtendVoteSync(
+	params types.RequestExtendVote,
+) (*types.ResponseExtendVote, error) {
+	reqres := cli.ExtendVoteAsync(params)
+	return cli.finishSyncCall(reqres).GetExtendVote(), cli.Error()
+}
+
+func (cli *grpcClient) VerifyVoteExtensionSync(
+	params types.RequestVerifyVoteExtension,
+) (*types.ResponseVerifyVoteExtension, error) {
+	reqres := cli.VerifyVoteExtensionAsync(params)
+	return cli.finishSyncCall(reqres).GetVerifyVoteExtension(), cli.Error()
+}
@@ -222,6 +222,32 @@ func (app *localClient) ProcessProposalAsync(
 	)
 }
 
+func (app *localClient) ExtendVoteAsync(
+	req types.RequestExtendVote,
+) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.ExtendVote(req)
+	return app.callback(
+		types.ToRequestExtendVote(req),
+		types.ToResponseExtendVote(res),
+	)
+}
+
+func (app *localClient) VerifyVoteExtensionAsync(
+	req types.RequestVerifyVoteExtension,
+) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.VerifyVoteExtension(req)
+	return app.callback(
+		types.ToRequestVerifyVoteExtension(req),
+		types.ToResponseVerifyVoteExtension(res),
+	)
+}
+
 //-------------------------------------------------------
 
 func (app *localClient) FlushSync() error {
@@ -351,6 +377,26 @@ func (app *localClient) ProcessProposalSync(
 	return &res, nil
 }
 
+func (app *localClient) ExtendVoteSync(
+	req types.RequestExtendVote,
+) (*types.ResponseExtendVote, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.ExtendVote(req)
+	return &res, nil
+}
+
+func (app *localClient) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension,
+) (*types.ResponseVerifyVoteExtension, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.VerifyVoteExtension(req)
+	return &res, nil
+}
+
 //-------------------------------------------------------
 
 func (app *localClient) callback(req *types.Request, res *types.Response) *ReqRes {
@@ -287,6 +287,18 @@ func (cli *socketClient) ProcessProposalAsync(
 	return cli.queueRequest(types.ToRequestProcessProposal(req))
 }
 
+func (cli *socketClient) ExtendVoteAsync(
+	req types.RequestExtendVote,
+) *ReqRes {
+	return cli.queueRequest(types.ToRequestExtendVote(req))
+}
+
+func (cli *socketClient) VerifyVoteExtensionAsync(
+	req types.RequestVerifyVoteExtension,
+) *ReqRes {
+	return cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+}
+
 func (cli *socketClient) FlushSync() error {
 	reqRes := cli.queueRequest(types.ToRequestFlush())
 	if err := cli.Error(); err != nil {
@@ -436,6 +448,28 @@ func (cli *socketClient) ProcessProposalSync(
 	return reqres.Response.GetProcessProposal(), nil
 }
 
+func (cli *socketClient) ExtendVoteSync(
+	req types.RequestExtendVote,
+) (*types.ResponseExtendVote, error) {
+
+	reqres := cli.queueRequest(types.ToRequestExtendVote(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+	return reqres.Response.GetExtendVote(), nil
+}
+
+func (cli *socketClient) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension,
+) (*types.ResponseVerifyVoteExtension, error) {
+
+	reqres := cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+	return reqres.Response.GetVerifyVoteExtension(), nil
+}
+
 //----------------------------------------
 
 func (cli *socketClient) queueRequest(req *types.Request) *ReqRes {
@@ -513,6 +547,10 @@ func resMatchesReq(req *types.Request, res *types.Response) (ok bool) {
 		_, ok = res.Value.(*types.Response_PrepareProposal)
 	case *types.Request_ProcessProposal:
 		_, ok = res.Value.(*types.Response_ProcessProposal)
+	case *types.Request_ExtendVote:
+		_, ok = res.Value.(*types.Response_ExtendVote)
+	case *types.Request_VerifyVoteExtension:
+		_, ok = res.Value.(*types.Response_VerifyVoteExtension)
 	}
 	return ok
 }
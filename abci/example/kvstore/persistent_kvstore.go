@@ -179,6 +179,16 @@ func (app *PersistentKVStoreApplication) ProcessProposal(
 	return types.ResponseProcessProposal{Status: types.ResponseProcessProposal_ACCEPT}
 }
 
+func (app *PersistentKVStoreApplication) ExtendVote(
+	req types.RequestExtendVote) types.ResponseExtendVote {
+	return app.app.ExtendVote(req)
+}
+
+func (app *PersistentKVStoreApplication) VerifyVoteExtension(
+	req types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension {
+	return app.app.VerifyVoteExtension(req)
+}
+
 //---------------------------------------------
 // update validators
 
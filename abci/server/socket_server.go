@@ -233,6 +233,12 @@ func (s *SocketServer) handleRequest(req *types.Request, responses chan<- *types
 	case *types.Request_ProcessProposal:
 		res := s.app.ProcessProposal(*r.ProcessProposal)
 		responses <- types.ToResponseProcessProposal(res)
+	case *types.Request_ExtendVote:
+		res := s.app.ExtendVote(*r.ExtendVote)
+		responses <- types.ToResponseExtendVote(res)
+	case *types.Request_VerifyVoteExtension:
+		res := s.app.VerifyVoteExtension(*r.VerifyVoteExtension)
+		responses <- types.ToResponseVerifyVoteExtension(res)
 	case *types.Request_LoadSnapshotChunk:
 		res := s.app.LoadSnapshotChunk(*r.LoadSnapshotChunk)
 		responses <- types.ToResponseLoadSnapshotChunk(res)
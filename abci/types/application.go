@@ -22,6 +22,8 @@ type Application interface {
 	InitChain(RequestInitChain) ResponseInitChain // Initialize blockchain w validators/other info from CometBFT
 	PrepareProposal(RequestPrepareProposal) ResponsePrepareProposal
 	ProcessProposal(RequestProcessProposal) ResponseProcessProposal
+	ExtendVote(RequestExtendVote) ResponseExtendVote
+	VerifyVoteExtension(RequestVerifyVoteExtension) ResponseVerifyVoteExtension
 	BeginBlock(RequestBeginBlock) ResponseBeginBlock // Signals the beginning of a block
 	DeliverTx(RequestDeliverTx) ResponseDeliverTx    // Deliver a tx for full processing
 	EndBlock(RequestEndBlock) ResponseEndBlock       // Signals the end of a block, returns changes to the validator set
@@ -104,6 +106,14 @@ func (BaseApplication) ProcessProposal(req RequestProcessProposal) ResponseProce
 	return ResponseProcessProposal{Status: ResponseProcessProposal_ACCEPT}
 }
 
+func (BaseApplication) ExtendVote(req RequestExtendVote) ResponseExtendVote {
+	return ResponseExtendVote{}
+}
+
+func (BaseApplication) VerifyVoteExtension(req RequestVerifyVoteExtension) ResponseVerifyVoteExtension {
+	return ResponseVerifyVoteExtension{Status: ResponseVerifyVoteExtension_ACCEPT}
+}
+
 //-------------------------------------------------------
 
 // GRPCApplication is a GRPC wrapper for Application
@@ -198,3 +208,15 @@ func (app *GRPCApplication) ProcessProposal(
 	res := app.app.ProcessProposal(*req)
 	return &res, nil
 }
+
+func (app *GRPCApplication) ExtendVote(
+	ctx context.Context, req *RequestExtendVote) (*ResponseExtendVote, error) {
+	res := app.app.ExtendVote(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) VerifyVoteExtension(
+	ctx context.Context, req *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error) {
+	res := app.app.VerifyVoteExtension(*req)
+	return &res, nil
+}
@@ -57,6 +57,16 @@ func (r ResponseProcessProposal) IsRejected() bool {
 	return r.Status == ResponseProcessProposal_REJECT
 }
 
+// IsAccepted returns true if this ResponseVerifyVoteExtension was accepted
+func (r ResponseVerifyVoteExtension) IsAccepted() bool {
+	return r.Status == ResponseVerifyVoteExtension_ACCEPT
+}
+
+// IsRejected returns true if this ResponseVerifyVoteExtension was rejected
+func (r ResponseVerifyVoteExtension) IsRejected() bool {
+	return r.Status == ResponseVerifyVoteExtension_REJECT
+}
+
 //---------------------------------------------------------------------------
 // override JSON marshaling so we emit defaults (ie. disable omitempty)
 
@@ -96,6 +96,7 @@ const (
 	ResponseOfferSnapshot_REJECT        ResponseOfferSnapshot_Result = 3
 	ResponseOfferSnapshot_REJECT_FORMAT ResponseOfferSnapshot_Result = 4
 	ResponseOfferSnapshot_REJECT_SENDER ResponseOfferSnapshot_Result = 5
+	ResponseOfferSnapshot_RESUME        ResponseOfferSnapshot_Result = 6
 )
 
 var ResponseOfferSnapshot_Result_name = map[int32]string{
@@ -105,6 +106,7 @@ var ResponseOfferSnapshot_Result_name = map[int32]string{
 	3: "REJECT",
 	4: "REJECT_FORMAT",
 	5: "REJECT_SENDER",
+	6: "RESUME",
 }
 
 var ResponseOfferSnapshot_Result_value = map[string]int32{
@@ -114,6 +116,7 @@ var ResponseOfferSnapshot_Result_value = map[string]int32{
 	"REJECT":        3,
 	"REJECT_FORMAT": 4,
 	"REJECT_SENDER": 5,
+	"RESUME":        6,
 }
 
 func (x ResponseOfferSnapshot_Result) String() string {
@@ -121,7 +124,7 @@ func (x ResponseOfferSnapshot_Result) String() string {
 }
 
 func (ResponseOfferSnapshot_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{30, 0}
+	return fileDescriptor_53387b996e042afb, []int{32, 0}
 }
 
 type ResponseApplySnapshotChunk_Result int32
@@ -158,7 +161,7 @@ func (x ResponseApplySnapshotChunk_Result) String() string {
 }
 
 func (ResponseApplySnapshotChunk_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{32, 0}
+	return fileDescriptor_53387b996e042afb, []int{34, 0}
 }
 
 type ResponseProcessProposal_ProposalStatus int32
@@ -186,7 +189,38 @@ func (x ResponseProcessProposal_ProposalStatus) String() string {
 }
 
 func (ResponseProcessProposal_ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{34, 0}
+	return fileDescriptor_53387b996e042afb, []int{36, 0}
+}
+
+type ResponseVerifyVoteExtension_VerifyStatus int32
+
+const (
+	ResponseVerifyVoteExtension_UNKNOWN ResponseVerifyVoteExtension_VerifyStatus = 0
+	ResponseVerifyVoteExtension_ACCEPT  ResponseVerifyVoteExtension_VerifyStatus = 1
+	// Rejecting the vote extension drops the precommit carrying it. This
+	// should only happen if the extension was not produced by a correct
+	// validator, as it may otherwise prevent the chain from making progress.
+	ResponseVerifyVoteExtension_REJECT ResponseVerifyVoteExtension_VerifyStatus = 2
+)
+
+var ResponseVerifyVoteExtension_VerifyStatus_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACCEPT",
+	2: "REJECT",
+}
+
+var ResponseVerifyVoteExtension_VerifyStatus_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ACCEPT":  1,
+	"REJECT":  2,
+}
+
+func (x ResponseVerifyVoteExtension_VerifyStatus) String() string {
+	return proto.EnumName(ResponseVerifyVoteExtension_VerifyStatus_name, int32(x))
+}
+
+func (ResponseVerifyVoteExtension_VerifyStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_53387b996e042afb, []int{38, 0}
 }
 
 type Request struct {
@@ -207,6 +241,8 @@ type Request struct {
 	//	*Request_ApplySnapshotChunk
 	//	*Request_PrepareProposal
 	//	*Request_ProcessProposal
+	//	*Request_ExtendVote
+	//	*Request_VerifyVoteExtension
 	Value isRequest_Value `protobuf_oneof:"value"`
 }
 
@@ -297,23 +333,31 @@ type Request_PrepareProposal struct {
 type Request_ProcessProposal struct {
 	ProcessProposal *RequestProcessProposal `protobuf:"bytes,17,opt,name=process_proposal,json=processProposal,proto3,oneof" json:"process_proposal,omitempty"`
 }
-
-func (*Request_Echo) isRequest_Value()               {}
-func (*Request_Flush) isRequest_Value()              {}
-func (*Request_Info) isRequest_Value()               {}
-func (*Request_InitChain) isRequest_Value()          {}
-func (*Request_Query) isRequest_Value()              {}
-func (*Request_BeginBlock) isRequest_Value()         {}
-func (*Request_CheckTx) isRequest_Value()            {}
-func (*Request_DeliverTx) isRequest_Value()          {}
-func (*Request_EndBlock) isRequest_Value()           {}
-func (*Request_Commit) isRequest_Value()             {}
-func (*Request_ListSnapshots) isRequest_Value()      {}
-func (*Request_OfferSnapshot) isRequest_Value()      {}
-func (*Request_LoadSnapshotChunk) isRequest_Value()  {}
-func (*Request_ApplySnapshotChunk) isRequest_Value() {}
-func (*Request_PrepareProposal) isRequest_Value()    {}
-func (*Request_ProcessProposal) isRequest_Value()    {}
+type Request_ExtendVote struct {
+	ExtendVote *RequestExtendVote `protobuf:"bytes,18,opt,name=extend_vote,json=extendVote,proto3,oneof" json:"extend_vote,omitempty"`
+}
+type Request_VerifyVoteExtension struct {
+	VerifyVoteExtension *RequestVerifyVoteExtension `protobuf:"bytes,19,opt,name=verify_vote_extension,json=verifyVoteExtension,proto3,oneof" json:"verify_vote_extension,omitempty"`
+}
+
+func (*Request_Echo) isRequest_Value()                {}
+func (*Request_Flush) isRequest_Value()               {}
+func (*Request_Info) isRequest_Value()                {}
+func (*Request_InitChain) isRequest_Value()           {}
+func (*Request_Query) isRequest_Value()               {}
+func (*Request_BeginBlock) isRequest_Value()          {}
+func (*Request_CheckTx) isRequest_Value()             {}
+func (*Request_DeliverTx) isRequest_Value()           {}
+func (*Request_EndBlock) isRequest_Value()            {}
+func (*Request_Commit) isRequest_Value()              {}
+func (*Request_ListSnapshots) isRequest_Value()       {}
+func (*Request_OfferSnapshot) isRequest_Value()       {}
+func (*Request_LoadSnapshotChunk) isRequest_Value()   {}
+func (*Request_ApplySnapshotChunk) isRequest_Value()  {}
+func (*Request_PrepareProposal) isRequest_Value()     {}
+func (*Request_ProcessProposal) isRequest_Value()     {}
+func (*Request_ExtendVote) isRequest_Value()          {}
+func (*Request_VerifyVoteExtension) isRequest_Value() {}
 
 func (m *Request) GetValue() isRequest_Value {
 	if m != nil {
@@ -434,6 +478,20 @@ func (m *Request) GetProcessProposal() *RequestProcessProposal {
 	return nil
 }
 
+func (m *Request) GetExtendVote() *RequestExtendVote {
+	if x, ok := m.GetValue().(*Request_ExtendVote); ok {
+		return x.ExtendVote
+	}
+	return nil
+}
+
+func (m *Request) GetVerifyVoteExtension() *RequestVerifyVoteExtension {
+	if x, ok := m.GetValue().(*Request_VerifyVoteExtension); ok {
+		return x.VerifyVoteExtension
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Request) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -453,6 +511,8 @@ func (*Request) XXX_OneofWrappers() []interface{} {
 		(*Request_ApplySnapshotChunk)(nil),
 		(*Request_PrepareProposal)(nil),
 		(*Request_ProcessProposal)(nil),
+		(*Request_ExtendVote)(nil),
+		(*Request_VerifyVoteExtension)(nil),
 	}
 }
 
@@ -1418,9 +1478,133 @@ func (m *RequestProcessProposal) GetProposerAddress() []byte {
 	return nil
 }
 
+// Extends a precommit for a block with application-injected data.
+type RequestExtendVote struct {
+	// the hash of the block that the precommit is for
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the height of the precommit
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestExtendVote) Reset()         { *m = RequestExtendVote{} }
+func (m *RequestExtendVote) String() string { return proto.CompactTextString(m) }
+func (*RequestExtendVote) ProtoMessage()    {}
+func (*RequestExtendVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53387b996e042afb, []int{17}
+}
+func (m *RequestExtendVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestExtendVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestExtendVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestExtendVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestExtendVote.Merge(m, src)
+}
+func (m *RequestExtendVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestExtendVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestExtendVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestExtendVote proto.InternalMessageInfo
+
+func (m *RequestExtendVote) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RequestExtendVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Verifies a vote extension of another validator.
+type RequestVerifyVoteExtension struct {
+	// the hash of the block that the precommit is for
+	Hash             []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ValidatorAddress []byte `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	VoteExtension    []byte `protobuf:"bytes,4,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+}
+
+func (m *RequestVerifyVoteExtension) Reset()         { *m = RequestVerifyVoteExtension{} }
+func (m *RequestVerifyVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*RequestVerifyVoteExtension) ProtoMessage()    {}
+func (*RequestVerifyVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53387b996e042afb, []int{18}
+}
+func (m *RequestVerifyVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestVerifyVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestVerifyVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestVerifyVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestVerifyVoteExtension.Merge(m, src)
+}
+func (m *RequestVerifyVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestVerifyVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestVerifyVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestVerifyVoteExtension proto.InternalMessageInfo
+
+func (m *RequestVerifyVoteExtension) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RequestVerifyVoteExtension) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *RequestVerifyVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RequestVerifyVoteExtension) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
 type Response struct {
 	// Types that are valid to be assigned to Value:
-	//
 	//	*Response_Exception
 	//	*Response_Echo
 	//	*Response_Flush
@@ -1438,6 +1622,8 @@ type Response struct {
 	//	*Response_ApplySnapshotChunk
 	//	*Response_PrepareProposal
 	//	*Response_ProcessProposal
+	//	*Response_ExtendVote
+	//	*Response_VerifyVoteExtension
 	Value isResponse_Value `protobuf_oneof:"value"`
 }
 
@@ -1445,7 +1631,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{17}
+	return fileDescriptor_53387b996e042afb, []int{19}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1531,24 +1717,32 @@ type Response_PrepareProposal struct {
 type Response_ProcessProposal struct {
 	ProcessProposal *ResponseProcessProposal `protobuf:"bytes,18,opt,name=process_proposal,json=processProposal,proto3,oneof" json:"process_proposal,omitempty"`
 }
-
-func (*Response_Exception) isResponse_Value()          {}
-func (*Response_Echo) isResponse_Value()               {}
-func (*Response_Flush) isResponse_Value()              {}
-func (*Response_Info) isResponse_Value()               {}
-func (*Response_InitChain) isResponse_Value()          {}
-func (*Response_Query) isResponse_Value()              {}
-func (*Response_BeginBlock) isResponse_Value()         {}
-func (*Response_CheckTx) isResponse_Value()            {}
-func (*Response_DeliverTx) isResponse_Value()          {}
-func (*Response_EndBlock) isResponse_Value()           {}
-func (*Response_Commit) isResponse_Value()             {}
-func (*Response_ListSnapshots) isResponse_Value()      {}
-func (*Response_OfferSnapshot) isResponse_Value()      {}
-func (*Response_LoadSnapshotChunk) isResponse_Value()  {}
-func (*Response_ApplySnapshotChunk) isResponse_Value() {}
-func (*Response_PrepareProposal) isResponse_Value()    {}
-func (*Response_ProcessProposal) isResponse_Value()    {}
+type Response_ExtendVote struct {
+	ExtendVote *ResponseExtendVote `protobuf:"bytes,19,opt,name=extend_vote,json=extendVote,proto3,oneof" json:"extend_vote,omitempty"`
+}
+type Response_VerifyVoteExtension struct {
+	VerifyVoteExtension *ResponseVerifyVoteExtension `protobuf:"bytes,20,opt,name=verify_vote_extension,json=verifyVoteExtension,proto3,oneof" json:"verify_vote_extension,omitempty"`
+}
+
+func (*Response_Exception) isResponse_Value()           {}
+func (*Response_Echo) isResponse_Value()                {}
+func (*Response_Flush) isResponse_Value()               {}
+func (*Response_Info) isResponse_Value()                {}
+func (*Response_InitChain) isResponse_Value()           {}
+func (*Response_Query) isResponse_Value()               {}
+func (*Response_BeginBlock) isResponse_Value()          {}
+func (*Response_CheckTx) isResponse_Value()             {}
+func (*Response_DeliverTx) isResponse_Value()           {}
+func (*Response_EndBlock) isResponse_Value()            {}
+func (*Response_Commit) isResponse_Value()              {}
+func (*Response_ListSnapshots) isResponse_Value()       {}
+func (*Response_OfferSnapshot) isResponse_Value()       {}
+func (*Response_LoadSnapshotChunk) isResponse_Value()   {}
+func (*Response_ApplySnapshotChunk) isResponse_Value()  {}
+func (*Response_PrepareProposal) isResponse_Value()     {}
+func (*Response_ProcessProposal) isResponse_Value()     {}
+func (*Response_ExtendVote) isResponse_Value()          {}
+func (*Response_VerifyVoteExtension) isResponse_Value() {}
 
 func (m *Response) GetValue() isResponse_Value {
 	if m != nil {
@@ -1676,6 +1870,20 @@ func (m *Response) GetProcessProposal() *ResponseProcessProposal {
 	return nil
 }
 
+func (m *Response) GetExtendVote() *ResponseExtendVote {
+	if x, ok := m.GetValue().(*Response_ExtendVote); ok {
+		return x.ExtendVote
+	}
+	return nil
+}
+
+func (m *Response) GetVerifyVoteExtension() *ResponseVerifyVoteExtension {
+	if x, ok := m.GetValue().(*Response_VerifyVoteExtension); ok {
+		return x.VerifyVoteExtension
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Response) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1696,6 +1904,8 @@ func (*Response) XXX_OneofWrappers() []interface{} {
 		(*Response_ApplySnapshotChunk)(nil),
 		(*Response_PrepareProposal)(nil),
 		(*Response_ProcessProposal)(nil),
+		(*Response_ExtendVote)(nil),
+		(*Response_VerifyVoteExtension)(nil),
 	}
 }
 
@@ -1708,7 +1918,7 @@ func (m *ResponseException) Reset()         { *m = ResponseException{} }
 func (m *ResponseException) String() string { return proto.CompactTextString(m) }
 func (*ResponseException) ProtoMessage()    {}
 func (*ResponseException) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{18}
+	return fileDescriptor_53387b996e042afb, []int{20}
 }
 func (m *ResponseException) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1962,7 @@ func (m *ResponseEcho) Reset()         { *m = ResponseEcho{} }
 func (m *ResponseEcho) String() string { return proto.CompactTextString(m) }
 func (*ResponseEcho) ProtoMessage()    {}
 func (*ResponseEcho) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{19}
+	return fileDescriptor_53387b996e042afb, []int{21}
 }
 func (m *ResponseEcho) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1795,7 +2005,7 @@ func (m *ResponseFlush) Reset()         { *m = ResponseFlush{} }
 func (m *ResponseFlush) String() string { return proto.CompactTextString(m) }
 func (*ResponseFlush) ProtoMessage()    {}
 func (*ResponseFlush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{20}
+	return fileDescriptor_53387b996e042afb, []int{22}
 }
 func (m *ResponseFlush) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +2046,7 @@ func (m *ResponseInfo) Reset()         { *m = ResponseInfo{} }
 func (m *ResponseInfo) String() string { return proto.CompactTextString(m) }
 func (*ResponseInfo) ProtoMessage()    {}
 func (*ResponseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{21}
+	return fileDescriptor_53387b996e042afb, []int{23}
 }
 func (m *ResponseInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1910,7 +2120,7 @@ func (m *ResponseInitChain) Reset()         { *m = ResponseInitChain{} }
 func (m *ResponseInitChain) String() string { return proto.CompactTextString(m) }
 func (*ResponseInitChain) ProtoMessage()    {}
 func (*ResponseInitChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{22}
+	return fileDescriptor_53387b996e042afb, []int{24}
 }
 func (m *ResponseInitChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1977,7 +2187,7 @@ func (m *ResponseQuery) Reset()         { *m = ResponseQuery{} }
 func (m *ResponseQuery) String() string { return proto.CompactTextString(m) }
 func (*ResponseQuery) ProtoMessage()    {}
 func (*ResponseQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{23}
+	return fileDescriptor_53387b996e042afb, []int{25}
 }
 func (m *ResponseQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2077,7 +2287,7 @@ func (m *ResponseBeginBlock) Reset()         { *m = ResponseBeginBlock{} }
 func (m *ResponseBeginBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseBeginBlock) ProtoMessage()    {}
 func (*ResponseBeginBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{24}
+	return fileDescriptor_53387b996e042afb, []int{26}
 }
 func (m *ResponseBeginBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2343,7 @@ func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
 func (m *ResponseCheckTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseCheckTx) ProtoMessage()    {}
 func (*ResponseCheckTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{25}
+	return fileDescriptor_53387b996e042afb, []int{27}
 }
 func (m *ResponseCheckTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2254,7 +2464,7 @@ func (m *ResponseDeliverTx) Reset()         { *m = ResponseDeliverTx{} }
 func (m *ResponseDeliverTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseDeliverTx) ProtoMessage()    {}
 func (*ResponseDeliverTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{26}
+	return fileDescriptor_53387b996e042afb, []int{28}
 }
 func (m *ResponseDeliverTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2349,7 +2559,7 @@ func (m *ResponseEndBlock) Reset()         { *m = ResponseEndBlock{} }
 func (m *ResponseEndBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseEndBlock) ProtoMessage()    {}
 func (*ResponseEndBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{27}
+	return fileDescriptor_53387b996e042afb, []int{29}
 }
 func (m *ResponseEndBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2409,7 +2619,7 @@ func (m *ResponseCommit) Reset()         { *m = ResponseCommit{} }
 func (m *ResponseCommit) String() string { return proto.CompactTextString(m) }
 func (*ResponseCommit) ProtoMessage()    {}
 func (*ResponseCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{28}
+	return fileDescriptor_53387b996e042afb, []int{30}
 }
 func (m *ResponseCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2460,7 +2670,7 @@ func (m *ResponseListSnapshots) Reset()         { *m = ResponseListSnapshots{} }
 func (m *ResponseListSnapshots) String() string { return proto.CompactTextString(m) }
 func (*ResponseListSnapshots) ProtoMessage()    {}
 func (*ResponseListSnapshots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{29}
+	return fileDescriptor_53387b996e042afb, []int{31}
 }
 func (m *ResponseListSnapshots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2504,7 +2714,7 @@ func (m *ResponseOfferSnapshot) Reset()         { *m = ResponseOfferSnapshot{} }
 func (m *ResponseOfferSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ResponseOfferSnapshot) ProtoMessage()    {}
 func (*ResponseOfferSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{30}
+	return fileDescriptor_53387b996e042afb, []int{32}
 }
 func (m *ResponseOfferSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2548,7 +2758,7 @@ func (m *ResponseLoadSnapshotChunk) Reset()         { *m = ResponseLoadSnapshotC
 func (m *ResponseLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseLoadSnapshotChunk) ProtoMessage()    {}
 func (*ResponseLoadSnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{31}
+	return fileDescriptor_53387b996e042afb, []int{33}
 }
 func (m *ResponseLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2594,7 +2804,7 @@ func (m *ResponseApplySnapshotChunk) Reset()         { *m = ResponseApplySnapsho
 func (m *ResponseApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseApplySnapshotChunk) ProtoMessage()    {}
 func (*ResponseApplySnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{32}
+	return fileDescriptor_53387b996e042afb, []int{34}
 }
 func (m *ResponseApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2655,7 +2865,7 @@ func (m *ResponsePrepareProposal) Reset()         { *m = ResponsePrepareProposal
 func (m *ResponsePrepareProposal) String() string { return proto.CompactTextString(m) }
 func (*ResponsePrepareProposal) ProtoMessage()    {}
 func (*ResponsePrepareProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{33}
+	return fileDescriptor_53387b996e042afb, []int{35}
 }
 func (m *ResponsePrepareProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +2909,7 @@ func (m *ResponseProcessProposal) Reset()         { *m = ResponseProcessProposal
 func (m *ResponseProcessProposal) String() string { return proto.CompactTextString(m) }
 func (*ResponseProcessProposal) ProtoMessage()    {}
 func (*ResponseProcessProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{34}
+	return fileDescriptor_53387b996e042afb, []int{36}
 }
 func (m *ResponseProcessProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2735,6 +2945,94 @@ func (m *ResponseProcessProposal) GetStatus() ResponseProcessProposal_ProposalSt
 	return ResponseProcessProposal_UNKNOWN
 }
 
+type ResponseExtendVote struct {
+	VoteExtension []byte `protobuf:"bytes,1,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+}
+
+func (m *ResponseExtendVote) Reset()         { *m = ResponseExtendVote{} }
+func (m *ResponseExtendVote) String() string { return proto.CompactTextString(m) }
+func (*ResponseExtendVote) ProtoMessage()    {}
+func (*ResponseExtendVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53387b996e042afb, []int{37}
+}
+func (m *ResponseExtendVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseExtendVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseExtendVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseExtendVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseExtendVote.Merge(m, src)
+}
+func (m *ResponseExtendVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseExtendVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseExtendVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseExtendVote proto.InternalMessageInfo
+
+func (m *ResponseExtendVote) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
+type ResponseVerifyVoteExtension struct {
+	Status ResponseVerifyVoteExtension_VerifyStatus `protobuf:"varint,1,opt,name=status,proto3,enum=celestiacore.abci.ResponseVerifyVoteExtension_VerifyStatus" json:"status,omitempty"`
+}
+
+func (m *ResponseVerifyVoteExtension) Reset()         { *m = ResponseVerifyVoteExtension{} }
+func (m *ResponseVerifyVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*ResponseVerifyVoteExtension) ProtoMessage()    {}
+func (*ResponseVerifyVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53387b996e042afb, []int{38}
+}
+func (m *ResponseVerifyVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseVerifyVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseVerifyVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseVerifyVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseVerifyVoteExtension.Merge(m, src)
+}
+func (m *ResponseVerifyVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseVerifyVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseVerifyVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseVerifyVoteExtension proto.InternalMessageInfo
+
+func (m *ResponseVerifyVoteExtension) GetStatus() ResponseVerifyVoteExtension_VerifyStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseVerifyVoteExtension_UNKNOWN
+}
+
 type CommitInfo struct {
 	Round int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
 	Votes []VoteInfo `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
@@ -2744,7 +3042,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{35}
+	return fileDescriptor_53387b996e042afb, []int{39}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2799,7 +3097,7 @@ func (m *ExtendedCommitInfo) Reset()         { *m = ExtendedCommitInfo{} }
 func (m *ExtendedCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedCommitInfo) ProtoMessage()    {}
 func (*ExtendedCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{36}
+	return fileDescriptor_53387b996e042afb, []int{40}
 }
 func (m *ExtendedCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2854,7 +3152,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{37}
+	return fileDescriptor_53387b996e042afb, []int{41}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2908,7 +3206,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{38}
+	return fileDescriptor_53387b996e042afb, []int{42}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2972,7 +3270,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{39}
+	return fileDescriptor_53387b996e042afb, []int{43}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3338,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{40}
+	return fileDescriptor_53387b996e042afb, []int{44}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3093,7 +3391,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{41}
+	return fileDescriptor_53387b996e042afb, []int{45}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3146,7 +3444,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{42}
+	return fileDescriptor_53387b996e042afb, []int{46}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3199,7 +3497,7 @@ func (m *ExtendedVoteInfo) Reset()         { *m = ExtendedVoteInfo{} }
 func (m *ExtendedVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedVoteInfo) ProtoMessage()    {}
 func (*ExtendedVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{43}
+	return fileDescriptor_53387b996e042afb, []int{47}
 }
 func (m *ExtendedVoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3267,7 +3565,7 @@ func (m *Misbehavior) Reset()         { *m = Misbehavior{} }
 func (m *Misbehavior) String() string { return proto.CompactTextString(m) }
 func (*Misbehavior) ProtoMessage()    {}
 func (*Misbehavior) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{44}
+	return fileDescriptor_53387b996e042afb, []int{48}
 }
 func (m *Misbehavior) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3641,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53387b996e042afb, []int{45}
+	return fileDescriptor_53387b996e042afb, []int{49}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3413,6 +3711,7 @@ func init() {
 	proto.RegisterEnum("celestiacore.abci.ResponseOfferSnapshot_Result", ResponseOfferSnapshot_Result_name, ResponseOfferSnapshot_Result_value)
 	proto.RegisterEnum("celestiacore.abci.ResponseApplySnapshotChunk_Result", ResponseApplySnapshotChunk_Result_name, ResponseApplySnapshotChunk_Result_value)
 	proto.RegisterEnum("celestiacore.abci.ResponseProcessProposal_ProposalStatus", ResponseProcessProposal_ProposalStatus_name, ResponseProcessProposal_ProposalStatus_value)
+	proto.RegisterEnum("celestiacore.abci.ResponseVerifyVoteExtension_VerifyStatus", ResponseVerifyVoteExtension_VerifyStatus_name, ResponseVerifyVoteExtension_VerifyStatus_value)
 	proto.RegisterType((*Request)(nil), "celestiacore.abci.Request")
 	proto.RegisterType((*RequestEcho)(nil), "celestiacore.abci.RequestEcho")
 	proto.RegisterType((*RequestFlush)(nil), "celestiacore.abci.RequestFlush")
@@ -3430,6 +3729,8 @@ func init() {
 	proto.RegisterType((*RequestApplySnapshotChunk)(nil), "celestiacore.abci.RequestApplySnapshotChunk")
 	proto.RegisterType((*RequestPrepareProposal)(nil), "celestiacore.abci.RequestPrepareProposal")
 	proto.RegisterType((*RequestProcessProposal)(nil), "celestiacore.abci.RequestProcessProposal")
+	proto.RegisterType((*RequestExtendVote)(nil), "celestiacore.abci.RequestExtendVote")
+	proto.RegisterType((*RequestVerifyVoteExtension)(nil), "celestiacore.abci.RequestVerifyVoteExtension")
 	proto.RegisterType((*Response)(nil), "celestiacore.abci.Response")
 	proto.RegisterType((*ResponseException)(nil), "celestiacore.abci.ResponseException")
 	proto.RegisterType((*ResponseEcho)(nil), "celestiacore.abci.ResponseEcho")
@@ -3448,6 +3749,8 @@ func init() {
 	proto.RegisterType((*ResponseApplySnapshotChunk)(nil), "celestiacore.abci.ResponseApplySnapshotChunk")
 	proto.RegisterType((*ResponsePrepareProposal)(nil), "celestiacore.abci.ResponsePrepareProposal")
 	proto.RegisterType((*ResponseProcessProposal)(nil), "celestiacore.abci.ResponseProcessProposal")
+	proto.RegisterType((*ResponseExtendVote)(nil), "celestiacore.abci.ResponseExtendVote")
+	proto.RegisterType((*ResponseVerifyVoteExtension)(nil), "celestiacore.abci.ResponseVerifyVoteExtension")
 	proto.RegisterType((*CommitInfo)(nil), "celestiacore.abci.CommitInfo")
 	proto.RegisterType((*ExtendedCommitInfo)(nil), "celestiacore.abci.ExtendedCommitInfo")
 	proto.RegisterType((*Event)(nil), "celestiacore.abci.Event")
@@ -3464,197 +3767,208 @@ func init() {
 func init() { proto.RegisterFile("celestiacore/abci/types.proto", fileDescriptor_53387b996e042afb) }
 
 var fileDescriptor_53387b996e042afb = []byte{
-	// 3025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x6f, 0x23, 0xc7,
-	0xf1, 0xe7, 0xfb, 0x51, 0x7c, 0xaa, 0x57, 0x5e, 0xd3, 0xfc, 0xdb, 0x92, 0x3c, 0xfb, 0x77, 0xbc,
-	0xbb, 0xb6, 0xa5, 0x44, 0xb1, 0xe3, 0xb5, 0x8d, 0xc4, 0x21, 0xb9, 0xf4, 0x52, 0x2b, 0xad, 0xa4,
-	0x1d, 0x71, 0x25, 0x38, 0x46, 0x3c, 0x3b, 0x24, 0x5b, 0xe2, 0x58, 0xe4, 0xcc, 0x78, 0xa6, 0xa9,
-	0xa5, 0x82, 0x9c, 0x72, 0x09, 0x62, 0xf8, 0x60, 0xe4, 0x18, 0xc4, 0xc8, 0x21, 0x87, 0x7c, 0x85,
-	0x00, 0xb9, 0xe4, 0xe8, 0xa3, 0x8f, 0x39, 0x39, 0x81, 0x7d, 0xcb, 0x07, 0x08, 0x90, 0x5b, 0xd0,
-	0x8f, 0x79, 0x51, 0x7c, 0x0c, 0xbd, 0x41, 0x80, 0x20, 0xb7, 0xee, 0x9a, 0xaa, 0xea, 0xee, 0xea,
-	0xee, 0xaa, 0xfa, 0xd5, 0x34, 0xbc, 0xd0, 0xc5, 0x03, 0x6c, 0x13, 0x4d, 0xed, 0x1a, 0x16, 0xde,
-	0x52, 0x3b, 0x5d, 0x6d, 0x8b, 0x5c, 0x9a, 0xd8, 0xde, 0x34, 0x2d, 0x83, 0x18, 0x68, 0xc5, 0xff,
-	0x79, 0x93, 0x7e, 0xae, 0xae, 0x07, 0x24, 0xba, 0xd6, 0xa5, 0x49, 0x8c, 0x2d, 0xd3, 0x32, 0x8c,
-	0x53, 0x2e, 0x53, 0x5d, 0x0b, 0x30, 0x30, 0x6d, 0x7e, 0x9d, 0x13, 0xdf, 0x85, 0x82, 0x73, 0x7c,
-	0xe9, 0x7c, 0x5f, 0x9f, 0x22, 0x6f, 0xaa, 0x96, 0x3a, 0x74, 0x19, 0xce, 0x0c, 0xe3, 0x6c, 0x80,
-	0xb7, 0x58, 0xaf, 0x33, 0x3a, 0xdd, 0x22, 0xda, 0x10, 0xdb, 0x44, 0x1d, 0x9a, 0x82, 0x61, 0xf5,
-	0xcc, 0x38, 0x33, 0x58, 0x73, 0x8b, 0xb6, 0x38, 0x55, 0xfa, 0x5d, 0x16, 0xd2, 0x32, 0xfe, 0x78,
-	0x84, 0x6d, 0x82, 0x5e, 0x87, 0x04, 0xee, 0xf6, 0x8d, 0x4a, 0x74, 0x23, 0x7a, 0x33, 0xb7, 0xbd,
-	0xb6, 0x79, 0x65, 0x99, 0x9b, 0x82, 0xb3, 0xd9, 0xed, 0x1b, 0xad, 0x88, 0xcc, 0xb8, 0xd1, 0x9b,
-	0x90, 0x3c, 0x1d, 0x8c, 0xec, 0x7e, 0x25, 0xc6, 0xc4, 0xd6, 0x67, 0x8b, 0xbd, 0x47, 0xd9, 0x5a,
-	0x11, 0x99, 0xf3, 0xd3, 0xe1, 0x34, 0xfd, 0xd4, 0xa8, 0xc4, 0x17, 0x0d, 0xb7, 0xa3, 0x9f, 0xb2,
-	0xe1, 0x28, 0x37, 0xba, 0x0b, 0xa0, 0xe9, 0x1a, 0x51, 0xba, 0x7d, 0x55, 0xd3, 0x2b, 0x49, 0x26,
-	0x7b, 0x63, 0x9e, 0xac, 0x46, 0x1a, 0x94, 0xb5, 0x15, 0x91, 0xb3, 0x9a, 0xd3, 0xa1, 0x93, 0xfe,
-	0x78, 0x84, 0xad, 0xcb, 0x4a, 0x6a, 0xd1, 0xa4, 0x1f, 0x52, 0x36, 0x3a, 0x69, 0xc6, 0x8f, 0xee,
-	0x41, 0xae, 0x83, 0xcf, 0x34, 0x5d, 0xe9, 0x0c, 0x8c, 0xee, 0x79, 0x25, 0xcd, 0xc4, 0xff, 0x7f,
-	0xb6, 0x78, 0x9d, 0x32, 0xd7, 0x29, 0x6f, 0x2b, 0x22, 0x43, 0xc7, 0xed, 0xa1, 0x1f, 0x41, 0xa6,
-	0xdb, 0xc7, 0xdd, 0x73, 0x85, 0x8c, 0x2b, 0x19, 0xa6, 0xe5, 0xc5, 0xd9, 0x5a, 0x1a, 0x94, 0xb3,
-	0x3d, 0x6e, 0x45, 0xe4, 0x74, 0x97, 0x37, 0xa9, 0x1d, 0x7a, 0x78, 0xa0, 0x5d, 0x60, 0x8b, 0x6a,
-	0xc8, 0x2e, 0xb2, 0xc3, 0x5d, 0xce, 0xcb, 0x74, 0x64, 0x7b, 0x4e, 0x07, 0xd5, 0x20, 0x8b, 0xf5,
-	0x9e, 0x58, 0x0c, 0x30, 0x25, 0xd2, 0x9c, 0x7d, 0xd7, 0x7b, 0xce, 0x52, 0x32, 0x58, 0xb4, 0xd1,
-	0xdb, 0x90, 0xea, 0x1a, 0xc3, 0xa1, 0x46, 0x2a, 0x39, 0x26, 0xbf, 0x31, 0x67, 0x19, 0x8c, 0xaf,
-	0x15, 0x91, 0x85, 0x04, 0x3a, 0x84, 0xe2, 0x40, 0xb3, 0x89, 0x62, 0xeb, 0xaa, 0x69, 0xf7, 0x0d,
-	0x62, 0x57, 0xf2, 0x4c, 0xc7, 0xcb, 0xb3, 0x75, 0xec, 0x69, 0x36, 0x39, 0x72, 0xd8, 0x5b, 0x11,
-	0xb9, 0x30, 0xf0, 0x13, 0xa8, 0x46, 0xe3, 0xf4, 0x14, 0x5b, 0xae, 0xca, 0x4a, 0x61, 0x91, 0xc6,
-	0x03, 0xca, 0xef, 0x68, 0xa0, 0x1a, 0x0d, 0x3f, 0x01, 0xfd, 0x14, 0xae, 0x0d, 0x0c, 0xb5, 0xe7,
-	0x2a, 0x54, 0xba, 0xfd, 0x91, 0x7e, 0x5e, 0x29, 0x32, 0xb5, 0xaf, 0xcc, 0x99, 0xa8, 0xa1, 0xf6,
-	0x1c, 0x25, 0x0d, 0x2a, 0xd2, 0x8a, 0xc8, 0x2b, 0x83, 0x49, 0x22, 0x7a, 0x0c, 0xab, 0xaa, 0x69,
-	0x0e, 0x2e, 0x27, 0xf5, 0x97, 0x98, 0xfe, 0x57, 0x67, 0xeb, 0xaf, 0x51, 0xa9, 0xc9, 0x01, 0x90,
-	0x7a, 0x85, 0x8a, 0x8e, 0xa1, 0x6c, 0x5a, 0xd8, 0x54, 0x2d, 0xac, 0x98, 0x96, 0x61, 0x1a, 0xb6,
-	0x3a, 0xa8, 0x94, 0x99, 0xf6, 0x5b, 0xb3, 0xb5, 0x1f, 0x72, 0x89, 0x43, 0x21, 0xd0, 0x8a, 0xc8,
-	0x25, 0x33, 0x48, 0xe2, 0x7a, 0x8d, 0x2e, 0xb6, 0x6d, 0x4f, 0xef, 0xca, 0x62, 0xbd, 0x4c, 0x22,
-	0xa8, 0x37, 0x40, 0xaa, 0xa7, 0x21, 0x79, 0xa1, 0x0e, 0x46, 0xf8, 0x7e, 0x22, 0x93, 0x28, 0x27,
-	0xa5, 0x97, 0x21, 0xe7, 0x73, 0x3b, 0xa8, 0x02, 0xe9, 0x21, 0xb6, 0x6d, 0xf5, 0x0c, 0x33, 0x3f,
-	0x95, 0x95, 0x9d, 0xae, 0x54, 0x84, 0xbc, 0xdf, 0xd1, 0x48, 0x9f, 0x45, 0x5d, 0x49, 0xea, 0x41,
-	0xa8, 0xe4, 0x05, 0xb6, 0x6c, 0xcd, 0xd0, 0x1d, 0x49, 0xd1, 0x45, 0x37, 0xa0, 0xc0, 0x6e, 0x80,
-	0xe2, 0x7c, 0xa7, 0xae, 0x2c, 0x21, 0xe7, 0x19, 0xf1, 0x58, 0x30, 0xad, 0x43, 0xce, 0xdc, 0x36,
-	0x5d, 0x96, 0x38, 0x63, 0x01, 0x73, 0xdb, 0x74, 0x18, 0x5e, 0x84, 0x3c, 0x5d, 0xa9, 0xcb, 0x91,
-	0x60, 0x83, 0xe4, 0x28, 0x4d, 0xb0, 0x48, 0x5f, 0xc6, 0xa0, 0x3c, 0xe9, 0x98, 0xd0, 0x1d, 0x48,
-	0x50, 0x5f, 0x2d, 0xdc, 0x6e, 0x75, 0x93, 0x3b, 0xf2, 0x4d, 0xc7, 0x91, 0x6f, 0xb6, 0x1d, 0x47,
-	0x5e, 0xcf, 0x7c, 0xf1, 0xd5, 0x7a, 0xe4, 0xb3, 0xbf, 0xae, 0x47, 0x65, 0x26, 0x81, 0x9e, 0xa3,
-	0x3e, 0x44, 0xd5, 0x74, 0x45, 0xeb, 0xb1, 0x29, 0x67, 0xa9, 0x7b, 0x50, 0x35, 0x7d, 0xa7, 0x87,
-	0xf6, 0xa1, 0xdc, 0x35, 0x74, 0x1b, 0xeb, 0xf6, 0xc8, 0x56, 0x78, 0xa0, 0x10, 0x8e, 0x76, 0xc2,
-	0x49, 0xf0, 0x20, 0xd4, 0x70, 0x78, 0x0f, 0x19, 0xab, 0x5c, 0xea, 0x06, 0x09, 0xa8, 0x05, 0x70,
-	0xa1, 0x0e, 0xb4, 0x9e, 0x4a, 0x0c, 0xcb, 0xae, 0x24, 0x36, 0xe2, 0x33, 0x3c, 0xc5, 0xb1, 0xc3,
-	0xf4, 0xc8, 0xec, 0xa9, 0x04, 0xd7, 0x13, 0x74, 0xca, 0xb2, 0x4f, 0x16, 0x7d, 0x07, 0x4a, 0xaa,
-	0x69, 0x2a, 0x36, 0x51, 0x09, 0x56, 0x3a, 0x97, 0x04, 0xdb, 0xcc, 0x8b, 0xe7, 0xe5, 0x82, 0x6a,
-	0x9a, 0x47, 0x94, 0x5a, 0xa7, 0x44, 0xf4, 0x12, 0x14, 0xa9, 0xbf, 0xd6, 0xd4, 0x81, 0xd2, 0xc7,
-	0xda, 0x59, 0x9f, 0x30, 0x5f, 0x1d, 0x97, 0x0b, 0x82, 0xda, 0x62, 0x44, 0xa9, 0xe7, 0xee, 0x3a,
-	0xf3, 0xd4, 0x08, 0x41, 0xa2, 0xa7, 0x12, 0x95, 0x59, 0x33, 0x2f, 0xb3, 0x36, 0xa5, 0x99, 0x2a,
-	0xe9, 0x0b, 0x1b, 0xb1, 0x36, 0xba, 0x0e, 0x29, 0xa1, 0x36, 0xce, 0xd4, 0x8a, 0x1e, 0x5a, 0x85,
-	0xa4, 0x69, 0x19, 0x17, 0x98, 0x6d, 0x5f, 0x46, 0xe6, 0x1d, 0xe9, 0x57, 0x31, 0x58, 0xb9, 0xe2,
-	0xd1, 0xa9, 0xde, 0xbe, 0x6a, 0xf7, 0x9d, 0xb1, 0x68, 0x1b, 0xdd, 0xa1, 0x7a, 0xd5, 0x1e, 0xb6,
-	0x44, 0x3c, 0xac, 0x4e, 0x33, 0x77, 0x8b, 0x71, 0x08, 0xe3, 0x08, 0x7e, 0xf4, 0x00, 0xca, 0x03,
-	0xd5, 0x26, 0x0a, 0xf7, 0x8d, 0x8a, 0x2f, 0x36, 0xbe, 0x30, 0xc5, 0xd0, 0xdc, 0x97, 0xd2, 0x83,
-	0x2d, 0xd4, 0x14, 0xa9, 0xb0, 0x47, 0x45, 0x27, 0xb0, 0xda, 0xb9, 0xfc, 0x99, 0xaa, 0x13, 0x4d,
-	0xc7, 0xca, 0x95, 0xbd, 0x9b, 0x16, 0x6e, 0x1f, 0x68, 0x76, 0x07, 0xf7, 0xd5, 0x0b, 0xcd, 0x70,
-	0xa6, 0x76, 0xcd, 0xd5, 0xe0, 0xee, 0xab, 0x2d, 0xb5, 0xa1, 0x18, 0x0c, 0x4b, 0xa8, 0x08, 0x31,
-	0x32, 0x16, 0x56, 0x88, 0x91, 0x31, 0xda, 0x86, 0x04, 0x5d, 0x27, 0xb3, 0x40, 0x71, 0xea, 0x50,
-	0x42, 0xb2, 0x7d, 0x69, 0x62, 0x99, 0xf1, 0x4a, 0x92, 0x7b, 0x33, 0xdc, 0x50, 0x35, 0xa9, 0x57,
-	0xba, 0x05, 0xa5, 0x89, 0x48, 0xe4, 0xdb, 0xc6, 0xa8, 0x7f, 0x1b, 0xa5, 0x12, 0x14, 0x02, 0x41,
-	0x47, 0xba, 0x0e, 0xab, 0xd3, 0x22, 0x88, 0xf4, 0x91, 0x4b, 0x0f, 0xc4, 0x01, 0xf4, 0x26, 0x64,
-	0xdc, 0x10, 0xc2, 0x6f, 0xe6, 0xff, 0x4d, 0x59, 0x87, 0xc3, 0x2e, 0xbb, 0xcc, 0xf4, 0x52, 0xd2,
-	0xf3, 0xcd, 0x0e, 0x46, 0x8c, 0x4d, 0x3d, 0xad, 0x9a, 0x66, 0x4b, 0xb5, 0xfb, 0xd2, 0x63, 0xa8,
-	0xcc, 0x0a, 0x0e, 0x13, 0x0b, 0x49, 0xb8, 0xe7, 0xf1, 0x3a, 0xa4, 0x4e, 0x0d, 0x6b, 0xa8, 0x12,
-	0xa6, 0xac, 0x20, 0x8b, 0x1e, 0x3d, 0xa7, 0x3c, 0x50, 0xc4, 0x19, 0x99, 0x77, 0x24, 0x05, 0x9e,
-	0x9b, 0x19, 0x1e, 0xa8, 0x88, 0xa6, 0xf7, 0x30, 0xb7, 0x68, 0x41, 0xe6, 0x1d, 0x4f, 0x11, 0x9f,
-	0x2c, 0xef, 0xd0, 0x61, 0x6d, 0xac, 0xd3, 0x63, 0x1c, 0x67, 0x97, 0x46, 0xf4, 0xa4, 0xdf, 0xc6,
-	0xe1, 0xfa, 0xf4, 0x10, 0x81, 0x36, 0x20, 0x3f, 0x54, 0xc7, 0x0a, 0x19, 0x8b, 0x5b, 0xcd, 0x37,
-	0x04, 0x86, 0xea, 0xb8, 0x3d, 0xe6, 0x57, 0xba, 0x0c, 0x71, 0x32, 0xb6, 0x2b, 0xb1, 0x8d, 0xf8,
-	0xcd, 0xbc, 0x4c, 0x9b, 0xe8, 0x04, 0x56, 0x06, 0x46, 0x57, 0x1d, 0x28, 0xbe, 0x93, 0x2f, 0x0e,
-	0xfd, 0x4b, 0x53, 0xcc, 0xdd, 0x1c, 0x13, 0x3a, 0x8d, 0xde, 0x95, 0xc3, 0x5f, 0x62, 0x5a, 0xf6,
-	0xdc, 0x1b, 0x80, 0xde, 0x83, 0xdc, 0xd0, 0x3b, 0xce, 0x4b, 0x1d, 0x7a, 0xbf, 0xa0, 0x6f, 0x5b,
-	0x92, 0x01, 0x37, 0xe1, 0x38, 0xed, 0xd4, 0xd2, 0x4e, 0xfb, 0xbb, 0xb0, 0xaa, 0xe3, 0x31, 0xf1,
-	0x5d, 0x49, 0x7e, 0x56, 0xd2, 0xcc, 0xfc, 0x88, 0x7e, 0xf3, 0x2e, 0x1b, 0x3d, 0x36, 0xe8, 0x16,
-	0x0b, 0xb4, 0xa6, 0x61, 0x63, 0x4b, 0x51, 0x7b, 0x3d, 0x0b, 0xdb, 0x36, 0x4b, 0x19, 0xf3, 0x2c,
-	0x76, 0x32, 0x7a, 0x8d, 0x93, 0xa5, 0x4f, 0xfd, 0xdb, 0x13, 0x08, 0xab, 0x8e, 0xf1, 0xa3, 0x9e,
-	0xf1, 0x1f, 0xc1, 0xaa, 0x90, 0xef, 0x05, 0xec, 0x1f, 0x0b, 0xef, 0x74, 0x90, 0xa3, 0x60, 0xb6,
-	0xe9, 0xe3, 0xdf, 0xd6, 0xf4, 0x8e, 0x77, 0x4d, 0xf8, 0xbc, 0xeb, 0x7f, 0xd9, 0x76, 0xfc, 0x23,
-	0x0b, 0x19, 0x19, 0xdb, 0x26, 0x0d, 0xa6, 0xe8, 0x2e, 0x64, 0xf1, 0xb8, 0x8b, 0x4d, 0xe2, 0x64,
-	0x20, 0xb3, 0x80, 0x03, 0xe7, 0x6f, 0x3a, 0xbc, 0x34, 0x63, 0x77, 0x05, 0xd1, 0x1b, 0x02, 0xa4,
-	0xcd, 0x43, 0x5b, 0x42, 0x81, 0x1f, 0xa5, 0xdd, 0x71, 0x50, 0x5a, 0x7c, 0x4e, 0x92, 0xce, 0xe5,
-	0x26, 0x60, 0xda, 0x1b, 0x02, 0xa6, 0x25, 0x16, 0x0e, 0x18, 0xc0, 0x69, 0xcd, 0x00, 0x4e, 0x4b,
-	0x2d, 0x5c, 0xee, 0x0c, 0xa0, 0x76, 0xc7, 0x01, 0x6a, 0xe9, 0x85, 0xf3, 0x9e, 0x40, 0x6a, 0xad,
-	0x20, 0x52, 0xcb, 0xcc, 0x74, 0x2a, 0x8e, 0xfc, 0x4c, 0xa8, 0xf6, 0xae, 0x0f, 0xaa, 0x65, 0xe7,
-	0x60, 0x24, 0xae, 0x66, 0x0a, 0x56, 0x6b, 0x06, 0xb0, 0x1a, 0x2c, 0xb4, 0xc5, 0x0c, 0xb0, 0x56,
-	0xf7, 0x83, 0xb5, 0xdc, 0x1c, 0xc4, 0x27, 0xf6, 0x7f, 0x1a, 0x5a, 0x7b, 0xc7, 0x45, 0x6b, 0xf9,
-	0x39, 0xa0, 0x53, 0xac, 0x64, 0x12, 0xae, 0x3d, 0xbc, 0x02, 0xd7, 0x38, 0xb8, 0xba, 0x39, 0x47,
-	0xc9, 0x02, 0xbc, 0xf6, 0xf0, 0x0a, 0x5e, 0x2b, 0x2e, 0x54, 0xb9, 0x00, 0xb0, 0x7d, 0x38, 0x1d,
-	0xb0, 0xcd, 0x03, 0x54, 0x62, 0xaa, 0xe1, 0x10, 0x9b, 0x3a, 0x03, 0xb1, 0x71, 0x4c, 0xf5, 0xda,
-	0x9c, 0x01, 0x42, 0x43, 0xb6, 0x93, 0x29, 0x90, 0x8d, 0x43, 0xab, 0xdb, 0x73, 0xd4, 0x87, 0xc0,
-	0x6c, 0x27, 0x53, 0x30, 0x1b, 0x0a, 0xa1, 0x78, 0x19, 0xd0, 0x96, 0x2c, 0xa7, 0xa4, 0x5b, 0x34,
-	0x5d, 0x9e, 0xf0, 0x63, 0x34, 0xd3, 0xc0, 0x96, 0x65, 0x58, 0x02, 0x7e, 0xf1, 0x8e, 0x74, 0x93,
-	0x26, 0xf0, 0x9e, 0xc7, 0x9a, 0x03, 0xf0, 0x58, 0x4e, 0xe7, 0xf3, 0x51, 0xd2, 0x1f, 0xa3, 0x9e,
-	0x2c, 0xcb, 0x79, 0xfd, 0xc9, 0x7f, 0x56, 0x24, 0xff, 0x3e, 0xd8, 0x17, 0x0b, 0xc2, 0xbe, 0x75,
-	0xc8, 0xd1, 0x4c, 0x6d, 0x02, 0xd1, 0xa9, 0xa6, 0x8b, 0xe8, 0x6e, 0xc3, 0x0a, 0x8b, 0x8b, 0x1c,
-	0x1c, 0x8a, 0xc0, 0x93, 0x60, 0x81, 0xa7, 0x44, 0x3f, 0xf0, 0xeb, 0xc5, 0x23, 0xd0, 0x6b, 0x70,
-	0xcd, 0xc7, 0xeb, 0x66, 0x80, 0x1c, 0xda, 0x94, 0x5d, 0xee, 0x9a, 0x48, 0x05, 0xbf, 0x88, 0x7a,
-	0x16, 0xf2, 0xa0, 0xe0, 0x34, 0xd4, 0x16, 0xfd, 0xb7, 0xa1, 0xb6, 0xd8, 0x53, 0xa0, 0x36, 0x7f,
-	0x56, 0x1b, 0x0f, 0x66, 0xb5, 0xff, 0x8c, 0x7a, 0xfb, 0xe2, 0x62, 0xb0, 0xae, 0xd1, 0xc3, 0x22,
-	0xcf, 0x64, 0x6d, 0x9a, 0x7e, 0x0c, 0x8c, 0x33, 0x91, 0x4d, 0xd2, 0x26, 0xe5, 0x72, 0x03, 0x4b,
-	0x56, 0x44, 0x0d, 0x37, 0x45, 0xe5, 0xe1, 0x5d, 0xa4, 0xa8, 0x65, 0x88, 0x9f, 0x63, 0x5e, 0xab,
-	0xcb, 0xcb, 0xb4, 0x49, 0xf9, 0xd8, 0x71, 0x13, 0x61, 0x9a, 0x77, 0xd0, 0xdb, 0x90, 0x65, 0x35,
-	0x57, 0xc5, 0x30, 0x6d, 0xe1, 0xf0, 0x27, 0xb2, 0x18, 0x5e, 0x58, 0xdd, 0x3c, 0xa4, 0x5c, 0x07,
-	0xa6, 0x2d, 0x67, 0x4c, 0xd1, 0xf2, 0x65, 0x16, 0xd9, 0x40, 0x66, 0xf1, 0x3c, 0x64, 0xe9, 0xfc,
-	0x6d, 0x53, 0xed, 0x62, 0xe6, 0xba, 0xb3, 0xb2, 0x47, 0x90, 0x1e, 0x03, 0xba, 0x1a, 0x3e, 0xd0,
-	0x7d, 0x48, 0xe1, 0x0b, 0xac, 0x13, 0x9e, 0x6d, 0xe5, 0xb6, 0x2b, 0xd3, 0x52, 0x59, 0xca, 0x50,
-	0xaf, 0x50, 0x43, 0xff, 0xfd, 0xab, 0xf5, 0x32, 0xe7, 0x7f, 0xd5, 0x18, 0x6a, 0x04, 0x0f, 0x4d,
-	0x72, 0x29, 0x0b, 0x0d, 0xd2, 0x57, 0x31, 0x0a, 0x7a, 0x02, 0xa1, 0x65, 0xaa, 0x7d, 0x9d, 0xa3,
-	0x1f, 0xf3, 0xe1, 0xde, 0x70, 0x36, 0x5f, 0x03, 0x38, 0x53, 0x6d, 0xe5, 0x89, 0xaa, 0x13, 0xdc,
-	0x13, 0x86, 0xf7, 0x51, 0x50, 0x15, 0x32, 0xb4, 0x37, 0xb2, 0x71, 0x4f, 0x40, 0x70, 0xb7, 0xef,
-	0x5b, 0x69, 0xfa, 0x69, 0x57, 0x1a, 0xb4, 0x74, 0x66, 0xc2, 0xd2, 0x3e, 0x40, 0x92, 0xf5, 0x03,
-	0x12, 0x3a, 0x3b, 0xd3, 0xd2, 0x0c, 0x4b, 0x23, 0x97, 0x6c, 0x7b, 0xe2, 0xb2, 0xdb, 0x47, 0x37,
-	0xa0, 0x30, 0xc4, 0x43, 0xd3, 0x30, 0x06, 0x0a, 0x77, 0x3c, 0x39, 0x26, 0x9a, 0x17, 0xc4, 0x26,
-	0xf3, 0x3f, 0xbf, 0x8c, 0x79, 0x37, 0xd1, 0x83, 0x9e, 0xff, 0x83, 0x26, 0x96, 0x7e, 0xcd, 0xaa,
-	0x53, 0xc1, 0xe4, 0x01, 0x3d, 0x82, 0x15, 0xd7, 0x0d, 0x28, 0x23, 0xe6, 0x1e, 0x9c, 0x63, 0x1d,
-	0xde, 0x93, 0x94, 0x2f, 0x82, 0x64, 0x1b, 0x7d, 0x00, 0xcf, 0x4e, 0x78, 0x3a, 0x57, 0x79, 0x2c,
-	0xbc, 0xc3, 0x7b, 0x26, 0xe8, 0xf0, 0x1c, 0xe5, 0x9e, 0xc9, 0xe2, 0x4f, 0x7d, 0xff, 0x76, 0xa0,
-	0x18, 0xcc, 0x87, 0xa6, 0x1e, 0x83, 0x1b, 0x50, 0xb0, 0x30, 0x51, 0x35, 0x5d, 0x09, 0x14, 0x95,
-	0xf2, 0x9c, 0x28, 0x4a, 0x55, 0x32, 0x3c, 0x33, 0x35, 0x2b, 0x42, 0x6f, 0x41, 0xd6, 0x4b, 0xa9,
-	0xb8, 0x6d, 0xe7, 0x16, 0x1b, 0x3c, 0x6e, 0xe9, 0xcf, 0x51, 0x4f, 0x69, 0xb0, 0x80, 0x71, 0x0f,
-	0x52, 0x16, 0xb6, 0x47, 0x03, 0x5e, 0x50, 0x28, 0x6e, 0x6f, 0x85, 0xcd, 0xa8, 0x28, 0x75, 0x34,
-	0x20, 0xb2, 0x10, 0x97, 0x3e, 0x84, 0x14, 0xa7, 0xa0, 0x1c, 0xa4, 0x1f, 0xed, 0xef, 0xee, 0x1f,
-	0x9c, 0xec, 0x97, 0x23, 0x08, 0x20, 0x55, 0x6b, 0x34, 0x9a, 0x87, 0xed, 0x72, 0x14, 0x65, 0x21,
-	0x59, 0xab, 0x1f, 0xc8, 0xed, 0x72, 0x8c, 0x92, 0xe5, 0xe6, 0xfd, 0x66, 0xa3, 0x5d, 0x8e, 0xa3,
-	0x15, 0x28, 0xf0, 0xb6, 0xf2, 0xde, 0x81, 0xfc, 0xa0, 0xd6, 0x2e, 0x27, 0x7c, 0xa4, 0xa3, 0xe6,
-	0xfe, 0xdd, 0xa6, 0x5c, 0x4e, 0x4a, 0xdf, 0x83, 0xe7, 0x66, 0x66, 0x60, 0x5e, 0x75, 0x22, 0xea,
-	0xab, 0x4e, 0x48, 0xbf, 0x89, 0x41, 0x75, 0x76, 0x52, 0x85, 0xf6, 0x26, 0x96, 0xfe, 0xfa, 0x52,
-	0x39, 0xd9, 0xc4, 0xfa, 0xd1, 0x4b, 0x50, 0xb4, 0xf0, 0x29, 0x26, 0xdd, 0x3e, 0x4f, 0xf4, 0x78,
-	0x20, 0x2d, 0xc8, 0x05, 0x41, 0x65, 0x42, 0x36, 0x67, 0xfb, 0x08, 0x77, 0x89, 0xc2, 0x3d, 0x13,
-	0x3f, 0x7c, 0x59, 0xca, 0x46, 0xa9, 0x47, 0x9c, 0x28, 0x3d, 0x5e, 0xca, 0x9a, 0x59, 0x48, 0xca,
-	0xcd, 0xb6, 0xfc, 0x7e, 0x39, 0x8e, 0x10, 0x14, 0x59, 0x53, 0x39, 0xda, 0xaf, 0x1d, 0x1e, 0xb5,
-	0x0e, 0xa8, 0x35, 0xaf, 0x41, 0xc9, 0xb1, 0xa6, 0x43, 0x4c, 0x4a, 0xaf, 0xc0, 0xb3, 0x33, 0x32,
-	0xc2, 0xab, 0x35, 0x00, 0xe9, 0xf7, 0x51, 0x3f, 0x77, 0xb0, 0x62, 0xf0, 0x10, 0x52, 0x36, 0x51,
-	0xc9, 0xc8, 0x16, 0x66, 0x7c, 0x2b, 0x7c, 0x8a, 0xb8, 0xe9, 0x34, 0x8e, 0x98, 0x02, 0x59, 0x28,
-	0x92, 0xde, 0x80, 0x62, 0xf0, 0xcb, 0x6c, 0x2b, 0x78, 0x07, 0x29, 0x26, 0x7d, 0x00, 0xe0, 0xab,
-	0x6c, 0xae, 0x42, 0xd2, 0x32, 0x46, 0x7a, 0x8f, 0x4d, 0x2b, 0x29, 0xf3, 0x0e, 0x7a, 0x13, 0x92,
-	0x17, 0x06, 0xf7, 0x1f, 0xb3, 0x2e, 0xd0, 0xb1, 0x41, 0xb0, 0xaf, 0x78, 0xc1, 0xf9, 0xa5, 0x73,
-	0x40, 0x57, 0xeb, 0x4a, 0x33, 0x06, 0x79, 0x37, 0x38, 0xc8, 0x8d, 0x39, 0x35, 0xaa, 0xe9, 0x83,
-	0xfd, 0x1c, 0x92, 0xcc, 0xf3, 0x50, 0x2f, 0xc2, 0x6a, 0xa4, 0x22, 0x55, 0xa5, 0x6d, 0xa4, 0x00,
-	0xa8, 0x84, 0x58, 0x5a, 0x67, 0xe4, 0x0d, 0xf1, 0xe2, 0x2c, 0xdf, 0x55, 0x73, 0x38, 0xeb, 0xcf,
-	0x0b, 0x27, 0xb6, 0xea, 0x09, 0xfb, 0x1c, 0x99, 0x4f, 0xa5, 0xb4, 0x0f, 0xc5, 0xa0, 0xac, 0x93,
-	0x5a, 0xf1, 0x59, 0x04, 0x53, 0x2b, 0x9e, 0x2d, 0x8b, 0xd4, 0xca, 0x4d, 0xcc, 0xe2, 0xbc, 0x2c,
-	0xce, 0x3a, 0xd2, 0xa7, 0x51, 0xc8, 0xb4, 0xc7, 0xe2, 0x3c, 0xcf, 0x28, 0xc5, 0x7a, 0xa2, 0x31,
-	0x7f, 0xd9, 0x91, 0xd7, 0x76, 0xe3, 0x6e, 0xcd, 0xb8, 0xee, 0xde, 0xd9, 0x44, 0x78, 0x7c, 0xec,
-	0x54, 0xd0, 0x85, 0xa7, 0x7a, 0x07, 0xb2, 0x6e, 0xfc, 0xa1, 0x79, 0xbf, 0x53, 0x9d, 0x89, 0x8a,
-	0x84, 0x95, 0x77, 0x59, 0x89, 0xdf, 0x78, 0x22, 0x4a, 0x9b, 0x71, 0x99, 0x77, 0xa4, 0x53, 0x28,
-	0x4d, 0x04, 0x2f, 0xf4, 0x43, 0x48, 0x9b, 0xa3, 0x8e, 0xe2, 0x18, 0xe8, 0x4a, 0x15, 0xcb, 0xc9,
-	0x26, 0x47, 0x9d, 0x81, 0xd6, 0xdd, 0xc5, 0x97, 0xce, 0x74, 0xcc, 0x51, 0x67, 0x97, 0x5b, 0x92,
-	0x8f, 0x13, 0xf3, 0x8f, 0x33, 0x86, 0x8c, 0x73, 0x34, 0xd0, 0x8f, 0x21, 0xeb, 0x46, 0x46, 0x31,
-	0xc4, 0xf3, 0xf3, 0x82, 0xaa, 0x18, 0xc0, 0x13, 0xa2, 0x10, 0xc5, 0xd6, 0xce, 0x74, 0xa7, 0x82,
-	0xc7, 0x6b, 0x03, 0x31, 0xb6, 0x47, 0x25, 0xfe, 0x61, 0xcf, 0x81, 0x1e, 0xd2, 0x1f, 0xa2, 0x50,
-	0x9e, 0x3c, 0x9d, 0xff, 0xd9, 0x29, 0x50, 0x27, 0x49, 0xef, 0x81, 0x82, 0xe9, 0x34, 0x5c, 0xd4,
-	0x95, 0x97, 0x0b, 0x94, 0xda, 0x74, 0x88, 0x34, 0x27, 0xcb, 0xf9, 0xaa, 0x83, 0xe8, 0x07, 0xbe,
-	0xcb, 0x52, 0x9c, 0x9a, 0x77, 0xf8, 0xb8, 0xbd, 0x9f, 0x0a, 0xc1, 0xc5, 0xc5, 0xbe, 0xcd, 0xe2,
-	0x66, 0xfd, 0x26, 0x72, 0x0a, 0x8e, 0x89, 0xa5, 0x0b, 0x8e, 0xaf, 0x02, 0x22, 0x06, 0x51, 0x07,
-	0xca, 0x85, 0x41, 0x34, 0xfd, 0x4c, 0xe1, 0x47, 0x84, 0xe7, 0x84, 0x65, 0xf6, 0xe5, 0x98, 0x7d,
-	0x38, 0x64, 0xa7, 0xe5, 0x17, 0x51, 0xc8, 0xb8, 0x21, 0x7d, 0xd9, 0x7f, 0x04, 0xd7, 0x21, 0x25,
-	0x22, 0x16, 0xff, 0x49, 0x20, 0x7a, 0x53, 0x2b, 0xab, 0x55, 0xc8, 0x0c, 0x31, 0x51, 0x59, 0x66,
-	0xc3, 0x41, 0xab, 0xdb, 0xbf, 0xfd, 0x16, 0xe4, 0x7c, 0x3f, 0x6c, 0xa8, 0xcf, 0xd8, 0x6f, 0x9e,
-	0x94, 0x23, 0xd5, 0xf4, 0x27, 0x9f, 0x6f, 0xc4, 0xf7, 0xf1, 0x13, 0x7a, 0xd7, 0xe4, 0x66, 0xa3,
-	0xd5, 0x6c, 0xec, 0x96, 0xa3, 0xd5, 0xdc, 0x27, 0x9f, 0x6f, 0xa4, 0x65, 0xcc, 0x8a, 0x5f, 0xb7,
-	0x77, 0xa1, 0x34, 0xb1, 0x35, 0x41, 0x8f, 0x8f, 0xa0, 0x78, 0xf7, 0xd1, 0xe1, 0xde, 0x4e, 0xa3,
-	0xd6, 0x6e, 0x2a, 0xc7, 0x07, 0xed, 0x66, 0x39, 0x8a, 0x9e, 0x85, 0x6b, 0x7b, 0x3b, 0xf7, 0x5a,
-	0x6d, 0xa5, 0xb1, 0xb7, 0xd3, 0xdc, 0x6f, 0x2b, 0xb5, 0x76, 0xbb, 0xd6, 0xd8, 0x2d, 0xc7, 0xb6,
-	0xff, 0x94, 0x83, 0x52, 0xad, 0xde, 0xd8, 0xa1, 0x31, 0x5b, 0xeb, 0xaa, 0xac, 0xa8, 0x70, 0x0f,
-	0x12, 0xac, 0x6c, 0xb0, 0xe0, 0xb9, 0x4a, 0x75, 0x51, 0xa5, 0x14, 0xdd, 0x87, 0x24, 0xab, 0x2a,
-	0xa0, 0x45, 0x2f, 0x58, 0xaa, 0x0b, 0x8b, 0xa7, 0x74, 0x52, 0xec, 0x72, 0x2d, 0x78, 0xd4, 0x52,
-	0x5d, 0x54, 0x4d, 0x45, 0xc7, 0x90, 0xf5, 0x30, 0x49, 0x98, 0xe7, 0x1d, 0xd5, 0x50, 0x7e, 0x13,
-	0x1d, 0x42, 0xda, 0x01, 0x93, 0x8b, 0x9f, 0x9d, 0x54, 0x43, 0x94, 0x3b, 0xa9, 0xf9, 0x38, 0xf8,
-	0x5f, 0xf4, 0x96, 0xa6, 0xba, 0xb0, 0x86, 0x8b, 0x1e, 0x40, 0x4a, 0xe4, 0xda, 0x0b, 0x1f, 0x93,
-	0x54, 0x17, 0x17, 0x30, 0xa9, 0x11, 0xbd, 0x12, 0x4b, 0x98, 0xb7, 0x42, 0xd5, 0x50, 0x85, 0x6a,
-	0xf4, 0x3e, 0x80, 0x0f, 0xf4, 0x87, 0x7a, 0x04, 0x54, 0x0d, 0x57, 0x80, 0x46, 0x47, 0x90, 0x71,
-	0x11, 0x58, 0x88, 0x07, 0x39, 0xd5, 0x30, 0x75, 0x60, 0xd4, 0x81, 0x42, 0x10, 0x77, 0x84, 0x7d,
-	0x66, 0x53, 0x0d, 0x5d, 0xe0, 0xa5, 0x63, 0x04, 0x61, 0x48, 0xd8, 0x87, 0x37, 0xd5, 0xd0, 0x15,
-	0x5f, 0xa4, 0xc3, 0xca, 0x55, 0xa0, 0xb0, 0xcc, 0x4b, 0x9c, 0xea, 0x52, 0x55, 0x60, 0xf4, 0x31,
-	0xa0, 0x29, 0x20, 0x63, 0xa9, 0xa7, 0x39, 0xd5, 0xe5, 0xca, 0xc2, 0xa8, 0x0f, 0xa5, 0xc9, 0xdc,
-	0x3d, 0xfc, 0x63, 0x9d, 0xea, 0x12, 0x45, 0x62, 0x3e, 0x52, 0x30, 0xef, 0x0f, 0xff, 0x7c, 0xa7,
-	0xba, 0x44, 0xd5, 0xb8, 0xbe, 0xf3, 0xc5, 0xd7, 0x6b, 0xd1, 0x2f, 0xbf, 0x5e, 0x8b, 0xfe, 0xed,
-	0xeb, 0xb5, 0xe8, 0x67, 0xdf, 0xac, 0x45, 0xbe, 0xfc, 0x66, 0x2d, 0xf2, 0x97, 0x6f, 0xd6, 0x22,
-	0x3f, 0xd9, 0x3a, 0xd3, 0x48, 0x7f, 0xd4, 0xd9, 0xec, 0x1a, 0xc3, 0xad, 0xdd, 0xf7, 0x8f, 0x9b,
-	0xfb, 0x98, 0x3c, 0x31, 0xac, 0xf3, 0x2d, 0x47, 0xf7, 0x6b, 0x13, 0xef, 0x30, 0x3b, 0x29, 0x16,
-	0x67, 0xbf, 0xff, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0x81, 0xf1, 0x70, 0xa9, 0x29, 0x00,
-	0x00,
+	// 3210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x73, 0xe3, 0xc6,
+	0xf1, 0xe7, 0xfb, 0xd1, 0x7c, 0x41, 0x23, 0xed, 0x9a, 0x86, 0x6d, 0x69, 0x8d, 0xfd, 0xfb, 0xef,
+	0xdd, 0xb5, 0x2d, 0x25, 0xb2, 0x1d, 0xaf, 0xbd, 0x95, 0x38, 0x92, 0x96, 0x5e, 0x6a, 0xa5, 0x95,
+	0xb4, 0x10, 0x57, 0x5b, 0x8e, 0x2b, 0xc1, 0x82, 0xe4, 0x48, 0x84, 0x45, 0x12, 0x30, 0x00, 0x6a,
+	0xa9, 0x54, 0x4e, 0xb9, 0xb8, 0xe2, 0xf2, 0xc1, 0x95, 0x63, 0xca, 0x3e, 0xa5, 0x2a, 0xf9, 0x0a,
+	0xf9, 0x08, 0x3e, 0x24, 0x55, 0x3e, 0xe6, 0xe4, 0xa4, 0xec, 0x5b, 0xbe, 0x41, 0x6e, 0xa9, 0x79,
+	0x81, 0x00, 0x09, 0x92, 0xa0, 0x9d, 0x4a, 0x55, 0x2a, 0xb7, 0x99, 0x46, 0x77, 0xcf, 0x4c, 0xcf,
+	0x4c, 0x77, 0xff, 0x1a, 0x03, 0x2f, 0xb4, 0x70, 0x17, 0x3b, 0xae, 0xa1, 0xb7, 0x4c, 0x1b, 0x6f,
+	0xe8, 0xcd, 0x96, 0xb1, 0xe1, 0x5e, 0x5a, 0xd8, 0x59, 0xb7, 0x6c, 0xd3, 0x35, 0xd1, 0x92, 0xff,
+	0xf3, 0x3a, 0xf9, 0x2c, 0xaf, 0x05, 0x24, 0x5a, 0xf6, 0xa5, 0xe5, 0x9a, 0x1b, 0x96, 0x6d, 0x9a,
+	0xa7, 0x4c, 0x46, 0x5e, 0x0d, 0x30, 0x50, 0x6d, 0x7e, 0x9d, 0xf2, 0x6a, 0x98, 0x82, 0x73, 0x7c,
+	0x29, 0xbe, 0xaf, 0x85, 0xc8, 0x5b, 0xba, 0xad, 0xf7, 0x3c, 0x86, 0x33, 0xd3, 0x3c, 0xeb, 0xe2,
+	0x0d, 0xda, 0x6b, 0x0e, 0x4e, 0x37, 0x5c, 0xa3, 0x87, 0x1d, 0x57, 0xef, 0x59, 0x9c, 0x61, 0xe5,
+	0xcc, 0x3c, 0x33, 0x69, 0x73, 0x83, 0xb4, 0x18, 0x55, 0xf9, 0x0b, 0x40, 0x56, 0xc5, 0x1f, 0x0d,
+	0xb0, 0xe3, 0xa2, 0x37, 0x20, 0x85, 0x5b, 0x1d, 0xb3, 0x1a, 0xbf, 0x16, 0xbf, 0x51, 0xd8, 0x5c,
+	0x5d, 0x9f, 0x58, 0xe6, 0x3a, 0xe7, 0xac, 0xb5, 0x3a, 0x66, 0x3d, 0xa6, 0x52, 0x6e, 0xf4, 0x16,
+	0xa4, 0x4f, 0xbb, 0x03, 0xa7, 0x53, 0x4d, 0x50, 0xb1, 0xb5, 0xe9, 0x62, 0xef, 0x11, 0xb6, 0x7a,
+	0x4c, 0x65, 0xfc, 0x64, 0x38, 0xa3, 0x7f, 0x6a, 0x56, 0x93, 0xf3, 0x86, 0xdb, 0xed, 0x9f, 0xd2,
+	0xe1, 0x08, 0x37, 0xba, 0x0b, 0x60, 0xf4, 0x0d, 0x57, 0x6b, 0x75, 0x74, 0xa3, 0x5f, 0x4d, 0x53,
+	0xd9, 0xeb, 0xb3, 0x64, 0x0d, 0x77, 0x87, 0xb0, 0xd6, 0x63, 0x6a, 0xde, 0x10, 0x1d, 0x32, 0xe9,
+	0x8f, 0x06, 0xd8, 0xbe, 0xac, 0x66, 0xe6, 0x4d, 0xfa, 0x21, 0x61, 0x23, 0x93, 0xa6, 0xfc, 0xe8,
+	0x1e, 0x14, 0x9a, 0xf8, 0xcc, 0xe8, 0x6b, 0xcd, 0xae, 0xd9, 0x3a, 0xaf, 0x66, 0xa9, 0xf8, 0xff,
+	0x4d, 0x17, 0xdf, 0x26, 0xcc, 0xdb, 0x84, 0xb7, 0x1e, 0x53, 0xa1, 0xe9, 0xf5, 0xd0, 0x4f, 0x20,
+	0xd7, 0xea, 0xe0, 0xd6, 0xb9, 0xe6, 0x0e, 0xab, 0x39, 0xaa, 0xe5, 0xc5, 0xe9, 0x5a, 0x76, 0x08,
+	0x67, 0x63, 0x58, 0x8f, 0xa9, 0xd9, 0x16, 0x6b, 0x12, 0x3b, 0xb4, 0x71, 0xd7, 0xb8, 0xc0, 0x36,
+	0xd1, 0x90, 0x9f, 0x67, 0x87, 0xbb, 0x8c, 0x97, 0xea, 0xc8, 0xb7, 0x45, 0x07, 0x6d, 0x41, 0x1e,
+	0xf7, 0xdb, 0x7c, 0x31, 0x40, 0x95, 0x28, 0x33, 0xf6, 0xbd, 0xdf, 0x16, 0x4b, 0xc9, 0x61, 0xde,
+	0x46, 0xef, 0x40, 0xa6, 0x65, 0xf6, 0x7a, 0x86, 0x5b, 0x2d, 0x50, 0xf9, 0x6b, 0x33, 0x96, 0x41,
+	0xf9, 0xea, 0x31, 0x95, 0x4b, 0xa0, 0x23, 0x28, 0x77, 0x0d, 0xc7, 0xd5, 0x9c, 0xbe, 0x6e, 0x39,
+	0x1d, 0xd3, 0x75, 0xaa, 0x45, 0xaa, 0xe3, 0xe5, 0xe9, 0x3a, 0xf6, 0x0d, 0xc7, 0x3d, 0x16, 0xec,
+	0xf5, 0x98, 0x5a, 0xea, 0xfa, 0x09, 0x44, 0xa3, 0x79, 0x7a, 0x8a, 0x6d, 0x4f, 0x65, 0xb5, 0x34,
+	0x4f, 0xe3, 0x21, 0xe1, 0x17, 0x1a, 0x88, 0x46, 0xd3, 0x4f, 0x40, 0x3f, 0x87, 0xe5, 0xae, 0xa9,
+	0xb7, 0x3d, 0x85, 0x5a, 0xab, 0x33, 0xe8, 0x9f, 0x57, 0xcb, 0x54, 0xed, 0x2b, 0x33, 0x26, 0x6a,
+	0xea, 0x6d, 0xa1, 0x64, 0x87, 0x88, 0xd4, 0x63, 0xea, 0x52, 0x77, 0x9c, 0x88, 0x9e, 0xc0, 0x8a,
+	0x6e, 0x59, 0xdd, 0xcb, 0x71, 0xfd, 0x15, 0xaa, 0xff, 0xd5, 0xe9, 0xfa, 0xb7, 0x88, 0xd4, 0xf8,
+	0x00, 0x48, 0x9f, 0xa0, 0xa2, 0x13, 0x90, 0x2c, 0x1b, 0x5b, 0xba, 0x8d, 0x35, 0xcb, 0x36, 0x2d,
+	0xd3, 0xd1, 0xbb, 0x55, 0x89, 0x6a, 0xbf, 0x39, 0x5d, 0xfb, 0x11, 0x93, 0x38, 0xe2, 0x02, 0xf5,
+	0x98, 0x5a, 0xb1, 0x82, 0x24, 0xa6, 0xd7, 0x6c, 0x61, 0xc7, 0x19, 0xe9, 0x5d, 0x9a, 0xaf, 0x97,
+	0x4a, 0x04, 0xf5, 0x06, 0x48, 0xe4, 0x8a, 0xe1, 0xa1, 0x4b, 0x8e, 0xe5, 0x85, 0xe9, 0xe2, 0x2a,
+	0x9a, 0x77, 0xc5, 0x6a, 0x94, 0xf9, 0xc4, 0x74, 0x31, 0xb9, 0x62, 0xd8, 0xeb, 0xa1, 0x16, 0x5c,
+	0xb9, 0xc0, 0xb6, 0x71, 0x7a, 0x49, 0x15, 0x69, 0xf4, 0x8b, 0x63, 0x98, 0xfd, 0xea, 0x32, 0x55,
+	0xf9, 0xda, 0x74, 0x95, 0x27, 0x54, 0x8c, 0x28, 0xa9, 0x09, 0xa1, 0x7a, 0x4c, 0x5d, 0xbe, 0x98,
+	0x24, 0x6f, 0x67, 0x21, 0x7d, 0xa1, 0x77, 0x07, 0xf8, 0x7e, 0x2a, 0x97, 0x92, 0xd2, 0xca, 0xcb,
+	0x50, 0xf0, 0x39, 0x49, 0x54, 0x85, 0x6c, 0x0f, 0x3b, 0x8e, 0x7e, 0x86, 0xa9, 0x57, 0xcd, 0xab,
+	0xa2, 0xab, 0x94, 0xa1, 0xe8, 0x77, 0x8b, 0xca, 0x67, 0x71, 0x28, 0xf8, 0xfc, 0x1d, 0x91, 0xbc,
+	0xc0, 0x36, 0x9d, 0x2e, 0x97, 0xe4, 0x5d, 0x74, 0x1d, 0x4a, 0xf4, 0xbe, 0x6a, 0xe2, 0x3b, 0x71,
+	0xbc, 0x29, 0xb5, 0x48, 0x89, 0x27, 0x9c, 0x69, 0x0d, 0x0a, 0xd6, 0xa6, 0xe5, 0xb1, 0x24, 0x29,
+	0x0b, 0x58, 0x9b, 0x96, 0x60, 0x78, 0x11, 0x8a, 0x64, 0xc5, 0x1e, 0x47, 0x8a, 0x0e, 0x52, 0x20,
+	0x34, 0xce, 0xa2, 0x7c, 0x95, 0x00, 0x69, 0xdc, 0x8d, 0xa2, 0xdb, 0x90, 0x22, 0x91, 0x85, 0x07,
+	0x09, 0x79, 0x9d, 0x85, 0x9d, 0x75, 0x11, 0x76, 0xd6, 0x1b, 0x22, 0xec, 0x6c, 0xe7, 0xbe, 0xfc,
+	0x7a, 0x2d, 0xf6, 0xd9, 0xdf, 0xd6, 0xe2, 0x2a, 0x95, 0x40, 0xcf, 0x12, 0x8f, 0xa7, 0x1b, 0x7d,
+	0xcd, 0x68, 0xd3, 0x29, 0xe7, 0x89, 0x33, 0xd3, 0x8d, 0xfe, 0x6e, 0x1b, 0x1d, 0x80, 0xd4, 0x32,
+	0xfb, 0x0e, 0xee, 0x3b, 0x03, 0x47, 0x63, 0x61, 0xad, 0x9a, 0x0c, 0x73, 0x69, 0x2c, 0x64, 0xee,
+	0x08, 0xde, 0x23, 0xca, 0xaa, 0x56, 0x5a, 0x41, 0x02, 0xaa, 0x03, 0x5c, 0xe8, 0x5d, 0xa3, 0xad,
+	0xbb, 0xa6, 0xed, 0x54, 0x53, 0xd7, 0x92, 0x53, 0xfc, 0xda, 0x89, 0x60, 0x7a, 0x64, 0xb5, 0x75,
+	0x17, 0x6f, 0xa7, 0xc8, 0x94, 0x55, 0x9f, 0x2c, 0xfa, 0x7f, 0xa8, 0xe8, 0x96, 0xa5, 0x39, 0xae,
+	0xee, 0x62, 0xad, 0x79, 0xe9, 0x62, 0x87, 0xc6, 0x9c, 0xa2, 0x5a, 0xd2, 0x2d, 0xeb, 0x98, 0x50,
+	0xb7, 0x09, 0x11, 0xbd, 0x04, 0x65, 0x12, 0x5d, 0x0c, 0xbd, 0xab, 0x75, 0xb0, 0x71, 0xd6, 0x71,
+	0x69, 0x64, 0x49, 0xaa, 0x25, 0x4e, 0xad, 0x53, 0xa2, 0xd2, 0x86, 0xa2, 0x3f, 0xae, 0x20, 0x04,
+	0xa9, 0xb6, 0xee, 0xea, 0xd4, 0x9a, 0x45, 0x95, 0xb6, 0x09, 0xcd, 0xd2, 0xdd, 0x0e, 0xb7, 0x11,
+	0x6d, 0xa3, 0xab, 0x90, 0xe1, 0x6a, 0x93, 0x54, 0x2d, 0xef, 0xa1, 0x15, 0x48, 0x5b, 0xb6, 0x79,
+	0x81, 0xe9, 0xf6, 0xe5, 0x54, 0xd6, 0x51, 0x7e, 0x93, 0x80, 0xa5, 0x89, 0xf8, 0x43, 0xf4, 0x76,
+	0x74, 0xa7, 0x23, 0xc6, 0x22, 0x6d, 0x74, 0x9b, 0xe8, 0xd5, 0xdb, 0xd8, 0xe6, 0xd1, 0x5b, 0x0e,
+	0x33, 0x77, 0x9d, 0x72, 0x70, 0xe3, 0x70, 0x7e, 0xf4, 0x00, 0xa4, 0xae, 0xee, 0xb8, 0x1a, 0xf3,
+	0xe4, 0x9a, 0x2f, 0x92, 0xbf, 0x10, 0x62, 0x68, 0xe6, 0xf9, 0xc9, 0xc1, 0xe6, 0x6a, 0xca, 0x44,
+	0x78, 0x44, 0x45, 0x8f, 0x61, 0xa5, 0x79, 0xf9, 0x4b, 0xbd, 0xef, 0x1a, 0x7d, 0xac, 0x4d, 0xec,
+	0x5d, 0x58, 0x72, 0xf0, 0xc0, 0x70, 0x9a, 0xb8, 0xa3, 0x5f, 0x18, 0xa6, 0x98, 0xda, 0xb2, 0xa7,
+	0xc1, 0xdb, 0x57, 0x47, 0x69, 0x40, 0x39, 0x18, 0x44, 0x51, 0x19, 0x12, 0xee, 0x90, 0x5b, 0x21,
+	0xe1, 0x0e, 0xd1, 0x26, 0xa4, 0xc8, 0x3a, 0xa9, 0x05, 0xca, 0xa1, 0x43, 0x71, 0xc9, 0xc6, 0xa5,
+	0x85, 0x55, 0xca, 0xab, 0x28, 0x20, 0x8d, 0x07, 0xd6, 0x71, 0xbd, 0xca, 0x4d, 0xa8, 0x8c, 0xc5,
+	0x4d, 0xdf, 0x36, 0xc6, 0xfd, 0xdb, 0xa8, 0x54, 0xa0, 0x14, 0x08, 0x91, 0xca, 0x55, 0x58, 0x09,
+	0x8b, 0x77, 0xca, 0x87, 0xb0, 0x12, 0x16, 0xb5, 0xd0, 0x5b, 0x90, 0xf3, 0x02, 0x1e, 0xbb, 0x99,
+	0xcf, 0x85, 0xac, 0x43, 0xb0, 0xab, 0x1e, 0x33, 0xb9, 0x94, 0xe4, 0x7c, 0xd3, 0x83, 0x91, 0xa0,
+	0x53, 0xcf, 0xea, 0x96, 0x55, 0xd7, 0x9d, 0x8e, 0xf2, 0x04, 0xaa, 0xd3, 0x42, 0xd9, 0xd8, 0x42,
+	0x52, 0xde, 0x79, 0xbc, 0x0a, 0x99, 0x53, 0xd3, 0xee, 0xe9, 0x2e, 0x55, 0x56, 0x52, 0x79, 0x8f,
+	0x9c, 0x53, 0x16, 0xd6, 0x92, 0x94, 0xcc, 0x3a, 0x8a, 0x06, 0xcf, 0x4e, 0x0d, 0x66, 0x44, 0xc4,
+	0xe8, 0xb7, 0x31, 0xb3, 0x68, 0x49, 0x65, 0x9d, 0x91, 0x22, 0x36, 0x59, 0xd6, 0x21, 0xc3, 0x3a,
+	0xb8, 0x4f, 0x8e, 0x71, 0x92, 0x5e, 0x1a, 0xde, 0x53, 0x3e, 0x4f, 0xc2, 0xd5, 0xf0, 0x80, 0x86,
+	0xae, 0x41, 0xb1, 0xa7, 0x0f, 0x35, 0x77, 0xc8, 0x6f, 0x35, 0xdb, 0x10, 0xe8, 0xe9, 0xc3, 0xc6,
+	0x90, 0x5d, 0x69, 0x09, 0x92, 0xee, 0xd0, 0xa9, 0x26, 0xae, 0x25, 0x6f, 0x14, 0x55, 0xd2, 0x44,
+	0x8f, 0x61, 0xa9, 0x6b, 0xb6, 0xf4, 0xae, 0xe6, 0x3b, 0xf9, 0xfc, 0xd0, 0xbf, 0x14, 0x62, 0x6e,
+	0x16, 0x98, 0x70, 0x7b, 0xe2, 0xf0, 0x57, 0xa8, 0x96, 0x7d, 0xef, 0x06, 0xa0, 0xf7, 0xa0, 0xd0,
+	0x1b, 0x1d, 0xe7, 0x85, 0x0e, 0xbd, 0x5f, 0xd0, 0xb7, 0x2d, 0xe9, 0x80, 0x9b, 0x10, 0x4e, 0x3b,
+	0xb3, 0xb0, 0xd3, 0xfe, 0x01, 0xac, 0xf4, 0xf1, 0xd0, 0xf5, 0x5d, 0x49, 0x76, 0x56, 0xb2, 0xd4,
+	0xfc, 0x88, 0x7c, 0x1b, 0x5d, 0x36, 0x72, 0x6c, 0xd0, 0x4d, 0x9a, 0x16, 0x58, 0xa6, 0x83, 0x6d,
+	0x4d, 0x6f, 0xb7, 0x6d, 0xec, 0x38, 0x34, 0xc1, 0x2d, 0xaa, 0x15, 0x41, 0xdf, 0x62, 0x64, 0xe5,
+	0x53, 0xff, 0xf6, 0x04, 0x93, 0x00, 0x6e, 0xfc, 0xf8, 0xc8, 0xf8, 0x8f, 0x60, 0x85, 0xcb, 0xb7,
+	0x03, 0xf6, 0x4f, 0x44, 0x77, 0x3a, 0x48, 0x28, 0x98, 0x6e, 0xfa, 0xe4, 0x77, 0x35, 0xbd, 0xf0,
+	0xae, 0x29, 0x9f, 0x77, 0xfd, 0x2f, 0xdb, 0x8e, 0x77, 0xbd, 0xa8, 0x31, 0x4a, 0xa9, 0x42, 0xa3,
+	0xc6, 0x68, 0x5d, 0x89, 0x80, 0x1b, 0xfb, 0x3c, 0x0e, 0xf2, 0xf4, 0x0c, 0x2a, 0x54, 0xd5, 0x2b,
+	0xb0, 0xe4, 0xad, 0xc5, 0x9b, 0x1f, 0xbb, 0xdb, 0x92, 0xf7, 0x81, 0x4f, 0x70, 0x6a, 0x14, 0x7c,
+	0x09, 0xca, 0x63, 0x19, 0x1e, 0xdb, 0x85, 0xd2, 0x85, 0x7f, 0x7c, 0xe5, 0x0f, 0x05, 0xc8, 0xa9,
+	0xd8, 0xb1, 0xcc, 0xbe, 0x83, 0xd1, 0x5d, 0xc8, 0xe3, 0x61, 0x0b, 0x5b, 0xae, 0xc8, 0xb0, 0xa6,
+	0xe5, 0x98, 0x8c, 0xbf, 0x26, 0x78, 0x09, 0x7e, 0xf2, 0x04, 0xd1, 0x9b, 0x1c, 0x32, 0xcf, 0xc2,
+	0xbe, 0x5c, 0x81, 0x1f, 0x33, 0xdf, 0x16, 0x98, 0x39, 0x39, 0x03, 0x32, 0x31, 0xb9, 0x31, 0xd0,
+	0xfc, 0x26, 0x07, 0xcd, 0xa9, 0xb9, 0x03, 0x06, 0x50, 0x73, 0x2d, 0x80, 0x9a, 0x33, 0x73, 0x97,
+	0x3b, 0x05, 0x36, 0xdf, 0x16, 0xb0, 0x39, 0x3b, 0x77, 0xde, 0x63, 0xb8, 0xb9, 0x1e, 0xc4, 0xcd,
+	0xb9, 0xa9, 0x4e, 0x53, 0xc8, 0x4f, 0x05, 0xce, 0xef, 0xfa, 0x80, 0x73, 0x7e, 0x06, 0x62, 0x65,
+	0x6a, 0x42, 0x90, 0x73, 0x2d, 0x80, 0x9c, 0x61, 0xae, 0x2d, 0xa6, 0x40, 0xe7, 0x6d, 0x3f, 0x74,
+	0x2e, 0xcc, 0xc0, 0xdf, 0x7c, 0xff, 0xc3, 0xb0, 0xf3, 0x1d, 0x0f, 0x3b, 0x17, 0x67, 0x94, 0x00,
+	0xf8, 0x4a, 0xc6, 0xc1, 0xf3, 0xc3, 0x09, 0xf0, 0xcc, 0xa0, 0xee, 0x8d, 0x19, 0x4a, 0xe6, 0xa0,
+	0xe7, 0x87, 0x13, 0xe8, 0xb9, 0x3c, 0x57, 0xe5, 0x1c, 0xf8, 0xfc, 0x8b, 0x70, 0xf8, 0x3c, 0x0b,
+	0xde, 0xf2, 0xa9, 0x46, 0xc3, 0xcf, 0xfa, 0x14, 0xfc, 0x2c, 0xcd, 0xc0, 0x78, 0x6c, 0x80, 0xc8,
+	0x00, 0xfa, 0x71, 0x08, 0x80, 0x66, 0x40, 0xf7, 0xd6, 0x0c, 0xf5, 0x11, 0x10, 0xf4, 0xe3, 0x10,
+	0x04, 0x8d, 0x22, 0x28, 0x9e, 0x0b, 0xa1, 0xeb, 0x41, 0x08, 0xbd, 0x3c, 0xf7, 0xb6, 0x4d, 0xc5,
+	0xd0, 0xed, 0x69, 0x18, 0x7a, 0x85, 0xea, 0x5c, 0x9f, 0xa1, 0xf3, 0xbb, 0x81, 0xe8, 0xb4, 0x94,
+	0x51, 0x6e, 0xc2, 0x92, 0x50, 0xe2, 0xf9, 0x5d, 0x92, 0xf9, 0x61, 0xdb, 0x36, 0x6d, 0x0e, 0x87,
+	0x59, 0x47, 0xb9, 0x01, 0x45, 0x8f, 0x75, 0x36, 0xe0, 0xa6, 0x39, 0xb6, 0xcf, 0xa7, 0x2a, 0x7f,
+	0x8a, 0x43, 0xd1, 0xef, 0x2c, 0x03, 0x60, 0x2c, 0xcf, 0xc1, 0x98, 0x0f, 0x86, 0x27, 0x82, 0x30,
+	0x7c, 0x0d, 0x0a, 0x24, 0x73, 0x1e, 0x43, 0xd8, 0xba, 0xe5, 0x21, 0xec, 0x5b, 0xb0, 0x44, 0xf3,
+	0x14, 0x06, 0xd6, 0x79, 0xe0, 0x4a, 0xd1, 0xc0, 0x55, 0x21, 0x1f, 0x98, 0x3b, 0xa0, 0x64, 0xf4,
+	0x1a, 0x2c, 0xfb, 0x78, 0xbd, 0x8c, 0x9c, 0x41, 0x4d, 0xc9, 0xe3, 0xde, 0xe2, 0xa9, 0xf9, 0x97,
+	0x71, 0x58, 0x9a, 0x70, 0xd5, 0xa1, 0x28, 0x3a, 0xfe, 0x6f, 0x43, 0xd1, 0x89, 0xef, 0x81, 0xa2,
+	0xfd, 0x28, 0x23, 0x19, 0x44, 0x19, 0xff, 0x8c, 0x43, 0x29, 0x10, 0x33, 0xc8, 0x36, 0xb4, 0xcc,
+	0x36, 0xe6, 0x79, 0x3f, 0x6d, 0x93, 0x74, 0xb0, 0x6b, 0x9e, 0xf1, 0xec, 0x9e, 0x34, 0x09, 0x97,
+	0x17, 0x08, 0xf3, 0x3c, 0xca, 0x79, 0x90, 0x81, 0xa5, 0x5b, 0xac, 0x43, 0x64, 0xcf, 0x31, 0xab,
+	0xf4, 0x16, 0x55, 0xd2, 0x44, 0x2b, 0xfc, 0xb8, 0xf1, 0xb4, 0x89, 0x75, 0xd0, 0x3b, 0x90, 0xa7,
+	0x15, 0x7b, 0xcd, 0xb4, 0x9c, 0x6a, 0x2e, 0x2c, 0xab, 0x64, 0x65, 0xf9, 0xf5, 0x23, 0xc2, 0x75,
+	0x68, 0x39, 0x6a, 0xce, 0xe2, 0x2d, 0x5f, 0x66, 0x92, 0x0f, 0x64, 0x26, 0xcf, 0x43, 0x9e, 0xcc,
+	0xdf, 0xb1, 0xf4, 0x16, 0xa6, 0xa1, 0x26, 0xaf, 0x8e, 0x08, 0xca, 0x13, 0x40, 0x93, 0xe1, 0x0e,
+	0xdd, 0x87, 0x0c, 0xbe, 0xc0, 0x7d, 0x97, 0x65, 0xbf, 0x85, 0xcd, 0x6a, 0x18, 0xb4, 0x20, 0x0c,
+	0xdb, 0x55, 0x62, 0xe8, 0x7f, 0x7c, 0xbd, 0x26, 0x31, 0xfe, 0x57, 0xcd, 0x9e, 0xe1, 0xe2, 0x9e,
+	0xe5, 0x5e, 0xaa, 0x5c, 0x83, 0xf2, 0x75, 0x02, 0x2a, 0x62, 0x08, 0x81, 0x7f, 0xc3, 0xec, 0x2b,
+	0x8e, 0x7e, 0xc2, 0x57, 0x87, 0x88, 0x66, 0xf3, 0x55, 0x80, 0x33, 0xdd, 0xd1, 0x9e, 0xea, 0x7d,
+	0x17, 0xb7, 0xb9, 0xe1, 0x7d, 0x14, 0x24, 0x43, 0x8e, 0xf4, 0x06, 0x0e, 0x6e, 0xf3, 0x92, 0x88,
+	0xd7, 0xf7, 0xad, 0x34, 0xfb, 0x7d, 0x57, 0x1a, 0xb4, 0x74, 0x6e, 0xcc, 0xd2, 0x3e, 0x80, 0x98,
+	0xf7, 0x03, 0x44, 0x32, 0x3b, 0xcb, 0x36, 0x4c, 0xdb, 0x70, 0x2f, 0xe9, 0xf6, 0x24, 0x55, 0xaf,
+	0x4f, 0xea, 0x6c, 0x3d, 0xdc, 0xb3, 0x4c, 0xb3, 0xab, 0x31, 0xc7, 0x53, 0xa0, 0xa2, 0x45, 0x4e,
+	0xac, 0x51, 0xff, 0xf3, 0x71, 0x02, 0x96, 0x26, 0x12, 0x85, 0xff, 0x45, 0x13, 0x2b, 0xbf, 0xa5,
+	0xd5, 0xc2, 0x60, 0xb2, 0x83, 0x1e, 0xf9, 0xd3, 0xfb, 0x01, 0x75, 0x0f, 0xe2, 0x58, 0x47, 0xf7,
+	0x24, 0xd2, 0x45, 0x90, 0xec, 0xa0, 0x0f, 0xe0, 0x99, 0x31, 0x4f, 0xe7, 0x29, 0x4f, 0x44, 0x77,
+	0x78, 0x57, 0x82, 0x0e, 0x4f, 0x28, 0x1f, 0x99, 0x2c, 0xf9, 0xbd, 0xef, 0xdf, 0x2e, 0x94, 0x85,
+	0x4d, 0x38, 0xde, 0x0c, 0x3b, 0x06, 0xd7, 0xa1, 0x64, 0x63, 0x97, 0x94, 0x46, 0x03, 0xf0, 0xa6,
+	0xc8, 0x88, 0xbc, 0x74, 0xa8, 0xc2, 0x95, 0xd0, 0x2c, 0x0e, 0xbd, 0x0d, 0xf9, 0x51, 0x0a, 0xc8,
+	0x6c, 0x3b, 0xb3, 0xf8, 0x33, 0xe2, 0x56, 0xfe, 0x1c, 0x87, 0x2b, 0xa1, 0x79, 0x1c, 0xba, 0x07,
+	0x19, 0x1b, 0x3b, 0x83, 0x2e, 0x2b, 0xf0, 0x94, 0x37, 0x37, 0xa2, 0x66, 0x80, 0x84, 0x3a, 0xe8,
+	0xba, 0x2a, 0x17, 0x57, 0x3e, 0x84, 0x0c, 0xa3, 0xa0, 0x02, 0x64, 0x1f, 0x1d, 0xec, 0x1d, 0x1c,
+	0x3e, 0x3e, 0x90, 0x62, 0x08, 0x20, 0xb3, 0xb5, 0xb3, 0x53, 0x3b, 0x6a, 0x48, 0x71, 0x94, 0x87,
+	0xf4, 0xd6, 0xf6, 0xa1, 0xda, 0x90, 0x12, 0x84, 0xac, 0xd6, 0xee, 0xd7, 0x76, 0x1a, 0x52, 0x12,
+	0x2d, 0x41, 0x89, 0xb5, 0xb5, 0xf7, 0x0e, 0xd5, 0x07, 0x5b, 0x0d, 0x29, 0xe5, 0x23, 0x1d, 0xd7,
+	0x0e, 0xee, 0xd6, 0x54, 0x29, 0xcd, 0x24, 0x8e, 0x1f, 0x3d, 0xa8, 0x49, 0x19, 0xe5, 0x87, 0xf0,
+	0xac, 0x98, 0xd3, 0x64, 0xc9, 0xca, 0xab, 0x1c, 0xc5, 0x7d, 0x95, 0x23, 0xe5, 0x77, 0x09, 0x90,
+	0x85, 0x4c, 0x48, 0x11, 0x6a, 0x7f, 0xcc, 0x0c, 0x6f, 0x2c, 0x94, 0x4f, 0x8e, 0xd9, 0x82, 0xe0,
+	0x54, 0x1b, 0x9f, 0x62, 0xb7, 0xd5, 0x61, 0x49, 0x2a, 0x0b, 0xaa, 0x25, 0xb5, 0xc4, 0xa9, 0x54,
+	0xc8, 0x61, 0x6c, 0x1f, 0xe2, 0x96, 0xab, 0x31, 0x2f, 0xc5, 0x0e, 0x62, 0x5e, 0x2d, 0x31, 0xea,
+	0x31, 0x23, 0x2a, 0x4f, 0x16, 0xb2, 0x6c, 0x1e, 0xd2, 0x6a, 0xad, 0xa1, 0xbe, 0x2f, 0x25, 0x11,
+	0x82, 0x32, 0x6d, 0x6a, 0xc7, 0x07, 0x5b, 0x47, 0xc7, 0xf5, 0x43, 0x62, 0xd9, 0x65, 0xa8, 0x08,
+	0xcb, 0x0a, 0x62, 0x5a, 0x79, 0x05, 0x9e, 0x99, 0x92, 0xcd, 0x4e, 0xd6, 0x67, 0x94, 0xdf, 0xc7,
+	0xfd, 0xdc, 0xc1, 0x7c, 0xf4, 0x21, 0x64, 0x1c, 0x57, 0x77, 0x07, 0x0e, 0x37, 0xe3, 0xdb, 0xd1,
+	0xd3, 0xdb, 0x75, 0xd1, 0x38, 0xa6, 0x0a, 0x54, 0xae, 0x48, 0x79, 0x13, 0xca, 0xc1, 0x2f, 0xd3,
+	0xad, 0x30, 0x3a, 0x54, 0x09, 0xe5, 0xce, 0x28, 0xe4, 0xfa, 0x8a, 0x1c, 0x93, 0x05, 0x84, 0x78,
+	0x58, 0x01, 0xe1, 0x8f, 0x71, 0x78, 0x6e, 0x46, 0x76, 0x8b, 0x8e, 0xc7, 0x96, 0x79, 0x67, 0xb1,
+	0xec, 0x78, 0x9d, 0xd1, 0xc6, 0x16, 0xfa, 0x3a, 0x14, 0xfd, 0xf4, 0x68, 0xcb, 0xfc, 0x00, 0xc0,
+	0x57, 0x5c, 0x5f, 0x81, 0xb4, 0x6d, 0x0e, 0xfa, 0x6d, 0x3a, 0xad, 0xb4, 0xca, 0x3a, 0xe4, 0x1f,
+	0x38, 0x59, 0x9e, 0xc8, 0xec, 0xc2, 0x7c, 0x06, 0x99, 0x9e, 0xaf, 0x7e, 0xc6, 0xf8, 0x95, 0x73,
+	0x40, 0x93, 0xa5, 0xcd, 0x29, 0x83, 0xbc, 0x1b, 0x1c, 0xe4, 0xfa, 0x8c, 0x32, 0x69, 0xf8, 0x60,
+	0xbf, 0x82, 0x34, 0x75, 0xb6, 0xc4, 0x71, 0xd2, 0x32, 0x3d, 0xcf, 0xce, 0x49, 0x1b, 0x69, 0x00,
+	0xba, 0xeb, 0xda, 0x46, 0x73, 0x30, 0x1a, 0xe2, 0xc5, 0x69, 0xee, 0x7a, 0x4b, 0x70, 0x6e, 0x3f,
+	0xcf, 0xfd, 0xf6, 0xca, 0x48, 0xd8, 0xe7, 0xbb, 0x7d, 0x2a, 0x95, 0x03, 0x28, 0x07, 0x65, 0x45,
+	0x36, 0xc9, 0x66, 0x11, 0xcc, 0x26, 0x19, 0x40, 0x60, 0x9d, 0x51, 0x2e, 0x9a, 0x64, 0x7f, 0x66,
+	0x68, 0x47, 0xf9, 0x34, 0x0e, 0xb9, 0xc6, 0x90, 0x5f, 0xdb, 0x29, 0x7f, 0x03, 0x46, 0xa2, 0x09,
+	0x7f, 0xe5, 0x9b, 0xfd, 0x5e, 0x48, 0x7a, 0xbf, 0x2d, 0xb6, 0x3d, 0xd7, 0x94, 0x8a, 0x5e, 0xc2,
+	0x10, 0x3f, 0x71, 0xb8, 0x73, 0xbe, 0x03, 0x79, 0x2f, 0xe4, 0x12, 0xa8, 0x23, 0x0a, 0x70, 0x71,
+	0x9e, 0xa3, 0xb3, 0x2e, 0x99, 0x90, 0x65, 0x3e, 0xe5, 0xd5, 0xf5, 0xa4, 0xca, 0x3a, 0xca, 0x29,
+	0x54, 0xc6, 0xe2, 0x35, 0xfa, 0x31, 0x64, 0xad, 0x41, 0x53, 0x13, 0x06, 0x9a, 0x28, 0xa4, 0x8a,
+	0x04, 0x7a, 0xd0, 0xec, 0x1a, 0xad, 0x3d, 0x7c, 0x29, 0xa6, 0x63, 0x0d, 0x9a, 0x7b, 0xcc, 0x92,
+	0x6c, 0x9c, 0x84, 0x7f, 0x9c, 0x21, 0xe4, 0xc4, 0xd1, 0x40, 0x3f, 0x85, 0xbc, 0x97, 0x0c, 0xf0,
+	0x21, 0x9e, 0x9f, 0x95, 0x47, 0xf0, 0x01, 0x46, 0x42, 0x04, 0x95, 0x39, 0xc6, 0x59, 0x5f, 0x14,
+	0x91, 0x59, 0xf9, 0x26, 0x41, 0xf7, 0xa8, 0xc2, 0x3e, 0xec, 0x0b, 0xb4, 0x45, 0xee, 0xbb, 0x34,
+	0x7e, 0x3a, 0xff, 0xb3, 0x53, 0x08, 0xf1, 0x4c, 0xc9, 0x30, 0xcf, 0xf4, 0x71, 0x02, 0x0a, 0xbe,
+	0x02, 0x35, 0xfa, 0x91, 0xef, 0xb2, 0x94, 0x43, 0x53, 0x2d, 0x1f, 0xf7, 0xe8, 0xbf, 0x56, 0x70,
+	0x71, 0x89, 0xef, 0xb2, 0xb8, 0x69, 0x35, 0x5a, 0x51, 0xf3, 0x4e, 0x2d, 0x5c, 0xf3, 0x7e, 0x15,
+	0x90, 0x6b, 0xba, 0x7a, 0x97, 0x54, 0x20, 0x8c, 0xfe, 0x99, 0xc6, 0x8e, 0x08, 0x4b, 0x83, 0x25,
+	0xfa, 0xe5, 0x84, 0x7e, 0x38, 0xa2, 0xa7, 0xe5, 0xd7, 0x71, 0xc8, 0x79, 0x59, 0xcc, 0xa2, 0xbf,
+	0xa9, 0xae, 0x42, 0x86, 0x07, 0x66, 0xf6, 0x9f, 0x8a, 0xf7, 0x42, 0x8b, 0xfb, 0x32, 0xe4, 0x7a,
+	0xd8, 0xd5, 0x69, 0x32, 0xc7, 0x70, 0xba, 0xd7, 0xbf, 0xf5, 0x36, 0x14, 0x7c, 0xff, 0x0c, 0x89,
+	0xcf, 0x38, 0xa8, 0x3d, 0x96, 0x62, 0x72, 0xf6, 0x93, 0x2f, 0xae, 0x25, 0x0f, 0xf0, 0x53, 0x72,
+	0xd7, 0xd4, 0xda, 0x4e, 0xbd, 0xb6, 0xb3, 0x27, 0xc5, 0xe5, 0xc2, 0x27, 0x5f, 0x5c, 0xcb, 0xaa,
+	0x98, 0xd6, 0x27, 0x6f, 0xed, 0x41, 0x65, 0x6c, 0x6b, 0x82, 0x1e, 0x1f, 0x41, 0xf9, 0xee, 0xa3,
+	0xa3, 0xfd, 0xdd, 0x9d, 0xad, 0x46, 0x4d, 0x3b, 0x39, 0x6c, 0xd4, 0xa4, 0x38, 0x7a, 0x06, 0x96,
+	0xf7, 0x77, 0xef, 0xd5, 0x1b, 0xda, 0xce, 0xfe, 0x6e, 0xed, 0xa0, 0xa1, 0x6d, 0x35, 0x1a, 0x5b,
+	0x3b, 0x7b, 0x52, 0x62, 0xf3, 0xd3, 0x12, 0x54, 0xb6, 0xb6, 0x77, 0x76, 0x49, 0x6a, 0x62, 0xb4,
+	0x74, 0x5a, 0x47, 0xb9, 0x07, 0x29, 0x5a, 0x29, 0x99, 0xf3, 0xbe, 0x4b, 0x9e, 0x57, 0xcc, 0x46,
+	0xf7, 0x21, 0x4d, 0x0b, 0x29, 0x68, 0xde, 0x93, 0x2f, 0x79, 0x6e, 0x7d, 0x9b, 0x4c, 0x8a, 0x5e,
+	0xae, 0x39, 0xaf, 0xc0, 0xe4, 0x79, 0x05, 0x6f, 0x74, 0x02, 0xf9, 0x11, 0x0c, 0x8b, 0xf2, 0x1e,
+	0x4a, 0x8e, 0xe4, 0x37, 0xd1, 0x11, 0x64, 0x05, 0x7e, 0x9e, 0xff, 0x4e, 0x4b, 0x8e, 0x50, 0x91,
+	0x26, 0xe6, 0x63, 0xf5, 0x8e, 0x79, 0x8f, 0xcf, 0xe4, 0xb9, 0x65, 0x76, 0xf4, 0x00, 0x32, 0x1c,
+	0x5e, 0xcc, 0x7d, 0x7d, 0x25, 0xcf, 0xaf, 0x31, 0x13, 0x23, 0x8e, 0xaa, 0x4a, 0x51, 0x1e, 0xd7,
+	0xc9, 0x91, 0xfe, 0x25, 0xa0, 0xf7, 0x01, 0x7c, 0x75, 0x8e, 0x48, 0xaf, 0xe6, 0xe4, 0x68, 0xff,
+	0x08, 0xd0, 0x31, 0xe4, 0x3c, 0xd0, 0x19, 0xe1, 0x05, 0x9b, 0x1c, 0xa5, 0x54, 0x8f, 0x9a, 0x50,
+	0x0a, 0x42, 0xad, 0xa8, 0xef, 0xd2, 0xe4, 0xc8, 0x35, 0x78, 0x32, 0x46, 0x10, 0x79, 0x45, 0x7d,
+	0xa9, 0x26, 0x47, 0x2e, 0xca, 0xa3, 0x3e, 0x2c, 0x4d, 0xe2, 0xa1, 0x45, 0x9e, 0xae, 0xc9, 0x0b,
+	0x15, 0xea, 0xd1, 0x47, 0x80, 0x42, 0xb0, 0xd4, 0x42, 0x6f, 0xd9, 0xe4, 0xc5, 0x2a, 0xf7, 0xa8,
+	0x03, 0x95, 0x71, 0x88, 0x12, 0xfd, 0x75, 0x9b, 0xbc, 0x40, 0x1d, 0x9f, 0x8d, 0x14, 0x84, 0x37,
+	0xd1, 0xdf, 0xbb, 0xc9, 0x0b, 0x14, 0xf6, 0xc9, 0x75, 0xf1, 0x61, 0x94, 0x48, 0x2f, 0xe0, 0xe4,
+	0x68, 0x45, 0x7e, 0xe4, 0xc2, 0x72, 0x18, 0x80, 0x59, 0xec, 0x49, 0x9c, 0xbc, 0x60, 0xf5, 0x7f,
+	0x7b, 0xf7, 0xcb, 0x6f, 0x56, 0xe3, 0x5f, 0x7d, 0xb3, 0x1a, 0xff, 0xfb, 0x37, 0xab, 0xf1, 0xcf,
+	0xbe, 0x5d, 0x8d, 0x7d, 0xf5, 0xed, 0x6a, 0xec, 0xaf, 0xdf, 0xae, 0xc6, 0x7e, 0xb6, 0x71, 0x66,
+	0xb8, 0x9d, 0x41, 0x73, 0xbd, 0x65, 0xf6, 0x36, 0xf6, 0xde, 0x3f, 0xa9, 0x1d, 0x60, 0xf7, 0xa9,
+	0x69, 0x9f, 0x6f, 0x08, 0xfd, 0xaf, 0x8d, 0xbd, 0xc4, 0x6e, 0x66, 0x68, 0xe2, 0xf0, 0xfa, 0xbf,
+	0x06, 0x00, 0x93, 0xd9, 0xf6, 0xbd, 0xab, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3685,6 +3999,8 @@ type ABCIApplicationClient interface {
 	ApplySnapshotChunk(ctx context.Context, in *RequestApplySnapshotChunk, opts ...grpc.CallOption) (*ResponseApplySnapshotChunk, error)
 	PrepareProposal(ctx context.Context, in *RequestPrepareProposal, opts ...grpc.CallOption) (*ResponsePrepareProposal, error)
 	ProcessProposal(ctx context.Context, in *RequestProcessProposal, opts ...grpc.CallOption) (*ResponseProcessProposal, error)
+	ExtendVote(ctx context.Context, in *RequestExtendVote, opts ...grpc.CallOption) (*ResponseExtendVote, error)
+	VerifyVoteExtension(ctx context.Context, in *RequestVerifyVoteExtension, opts ...grpc.CallOption) (*ResponseVerifyVoteExtension, error)
 }
 
 type aBCIApplicationClient struct {
@@ -3839,6 +4155,24 @@ func (c *aBCIApplicationClient) ProcessProposal(ctx context.Context, in *Request
 	return out, nil
 }
 
+func (c *aBCIApplicationClient) ExtendVote(ctx context.Context, in *RequestExtendVote, opts ...grpc.CallOption) (*ResponseExtendVote, error) {
+	out := new(ResponseExtendVote)
+	err := c.cc.Invoke(ctx, "/celestiacore.abci.ABCIApplication/ExtendVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIApplicationClient) VerifyVoteExtension(ctx context.Context, in *RequestVerifyVoteExtension, opts ...grpc.CallOption) (*ResponseVerifyVoteExtension, error) {
+	out := new(ResponseVerifyVoteExtension)
+	err := c.cc.Invoke(ctx, "/celestiacore.abci.ABCIApplication/VerifyVoteExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ABCIApplicationServer is the server API for ABCIApplication service.
 type ABCIApplicationServer interface {
 	Echo(context.Context, *RequestEcho) (*ResponseEcho, error)
@@ -3857,6 +4191,8 @@ type ABCIApplicationServer interface {
 	ApplySnapshotChunk(context.Context, *RequestApplySnapshotChunk) (*ResponseApplySnapshotChunk, error)
 	PrepareProposal(context.Context, *RequestPrepareProposal) (*ResponsePrepareProposal, error)
 	ProcessProposal(context.Context, *RequestProcessProposal) (*ResponseProcessProposal, error)
+	ExtendVote(context.Context, *RequestExtendVote) (*ResponseExtendVote, error)
+	VerifyVoteExtension(context.Context, *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error)
 }
 
 // UnimplementedABCIApplicationServer can be embedded to have forward compatible implementations.
@@ -3911,6 +4247,12 @@ func (*UnimplementedABCIApplicationServer) PrepareProposal(ctx context.Context,
 func (*UnimplementedABCIApplicationServer) ProcessProposal(ctx context.Context, req *RequestProcessProposal) (*ResponseProcessProposal, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessProposal not implemented")
 }
+func (*UnimplementedABCIApplicationServer) ExtendVote(ctx context.Context, req *RequestExtendVote) (*ResponseExtendVote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendVote not implemented")
+}
+func (*UnimplementedABCIApplicationServer) VerifyVoteExtension(ctx context.Context, req *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyVoteExtension not implemented")
+}
 
 func RegisterABCIApplicationServer(s grpc1.Server, srv ABCIApplicationServer) {
 	s.RegisterService(&_ABCIApplication_serviceDesc, srv)
@@ -4204,20 +4546,56 @@ func _ABCIApplication_ProcessProposal_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var ABCIApplication_serviceDesc = _ABCIApplication_serviceDesc
-var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestiacore.abci.ABCIApplication",
-	HandlerType: (*ABCIApplicationServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Echo",
-			Handler:    _ABCIApplication_Echo_Handler,
-		},
-		{
-			MethodName: "Flush",
-			Handler:    _ABCIApplication_Flush_Handler,
-		},
-		{
+func _ABCIApplication_ExtendVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExtendVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).ExtendVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestiacore.abci.ABCIApplication/ExtendVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).ExtendVote(ctx, req.(*RequestExtendVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_VerifyVoteExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestVerifyVoteExtension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).VerifyVoteExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestiacore.abci.ABCIApplication/VerifyVoteExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).VerifyVoteExtension(ctx, req.(*RequestVerifyVoteExtension))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var ABCIApplication_serviceDesc = _ABCIApplication_serviceDesc
+var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestiacore.abci.ABCIApplication",
+	HandlerType: (*ABCIApplicationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Echo",
+			Handler:    _ABCIApplication_Echo_Handler,
+		},
+		{
+			MethodName: "Flush",
+			Handler:    _ABCIApplication_Flush_Handler,
+		},
+		{
 			MethodName: "Info",
 			Handler:    _ABCIApplication_Info_Handler,
 		},
@@ -4273,6 +4651,14 @@ var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessProposal",
 			Handler:    _ABCIApplication_ProcessProposal_Handler,
 		},
+		{
+			MethodName: "ExtendVote",
+			Handler:    _ABCIApplication_ExtendVote_Handler,
+		},
+		{
+			MethodName: "VerifyVoteExtension",
+			Handler:    _ABCIApplication_VerifyVoteExtension_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestiacore/abci/types.proto",
@@ -4650,6 +5036,52 @@ func (m *Request_ProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Request_ExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_ExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExtendVote != nil {
+		{
+			size, err := m.ExtendVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Request_VerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_VerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VerifyVoteExtension != nil {
+		{
+			size, err := m.VerifyVoteExtension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *RequestEcho) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4815,12 +5247,12 @@ func (m *RequestInitChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err18 != nil {
-		return 0, err18
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
 	}
-	i -= n18
-	i = encodeVarintTypes(dAtA, i, uint64(n18))
+	i -= n20
+	i = encodeVarintTypes(dAtA, i, uint64(n20))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -5237,12 +5669,12 @@ func (m *RequestPrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x3a
 	}
-	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err22 != nil {
-		return 0, err22
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err24 != nil {
+		return 0, err24
 	}
-	i -= n22
-	i = encodeVarintTypes(dAtA, i, uint64(n22))
+	i -= n24
+	i = encodeVarintTypes(dAtA, i, uint64(n24))
 	i--
 	dAtA[i] = 0x32
 	if m.Height != 0 {
@@ -5325,12 +5757,12 @@ func (m *RequestProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x3a
 	}
-	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err24 != nil {
-		return 0, err24
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err26 != nil {
+		return 0, err26
 	}
-	i -= n24
-	i = encodeVarintTypes(dAtA, i, uint64(n24))
+	i -= n26
+	i = encodeVarintTypes(dAtA, i, uint64(n26))
 	i--
 	dAtA[i] = 0x32
 	if m.Height != 0 {
@@ -5381,6 +5813,90 @@ func (m *RequestProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestExtendVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestVerifyVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestVerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestVerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5776,6 +6292,52 @@ func (m *Response_ProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Response_ExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_ExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExtendVote != nil {
+		{
+			size, err := m.ExtendVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Response_VerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_VerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VerifyVoteExtension != nil {
+		{
+			size, err := m.VerifyVoteExtension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ResponseException) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6493,20 +7055,20 @@ func (m *ResponseApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 		}
 	}
 	if len(m.RefetchChunks) > 0 {
-		dAtA47 := make([]byte, len(m.RefetchChunks)*10)
-		var j46 int
+		dAtA51 := make([]byte, len(m.RefetchChunks)*10)
+		var j50 int
 		for _, num := range m.RefetchChunks {
 			for num >= 1<<7 {
-				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j46++
+				j50++
 			}
-			dAtA47[j46] = uint8(num)
-			j46++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j46
-		copy(dAtA[i:], dAtA47[:j46])
-		i = encodeVarintTypes(dAtA, i, uint64(j46))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintTypes(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6578,6 +7140,64 @@ func (m *ResponseProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseExtendVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseVerifyVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseVerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseVerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6994,12 +7614,12 @@ func (m *Misbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n52, err52 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err52 != nil {
-		return 0, err52
+	n56, err56 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err56 != nil {
+		return 0, err56
 	}
-	i -= n52
-	i = encodeVarintTypes(dAtA, i, uint64(n52))
+	i -= n56
+	i = encodeVarintTypes(dAtA, i, uint64(n56))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -7292,14 +7912,38 @@ func (m *Request_ProcessProposal) Size() (n int) {
 	}
 	return n
 }
-func (m *RequestEcho) Size() (n int) {
+func (m *Request_ExtendVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
+	if m.ExtendVote != nil {
+		l = m.ExtendVote.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_VerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerifyVoteExtension != nil {
+		l = m.VerifyVoteExtension.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *RequestEcho) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
@@ -7608,6 +8252,46 @@ func (m *RequestProcessProposal) Size() (n int) {
 	return n
 }
 
+func (m *RequestExtendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestVerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Response) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7824,6 +8508,30 @@ func (m *Response_ProcessProposal) Size() (n int) {
 	}
 	return n
 }
+func (m *Response_ExtendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendVote != nil {
+		l = m.ExtendVote.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_VerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerifyVoteExtension != nil {
+		l = m.VerifyVoteExtension.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *ResponseException) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8190,6 +8898,31 @@ func (m *ResponseProcessProposal) Size() (n int) {
 	return n
 }
 
+func (m *ResponseExtendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseVerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
 func (m *CommitInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8991,6 +9724,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Request_ProcessProposal{v}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestExtendVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_ExtendVote{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyVoteExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestVerifyVoteExtension{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_VerifyVoteExtension{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11064,7 +11867,261 @@ func (m *RequestProcessProposal) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidatorsHash = append(m.NextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextValidatorsHash == nil {
+				m.NextValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestExtendVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestExtendVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestExtendVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestVerifyVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestVerifyVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestVerifyVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11074,28 +12131,29 @@ func (m *RequestProcessProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11122,14 +12180,33 @@ func (m *RequestProcessProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextValidatorsHash = append(m.NextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextValidatorsHash == nil {
-				m.NextValidatorsHash = []byte{}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11156,9 +12233,9 @@ func (m *RequestProcessProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -11806,6 +12883,76 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Response_ProcessProposal{v}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseExtendVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_ExtendVote{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyVoteExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseVerifyVoteExtension{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_VerifyVoteExtension{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14219,6 +15366,159 @@ func (m *ResponseProcessProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseExtendVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseExtendVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseExtendVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseVerifyVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseVerifyVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseVerifyVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ResponseVerifyVoteExtension_VerifyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,7 +4,7 @@ Package Behaviour provides a mechanism for reactors to report behaviour of peers
 Instead of a reactor calling the switch directly it will call the behaviour module which will
 handle the stopping and marking peer as good on behalf of the reactor.
 
-There are four different behaviours a reactor can report.
+There are six different behaviours a reactor can report.
 
 1. bad message
 
@@ -12,7 +12,8 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-# This message will request the peer be stopped for an error
+This message will request the peer be stopped for a fault, which also lowers
+its trust score.
 
 2. message out of order
 
@@ -20,7 +21,7 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-# This message will request the peer be stopped for an error
+This message will request the peer be stopped for an error.
 
 3. consesnsus Vote
 
@@ -28,7 +29,7 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-# This message will request the peer be marked as good
+This message will request the peer be marked as good.
 
 4. block part
 
@@ -36,6 +37,23 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-This message will request the peer be marked as good
+This message will request the peer be marked as good.
+
+5. snapshot chunk
+
+	type snapshotChunk struct {
+		explanation string
+	}
+
+This message will request the peer be marked as good.
+
+6. rejected message
+
+	type rejectedMessage struct {
+		explanation string
+	}
+
+This message will request the peer be marked as bad, which lowers its trust
+score without disconnecting from it.
 */
 package behaviour
@@ -47,3 +47,21 @@ type blockPart struct {
 func BlockPart(peerID p2p.ID, explanation string) PeerBehaviour {
 	return PeerBehaviour{peerID: peerID, reason: blockPart{explanation}}
 }
+
+type snapshotChunk struct {
+	explanation string
+}
+
+// SnapshotChunk returns a snapshotChunk PeerBehaviour.
+func SnapshotChunk(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: snapshotChunk{explanation}}
+}
+
+type rejectedMessage struct {
+	explanation string
+}
+
+// RejectedMessage returns a rejectedMessage PeerBehaviour.
+func RejectedMessage(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: rejectedMessage{explanation}}
+}
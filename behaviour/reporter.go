@@ -33,10 +33,12 @@ func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
 	}
 
 	switch reason := behaviour.reason.(type) {
-	case consensusVote, blockPart:
+	case consensusVote, blockPart, snapshotChunk:
 		spbr.sw.MarkPeerAsGood(peer)
+	case rejectedMessage:
+		spbr.sw.MarkPeerAsBad(peer, reason.explanation)
 	case badMessage:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
+		spbr.sw.StopPeerForFault(peer, reason.explanation)
 	case messageOutOfOrder:
 		spbr.sw.StopPeerForError(peer, reason.explanation)
 	default:
@@ -0,0 +1,35 @@
+package blockchain
+
+import (
+	"fmt"
+
+	sm "github.com/KYVENetwork/celestia-core/state"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+// VerifyExtendedCommit verifies the extended commit received along with the
+// block of the given ID and height. Once vote extensions are enabled, it must
+// be present and signed, including the vote extensions, by the validators of
+// the state, so that the extensions can be passed to the application after
+// switching to consensus. Before that, it must be absent.
+func VerifyExtendedCommit(
+	state sm.State,
+	blockID types.BlockID,
+	height int64,
+	extCommit *types.ExtendedCommit,
+) error {
+	if !state.ConsensusParams.VoteExtensionsEnabled(height) {
+		if extCommit != nil {
+			return fmt.Errorf("unexpected extended commit for height %d, vote extensions are disabled", height)
+		}
+		return nil
+	}
+
+	if extCommit == nil {
+		return fmt.Errorf("missing extended commit for height %d", height)
+	}
+	if err := state.Validators.VerifyCommitLight(state.ChainID, blockID, height, extCommit.ToCommit()); err != nil {
+		return fmt.Errorf("invalid extended commit: %w", err)
+	}
+	return extCommit.VerifyExtensions(state.ChainID, state.Validators)
+}
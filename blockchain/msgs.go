@@ -32,10 +32,20 @@ func ValidateMsg(pb proto.Message) error {
 			return errors.New("negative Height")
 		}
 	case *bcproto.BlockResponse:
-		_, err := types.BlockFromProto(msg.Block)
+		block, err := types.BlockFromProto(msg.Block)
 		if err != nil {
 			return err
 		}
+		if msg.ExtCommit != nil {
+			extCommit, err := types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				return err
+			}
+			if extCommit.Height != block.Height {
+				return fmt.Errorf("extended commit height %d does not match block height %d",
+					extCommit.Height, block.Height)
+			}
+		}
 	case *bcproto.NoBlockResponse:
 		if msg.Height < 0 {
 			return errors.New("negative Height")
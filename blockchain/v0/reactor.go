@@ -183,9 +183,11 @@ func (bcR *BlockchainReactor) respondToPeer(msg *bcproto.BlockRequest,
 			bcR.Logger.Error("could not convert msg to protobuf", "err", err)
 			return false
 		}
+		// The extended commit is only stored once vote extensions are enabled.
+		extCommit := bcR.store.LoadBlockExtendedCommit(msg.Height)
 		return p2p.TrySendEnvelopeShim(src, p2p.Envelope{ //nolint: staticcheck
 			ChannelID: BlockchainChannel,
-			Message:   &bcproto.BlockResponse{Block: bl},
+			Message:   &bcproto.BlockResponse{Block: bl, ExtCommit: extCommit.ToProto()},
 		}, bcR.Logger)
 	}
 
@@ -198,7 +200,7 @@ func (bcR *BlockchainReactor) respondToPeer(msg *bcproto.BlockRequest,
 func (bcR *BlockchainReactor) ReceiveEnvelope(e p2p.Envelope) {
 	if err := bc.ValidateMsg(e.Message); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
-		bcR.Switch.StopPeerForError(e.Src, err)
+		bcR.Switch.StopPeerForFault(e.Src, err)
 		return
 	}
 
@@ -213,7 +215,15 @@ func (bcR *BlockchainReactor) ReceiveEnvelope(e p2p.Envelope) {
 			bcR.Logger.Error("Block content is invalid", "err", err)
 			return
 		}
-		bcR.pool.AddBlock(e.Src.ID(), bi, msg.Block.Size())
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				bcR.Logger.Error("Extended commit content is invalid", "err", err)
+				return
+			}
+		}
+		bcR.pool.AddBlock(e.Src.ID(), bi, extCommit, msg.Block.Size())
 	case *bcproto.StatusRequest:
 		// Send peer our state.
 		p2p.TrySendEnvelopeShim(e.Src, p2p.Envelope{ //nolint: staticcheck
@@ -346,7 +356,7 @@ FOR_LOOP:
 			// routine.
 
 			// See if there are any blocks to sync.
-			first, second := bcR.pool.PeekTwoBlocks()
+			first, second, firstExtCommit := bcR.pool.PeekTwoBlocks()
 			// bcR.Logger.Info("TrySync peeked", "first", first, "second", second)
 			if first == nil || second == nil {
 				// We need both to sync the first block.
@@ -371,6 +381,10 @@ FOR_LOOP:
 				err = bcR.blockExec.ValidateBlock(state, first)
 			}
 
+			if err == nil {
+				err = bc.VerifyExtendedCommit(state, firstID, first.Height, firstExtCommit)
+			}
+
 			if err == nil {
 				var stateMachineValid bool
 				// Block sync doesn't check that the `Data` in a block is valid.
@@ -405,10 +419,20 @@ FOR_LOOP:
 				continue FOR_LOOP
 			}
 
-			bcR.pool.PopRequest()
+			if peer := bcR.Switch.Peers().Get(bcR.pool.PopRequest()); peer != nil {
+				bcR.Switch.MarkPeerAsGood(peer)
+			}
 
 			// TODO: batch saves so we dont persist to disk every block
-			bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+			if firstExtCommit != nil {
+				// Keep the vote extensions around for the proposer of the
+				// next height, in case we switch to consensus.
+				if err := bcR.store.SaveBlockWithExtendedCommit(first, firstParts, firstExtCommit); err != nil {
+					panic(fmt.Sprintf("Failed to save block with extended commit (%d:%X): %v", first.Height, first.Hash(), err))
+				}
+			} else {
+				bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+			}
 
 			// TODO: same thing for app - but we would need a way to
 			// get the hash without persisting the state
@@ -320,6 +320,7 @@ func makeBlock(height int64, state sm.State, lastCommit *types.Commit) *types.Bl
 		lastCommit,
 		nil,
 		state.Validators.GetProposer().Address,
+		cmttime.Now(),
 	)
 	return block
 }
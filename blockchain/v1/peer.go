@@ -27,10 +27,11 @@ type BpPeer struct {
 	logger log.Logger
 	ID     p2p.ID
 
-	Base                    int64                  // the peer reported base
-	Height                  int64                  // the peer reported height
-	NumPendingBlockRequests int                    // number of requests still waiting for block responses
-	blocks                  map[int64]*types.Block // blocks received or expected to be received from this peer
+	Base                    int64                           // the peer reported base
+	Height                  int64                           // the peer reported height
+	NumPendingBlockRequests int                             // number of requests still waiting for block responses
+	blocks                  map[int64]*types.Block          // blocks received or expected to be received from this peer
+	extCommits              map[int64]*types.ExtendedCommit // extended commits received along with the blocks
 	blockResponseTimer      *time.Timer
 	recvMonitor             *flow.Monitor
 	params                  *BpPeerParams // parameters for timer and monitor
@@ -46,13 +47,14 @@ func NewBpPeer(peerID p2p.ID, base int64, height int64,
 		params = BpPeerDefaultParams()
 	}
 	return &BpPeer{
-		ID:     peerID,
-		Base:   base,
-		Height: height,
-		blocks: make(map[int64]*types.Block, maxRequestsPerPeer),
-		logger: log.NewNopLogger(),
-		onErr:  onErr,
-		params: params,
+		ID:         peerID,
+		Base:       base,
+		Height:     height,
+		blocks:     make(map[int64]*types.Block, maxRequestsPerPeer),
+		extCommits: make(map[int64]*types.ExtendedCommit),
+		logger:     log.NewNopLogger(),
+		onErr:      onErr,
+		params:     params,
 	}
 }
 
@@ -80,6 +82,9 @@ func (peer *BpPeer) Cleanup() {
 	for h := range peer.blocks {
 		delete(peer.blocks, h)
 	}
+	for h := range peer.extCommits {
+		delete(peer.extCommits, h)
+	}
 	peer.NumPendingBlockRequests = 0
 	peer.recvMonitor = nil
 }
@@ -96,9 +101,16 @@ func (peer *BpPeer) BlockAtHeight(height int64) (*types.Block, error) {
 	return peer.blocks[height], nil
 }
 
+// ExtCommitAtHeight returns the extended commit received along with the block
+// at a given height, or nil if there is none.
+func (peer *BpPeer) ExtCommitAtHeight(height int64) *types.ExtendedCommit {
+	return peer.extCommits[height]
+}
+
 // AddBlock adds a block at peer level. Block must be non-nil and recvSize a positive integer
+// The extended commit of the block is nil if the peer didn't send one.
 // The peer must have a pending request for this block.
-func (peer *BpPeer) AddBlock(block *types.Block, recvSize int) error {
+func (peer *BpPeer) AddBlock(block *types.Block, extCommit *types.ExtendedCommit, recvSize int) error {
 	if block == nil || recvSize < 0 {
 		panic("bad parameters")
 	}
@@ -115,6 +127,9 @@ func (peer *BpPeer) AddBlock(block *types.Block, recvSize int) error {
 		panic("peer does not have pending requests")
 	}
 	peer.blocks[block.Height] = block
+	if extCommit != nil {
+		peer.extCommits[block.Height] = extCommit
+	}
 	peer.NumPendingBlockRequests--
 	if peer.NumPendingBlockRequests == 0 {
 		peer.stopMonitor()
@@ -129,6 +144,7 @@ func (peer *BpPeer) AddBlock(block *types.Block, recvSize int) error {
 // RemoveBlock removes the block of given height
 func (peer *BpPeer) RemoveBlock(height int64) {
 	delete(peer.blocks, height)
+	delete(peer.extCommits, height)
 }
 
 // RequestSent records that a request was sent, and starts the peer timer and monitor if needed.
@@ -244,7 +244,8 @@ func (pool *BlockPool) sendRequest(height int64) bool {
 }
 
 // AddBlock validates that the block comes from the peer it was expected from and stores it in the 'blocks' map.
-func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int) error {
+func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, extCommit *types.ExtendedCommit,
+	blockSize int) error {
 	peer, ok := pool.peers[peerID]
 	if !ok {
 		pool.logger.Error("block from unknown peer", "height", block.Height, "peer", peerID)
@@ -256,13 +257,14 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int
 		return errBadDataFromPeer
 	}
 
-	return peer.AddBlock(block, blockSize)
+	return peer.AddBlock(block, extCommit, blockSize)
 }
 
 // BlockData stores the peer responsible to deliver a block and the actual block if delivered.
 type BlockData struct {
-	block *types.Block
-	peer  *BpPeer
+	block     *types.Block
+	extCommit *types.ExtendedCommit
+	peer      *BpPeer
 }
 
 // BlockAndPeerAtHeight retrieves the block and delivery peer at specified height.
@@ -279,7 +281,7 @@ func (pool *BlockPool) BlockAndPeerAtHeight(height int64) (bData *BlockData, err
 		return nil, err
 	}
 
-	return &BlockData{peer: peer, block: block}, nil
+	return &BlockData{peer: peer, block: block, extCommit: peer.ExtCommitAtHeight(height)}, nil
 
 }
 
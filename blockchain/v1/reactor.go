@@ -206,9 +206,11 @@ func (bcR *BlockchainReactor) sendBlockToPeer(msg *bcproto.BlockRequest,
 			bcR.Logger.Error("Could not send block message to peer", "err", err)
 			return false
 		}
+		// The extended commit is only stored once vote extensions are enabled.
+		extCommit := bcR.store.LoadBlockExtendedCommit(msg.Height)
 		return p2p.TrySendEnvelopeShim(src, p2p.Envelope{ //nolint: staticcheck
 			ChannelID: BlockchainChannel,
-			Message:   &bcproto.BlockResponse{Block: pbbi},
+			Message:   &bcproto.BlockResponse{Block: pbbi, ExtCommit: extCommit.ToProto()},
 		}, bcR.Logger)
 	}
 
@@ -272,13 +274,22 @@ func (bcR *BlockchainReactor) ReceiveEnvelope(e p2p.Envelope) {
 			bcR.Logger.Error("error transition block from protobuf", "err", err)
 			return
 		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				bcR.Logger.Error("error transition extended commit from protobuf", "err", err)
+				return
+			}
+		}
 		msgForFSM := bcReactorMessage{
 			event: blockResponseEv,
 			data: bReactorEventData{
-				peerID: e.Src.ID(),
-				height: bi.Height,
-				block:  bi,
-				length: msg.Size(),
+				peerID:    e.Src.ID(),
+				height:    bi.Height,
+				block:     bi,
+				extCommit: extCommit,
+				length:    msg.Size(),
 			},
 		}
 		bcR.Logger.Info("Received", "src", e.Src, "height", bi.Height)
@@ -460,7 +471,7 @@ func (bcR *BlockchainReactor) reportPeerErrorToSwitch(err error, peerID p2p.ID)
 
 func (bcR *BlockchainReactor) processBlock() error {
 
-	first, second, err := bcR.fsm.FirstTwoBlocks()
+	first, second, firstExtCommit, err := bcR.fsm.FirstTwoBlocks()
 	if err != nil {
 		// We need both to sync the first block.
 		return err
@@ -482,7 +493,21 @@ func (bcR *BlockchainReactor) processBlock() error {
 		return errBlockVerificationFailure
 	}
 
-	bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+	err = bc.VerifyExtendedCommit(bcR.state, firstID, first.Height, firstExtCommit)
+	if err != nil {
+		bcR.Logger.Error("error during extended commit verification", "err", err, "first", first.Height)
+		return errBlockVerificationFailure
+	}
+
+	if firstExtCommit != nil {
+		// Keep the vote extensions around for the proposer of the next
+		// height, in case we switch to consensus.
+		if err := bcR.store.SaveBlockWithExtendedCommit(first, firstParts, firstExtCommit); err != nil {
+			panic(fmt.Sprintf("failed to save block with extended commit (%d:%X): %v", first.Height, first.Hash(), err))
+		}
+	} else {
+		bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+	}
 
 	bcR.state, _, err = bcR.blockExec.ApplyBlock(bcR.state, firstID, first, second.LastCommit)
 	if err != nil {
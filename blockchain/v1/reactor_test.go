@@ -73,7 +73,7 @@ func makeVote(
 
 	vpb := vote.ToProto()
 
-	_ = privVal.SignVote(header.ChainID, vpb)
+	_ = privVal.SignVote(header.ChainID, vpb, false)
 	vote.Signature = vpb.Signature
 
 	return vote
@@ -387,6 +387,7 @@ func makeBlock(height int64, state sm.State, lastCommit *types.Commit) *types.Bl
 		lastCommit,
 		nil,
 		state.Validators.GetProposer().Address,
+		cmttime.Now(),
 	)
 	return block
 }
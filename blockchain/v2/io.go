@@ -11,7 +11,7 @@ import (
 
 type iIO interface {
 	sendBlockRequest(peerID p2p.ID, height int64) error
-	sendBlockToPeer(block *types.Block, peerID p2p.ID) error
+	sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error
 	sendBlockNotFound(height int64, peerID p2p.ID) error
 	sendStatusResponse(base, height int64, peerID p2p.ID) error
 
@@ -71,7 +71,7 @@ func (sio *switchIO) sendStatusResponse(base int64, height int64, peerID p2p.ID)
 	return nil
 }
 
-func (sio *switchIO) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
+func (sio *switchIO) sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error {
 	peer := sio.sw.Peers().Get(peerID)
 	if peer == nil {
 		return fmt.Errorf("peer not found")
@@ -87,7 +87,7 @@ func (sio *switchIO) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
 
 	if queued := p2p.TrySendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
 		ChannelID: BlockchainChannel,
-		Message:   &bcproto.BlockResponse{Block: bpb},
+		Message:   &bcproto.BlockResponse{Block: bpb, ExtCommit: extCommit.ToProto()},
 	}, sio.sw.Logger); !queued {
 		return fmt.Errorf("peer queue full")
 	}
@@ -3,6 +3,7 @@ package v2
 import (
 	"fmt"
 
+	bc "github.com/KYVENetwork/celestia-core/blockchain"
 	"github.com/KYVENetwork/celestia-core/state"
 	"github.com/KYVENetwork/celestia-core/types"
 )
@@ -10,7 +11,8 @@ import (
 type processorContext interface {
 	applyBlock(blockID types.BlockID, block *types.Block, seenCommit *types.Commit) error
 	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error
-	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	verifyExtendedCommit(blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error
+	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit, extCommit *types.ExtendedCommit)
 	cmtState() state.State
 	setState(state.State)
 }
@@ -47,8 +49,21 @@ func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, height in
 	return pc.state.Validators.VerifyCommitLight(chainID, blockID, height, commit)
 }
 
-func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
-	pc.store.SaveBlock(block, blockParts, seenCommit)
+func (pc pContext) verifyExtendedCommit(blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error {
+	return bc.VerifyExtendedCommit(pc.state, blockID, height, extCommit)
+}
+
+// saveBlock saves the block with its extended commit, if there is one, so that
+// the vote extensions are kept around for the proposer of the next height.
+func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit,
+	extCommit *types.ExtendedCommit) {
+	if extCommit == nil {
+		pc.store.SaveBlock(block, blockParts, seenCommit)
+		return
+	}
+	if err := pc.store.SaveBlockWithExtendedCommit(block, blockParts, extCommit); err != nil {
+		panic(fmt.Sprintf("failed to save block with extended commit (%d:%X): %v", block.Height, block.Hash(), err))
+	}
 }
 
 type mockPContext struct {
@@ -87,7 +102,13 @@ func (mpc *mockPContext) verifyCommit(chainID string, blockID types.BlockID, hei
 	return nil
 }
 
-func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+func (mpc *mockPContext) verifyExtendedCommit(blockID types.BlockID, height int64,
+	extCommit *types.ExtendedCommit) error {
+	return nil
+}
+
+func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit,
+	extCommit *types.ExtendedCommit) {
 
 }
 
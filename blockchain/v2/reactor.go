@@ -24,7 +24,9 @@ const (
 
 type blockStore interface {
 	LoadBlock(height int64) *types.Block
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 	SaveBlock(*types.Block, *types.PartSet, *types.Commit)
+	SaveBlockWithExtendedCommit(*types.Block, *types.PartSet, *types.ExtendedCommit) error
 	Base() int64
 	Height() int64
 }
@@ -215,10 +217,11 @@ func (e rProcessBlock) String() string {
 // blockResponse message received from a peer
 type bcBlockResponse struct {
 	priorityNormal
-	time   time.Time
-	peerID p2p.ID
-	size   int
-	block  *types.Block
+	time      time.Time
+	peerID    p2p.ID
+	size      int
+	block     *types.Block
+	extCommit *types.ExtendedCommit
 }
 
 func (resp bcBlockResponse) String() string {
@@ -473,7 +476,9 @@ func (r *BlockchainReactor) ReceiveEnvelope(e p2p.Envelope) {
 	case *bcproto.BlockRequest:
 		block := r.store.LoadBlock(msg.Height)
 		if block != nil {
-			if err := r.io.sendBlockToPeer(block, e.Src.ID()); err != nil {
+			// The extended commit is only stored once vote extensions are enabled.
+			extCommit := r.store.LoadBlockExtendedCommit(msg.Height)
+			if err := r.io.sendBlockToPeer(block, extCommit, e.Src.ID()); err != nil {
 				r.logger.Error("Could not send block message to peer: ", err)
 			}
 		} else {
@@ -497,13 +502,22 @@ func (r *BlockchainReactor) ReceiveEnvelope(e p2p.Envelope) {
 			r.logger.Error("error transitioning block from protobuf", "err", err)
 			return
 		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				r.logger.Error("error transitioning extended commit from protobuf", "err", err)
+				return
+			}
+		}
 		r.mtx.RLock()
 		if r.events != nil {
 			r.events <- bcBlockResponse{
-				peerID: e.Src.ID(),
-				block:  bi,
-				time:   time.Now(),
-				size:   msg.Size(),
+				peerID:    e.Src.ID(),
+				block:     bi,
+				extCommit: extCommit,
+				time:      time.Now(),
+				size:      msg.Size(),
 			}
 		}
 		r.mtx.RUnlock()
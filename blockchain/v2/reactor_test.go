@@ -94,7 +94,7 @@ func (sio *mockSwitchIo) sendStatusResponse(base, height int64, peerID p2p.ID) e
 	return nil
 }
 
-func (sio *mockSwitchIo) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
+func (sio *mockSwitchIo) sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error {
 	sio.mtx.Lock()
 	defer sio.mtx.Unlock()
 	sio.numBlockResponse++
@@ -476,6 +476,7 @@ func makeBlock(height int64, state sm.State, lastCommit *types.Commit) *types.Bl
 		lastCommit,
 		nil,
 		state.Validators.GetProposer().Address,
+		cmttime.Now(),
 	)
 	return block
 }
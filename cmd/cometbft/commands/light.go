@@ -15,13 +15,17 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	cmtjson "github.com/KYVENetwork/celestia-core/libs/json"
 	"github.com/KYVENetwork/celestia-core/libs/log"
 	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
 	cmtos "github.com/KYVENetwork/celestia-core/libs/os"
 	"github.com/KYVENetwork/celestia-core/light"
+	levidence "github.com/KYVENetwork/celestia-core/light/evidence"
 	lproxy "github.com/KYVENetwork/celestia-core/light/proxy"
 	lrpc "github.com/KYVENetwork/celestia-core/light/rpc"
 	dbs "github.com/KYVENetwork/celestia-core/light/store/db"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	rpchttp "github.com/KYVENetwork/celestia-core/rpc/client/http"
 	rpcserver "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/server"
 )
 
@@ -40,6 +44,18 @@ need a primary RPC address, a trusted hash and height and witness RPC addresses
 (if not using sequential verification). To restart the node, thereafter
 only the chainID is required.
 
+Instead of verifying all headers from the trusted header up to the latest one,
+a light client can be bootstrapped from a checkpoint bundle (--import-checkpoint)
+exported by another light client (--export-checkpoint). The bundle holds the
+few headers needed to bridge the validator set changes since the trusted
+header, which must still be within the trusting period, and is verified before
+connecting to the primary and witnesses. The bundle is not signed by its
+exporter: it is as trustworthy as the trusted height and hash.
+
+Evidence of light client attacks is stored locally and can be listed with
+/light_evidence. It is also broadcast to the full nodes given with
+--evidence-nodes until it is seen committed.
+
 When /abci_query is called, the Merkle key path format is:
 
 	/{store name}/{key}
@@ -67,6 +83,13 @@ var (
 	trustedHash    []byte
 	trustLevelStr  string
 
+	concurrentPrimaries uint16
+
+	importCheckpoint string
+	exportCheckpoint string
+
+	evidenceAddrsJoined string
+
 	verbose bool
 
 	primaryKey   = []byte("primary")
@@ -98,6 +121,20 @@ func init() {
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().Uint16Var(&concurrentPrimaries, "concurrent-primaries", 1,
+		"number of nodes (the primary and the first witnesses) to request headers from in parallel."+
+			" The first header received is used and the others are cross-checked in the background",
+	)
+	LightCmd.Flags().StringVar(&importCheckpoint, "import-checkpoint", "",
+		"bootstrap from the checkpoint bundle in this file, starting at the trusted height and hash."+
+			" The bundle is verified offline before connecting to the primary and witnesses",
+	)
+	LightCmd.Flags().StringVar(&exportCheckpoint, "export-checkpoint", "",
+		"export a checkpoint bundle of the trusted headers to this file and exit",
+	)
+	LightCmd.Flags().StringVar(&evidenceAddrsJoined, "evidence-nodes", "",
+		"CometBFT nodes to broadcast evidence of light client attacks to until it is committed, comma-separated",
+	)
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -172,8 +209,37 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		options = append(options, light.SkippingVerification(trustLevel))
 	}
 
+	if concurrentPrimaries > 1 {
+		options = append(options, light.ConcurrentPrimaries(concurrentPrimaries))
+	}
+
+	evidenceSink, err := newEvidenceSink(db, logger)
+	if err != nil {
+		return err
+	}
+	options = append(options, light.EvidenceSinks(evidenceSink))
+
 	var c *light.Client
-	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
+	if importCheckpoint != "" { // bootstrap from a checkpoint bundle
+		bundle, err := readCheckpointBundle(importCheckpoint)
+		if err != nil {
+			return err
+		}
+		c, err = light.NewHTTPClientFromCheckpointBundle(
+			context.Background(),
+			chainID,
+			light.TrustOptions{
+				Period: trustingPeriod,
+				Height: trustedHeight,
+				Hash:   trustedHash,
+			},
+			bundle,
+			primaryAddr,
+			witnessesAddrs,
+			dbs.New(db, chainID),
+			options...,
+		)
+	} else if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
 		c, err = light.NewHTTPClient(
 			context.Background(),
 			chainID,
@@ -201,6 +267,14 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if exportCheckpoint != "" {
+		bundle, err := c.ExportCheckpointBundle(context.Background())
+		if err != nil {
+			return fmt.Errorf("can't export checkpoint bundle: %w", err)
+		}
+		return writeCheckpointBundle(exportCheckpoint, bundle)
+	}
+
 	cfg := rpcserver.DefaultConfig()
 	cfg.MaxBodyBytes = config.RPC.MaxBodyBytes
 	cfg.MaxHeaderBytes = config.RPC.MaxHeaderBytes
@@ -216,10 +290,18 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	p.EvidenceSink = evidenceSink
+
+	if err := evidenceSink.Start(); err != nil {
+		return fmt.Errorf("can't start evidence sink: %w", err)
+	}
 
 	// Stop upon receiving SIGTERM or CTRL-C.
 	cmtos.TrapSignal(logger, func() {
 		p.Listener.Close()
+		if err := evidenceSink.Stop(); err != nil {
+			logger.Error("Failed to stop evidence sink", "err", err)
+		}
 	})
 
 	logger.Info("Starting proxy...", "laddr", listenAddr)
@@ -231,6 +313,43 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func newEvidenceSink(db dbm.DB, logger log.Logger) (*levidence.Sink, error) {
+	clients := []rpcclient.Client{}
+	if evidenceAddrsJoined != "" {
+		for _, addr := range strings.Split(evidenceAddrsJoined, ",") {
+			client, err := rpchttp.New(addr, "/websocket")
+			if err != nil {
+				return nil, fmt.Errorf("failed to create http client for %s: %w", addr, err)
+			}
+			clients = append(clients, client)
+		}
+	}
+
+	sink := levidence.NewSink(db, clients)
+	sink.SetLogger(logger.With("module", "evidence"))
+	return sink, nil
+}
+
+func readCheckpointBundle(path string) (*light.CheckpointBundle, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read checkpoint bundle: %w", err)
+	}
+	bundle := &light.CheckpointBundle{}
+	if err := cmtjson.Unmarshal(bz, bundle); err != nil {
+		return nil, fmt.Errorf("can't decode checkpoint bundle: %w", err)
+	}
+	return bundle, nil
+}
+
+func writeCheckpointBundle(path string, bundle *light.CheckpointBundle) error {
+	bz, err := cmtjson.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't encode checkpoint bundle: %w", err)
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
 func checkForExistingProviders(db dbm.DB) (string, []string, error) {
 	primaryBytes, err := db.Get(primaryKey)
 	if err != nil {
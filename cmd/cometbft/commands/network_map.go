@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	cmtjson "github.com/KYVENetwork/celestia-core/libs/json"
+	rpchttp "github.com/KYVENetwork/celestia-core/rpc/client/http"
+	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
+)
+
+var (
+	networkMapRPCAddr string
+	networkMapSummary bool
+)
+
+// NetworkMapCmd exports the network map of a crawling seed node.
+var NetworkMapCmd = &cobra.Command{
+	Use:   "network-map",
+	Short: "Export the nodes discovered by a seed node as JSON",
+	Long: `
+Export the nodes discovered by a seed node (p2p.seed_mode) while crawling the
+network, together with their node info, reachability and last-seen time.
+With --summary, print the number of nodes per network and version instead.
+`,
+	Example: `cometbft network-map --rpc-laddr tcp://seed:26657 > network.json`,
+	RunE:    exportNetworkMap,
+}
+
+func init() {
+	NetworkMapCmd.Flags().StringVar(&networkMapRPCAddr, "rpc-laddr", "tcp://localhost:26657",
+		"the seed node's RPC address (<host>:<port>)")
+	NetworkMapCmd.Flags().BoolVar(&networkMapSummary, "summary", false,
+		"print the number of nodes per network and version")
+}
+
+func exportNetworkMap(cmd *cobra.Command, args []string) error {
+	client, err := rpchttp.New(networkMapRPCAddr, "/websocket")
+	if err != nil {
+		return fmt.Errorf("failed to create new http client: %w", err)
+	}
+
+	result, err := client.NetworkMap(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if networkMapSummary {
+		printNetworkMapSummary(result)
+		return nil
+	}
+
+	bz, err := cmtjson.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
+
+func printNetworkMapSummary(result *ctypes.ResultNetworkMap) {
+	type key struct {
+		network, version string
+	}
+	var (
+		counts    = make(map[key]int)
+		reachable int
+	)
+	for _, node := range result.Nodes {
+		if node.Reachable {
+			reachable++
+		}
+		k := key{"unknown", "unknown"}
+		if node.NodeInfo != nil {
+			k = key{node.NodeInfo.Network, node.NodeInfo.Version}
+		}
+		counts[k]++
+	}
+
+	keys := make([]key, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].network != keys[j].network {
+			return keys[i].network < keys[j].network
+		}
+		return keys[i].version < keys[j].version
+	})
+
+	fmt.Printf("nodes=%d reachable=%d\n", result.NNodes, reachable)
+	for _, k := range keys {
+		fmt.Printf("network=%s version=%s nodes=%d\n", k.network, k.version, counts[k])
+	}
+}
@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/KYVENetwork/celestia-core/consensus"
+	cmtjson "github.com/KYVENetwork/celestia-core/libs/json"
+)
+
+var (
+	walFile          string
+	walFromHeight    int64
+	walToHeight      int64
+	walTruncateForce bool
+
+	errWALCorrupted = errors.New("WAL contains corrupted data")
+)
+
+// WALCmd groups the commands used to inspect and repair the consensus WAL of
+// a stopped node.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus write-ahead log",
+	Long: `
+Commands to inspect and repair the consensus write-ahead log (WAL).
+They must only be run while the node is stopped.
+`,
+}
+
+var walListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the WAL segments with their heights and message counts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		infos, err := cs.InspectWAL(walFilePath())
+		if err != nil {
+			return err
+		}
+
+		for _, info := range infos {
+			heights := "-"
+			if info.MinHeight != -1 {
+				heights = fmt.Sprintf("%d-%d", info.MinHeight, info.MaxHeight)
+			}
+			fmt.Printf("%03d %s size=%d messages=%d end_heights=%s\n",
+				info.Index, info.Path, info.Size, info.Messages, heights)
+			if info.Err != nil {
+				fmt.Printf("    corrupted at offset %d: %v\n", info.CorruptedAt, info.Err)
+			}
+		}
+		return nil
+	},
+}
+
+var walDumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Print the WAL messages of a height range as JSON, one per line",
+	Example: `
+	cometbft wal dump --from 10 --to 12
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		to := walToHeight
+		if to == 0 {
+			to = walFromHeight
+		}
+		return cs.WalkWAL(walFilePath(), walFromHeight, to, func(msg *cs.TimedWALMessage) error {
+			bz, err := cmtjson.Marshal(msg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal msg: %w", err)
+			}
+			_, err = fmt.Fprintln(os.Stdout, string(bz))
+			return err
+		})
+	},
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the checksums of the messages in all WAL segments",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		infos, err := cs.InspectWAL(walFilePath())
+		if err != nil {
+			return err
+		}
+
+		corrupted := false
+		for _, info := range infos {
+			if info.Err != nil {
+				corrupted = true
+				fmt.Printf("%s: corrupted at offset %d: %v\n", info.Path, info.CorruptedAt, info.Err)
+			}
+		}
+		if corrupted {
+			return errWALCorrupted
+		}
+
+		fmt.Printf("verified %d WAL segments\n", len(infos))
+		return nil
+	},
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate",
+	Short: "Truncate the WAL after the last intact end of height",
+	Long: `
+Truncate removes everything written to the WAL after the last intact
+EndHeightMessage preceding the first corrupted message. The messages of the
+height which was in progress are discarded along with the corrupted data, and
+so are the segments following the truncation point.
+
+It refuses to truncate an intact WAL, which would only discard the messages of
+the height in progress, unless --force is given. The segments which are
+modified or removed are first copied to a backup directory next to the WAL.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, backupDir, err := cs.TruncateWAL(walFilePath(), walTruncateForce)
+		if errors.Is(err, cs.ErrWALNotCorrupted) {
+			return fmt.Errorf("%w, use --force to truncate it anyway", err)
+		}
+		if backupDir != "" {
+			fmt.Printf("Backed up the WAL to %s\n", backupDir)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to truncate WAL: %w", err)
+		}
+
+		fmt.Printf("Truncated WAL after the end of height %d\n", height)
+		return nil
+	},
+}
+
+func init() {
+	WALCmd.PersistentFlags().StringVar(&walFile, "wal-file", "",
+		"path to the consensus WAL (defaults to consensus.wal_file of the config)")
+
+	walDumpCmd.Flags().Int64Var(&walFromHeight, "from", 1, "first height to dump")
+	walDumpCmd.Flags().Int64Var(&walToHeight, "to", 0, "last height to dump (defaults to --from)")
+
+	walTruncateCmd.Flags().BoolVar(&walTruncateForce, "force", false,
+		"truncate the WAL even if it is not corrupted, discarding the height in progress")
+
+	WALCmd.AddCommand(walListCmd)
+	WALCmd.AddCommand(walDumpCmd)
+	WALCmd.AddCommand(walVerifyCmd)
+	WALCmd.AddCommand(walTruncateCmd)
+}
+
+func walFilePath() string {
+	if walFile != "" {
+		return walFile
+	}
+	return config.Consensus.WalFile()
+}
@@ -4,9 +4,12 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +27,11 @@ const (
 	// LogFormatJSON is a format for json output
 	LogFormatJSON = "json"
 
+	// ModeFull is the mode of a full node, which executes the blocks
+	ModeFull = "full"
+	// ModeLight is the mode of a light node, which only verifies the headers
+	ModeLight = "light"
+
 	// DefaultLogLevel defines a default log level as INFO.
 	DefaultLogLevel = "info"
 
@@ -32,6 +40,17 @@ const (
 	MempoolV0 = "v0"
 	MempoolV1 = "v1"
 	MempoolV2 = "v2"
+
+	// P2P transports. QUIC falls back to TCP for peers without QUIC support.
+	// Default is TCP.
+	P2PTransportTCP  = "tcp"
+	P2PTransportQUIC = "quic"
+
+	// Address book backends. The DB backend migrates the addresses of the
+	// address book file on first start.
+	// Default is file.
+	AddrBookBackendFile = "file"
+	AddrBookBackendDB   = "db"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -83,6 +102,7 @@ type Config struct {
 	Mempool         *MempoolConfig         `mapstructure:"mempool"`
 	StateSync       *StateSyncConfig       `mapstructure:"statesync"`
 	FastSync        *FastSyncConfig        `mapstructure:"fastsync"`
+	Light           *LightConfig           `mapstructure:"light"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	Storage         *StorageConfig         `mapstructure:"storage"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
@@ -98,6 +118,7 @@ func DefaultConfig() *Config {
 		Mempool:         DefaultMempoolConfig(),
 		StateSync:       DefaultStateSyncConfig(),
 		FastSync:        DefaultFastSyncConfig(),
+		Light:           DefaultLightConfig(),
 		Consensus:       DefaultConsensusConfig(),
 		Storage:         DefaultStorageConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
@@ -114,6 +135,7 @@ func TestConfig() *Config {
 		Mempool:         TestMempoolConfig(),
 		StateSync:       TestStateSyncConfig(),
 		FastSync:        TestFastSyncConfig(),
+		Light:           TestLightConfig(),
 		Consensus:       TestConsensusConfig(),
 		Storage:         TestStorageConfig(),
 		TxIndex:         TestTxIndexConfig(),
@@ -128,6 +150,7 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.P2P.RootDir = root
 	cfg.Mempool.RootDir = root
 	cfg.Consensus.RootDir = root
+	cfg.StateSync.RootDir = root
 	return cfg
 }
 
@@ -152,6 +175,12 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.FastSync.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [fastsync] section: %w", err)
 	}
+	if err := cfg.Light.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [light] section: %w", err)
+	}
+	if cfg.Mode == ModeLight && len(cfg.Light.RPCServers) == 0 {
+		return errors.New("error in [light] section: rpc_servers is required in the light mode")
+	}
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
@@ -180,6 +209,12 @@ type BaseConfig struct { //nolint: maligned
 	// A custom human readable name for this node
 	Moniker string `mapstructure:"moniker"`
 
+	// Mode of the node: full | light
+	// * full (default) - executes the blocks with the ABCI application
+	// * light - only follows the headers of the chain over p2p, verifies and
+	//   stores them, and serves them over RPC. See the [light] section.
+	Mode string `mapstructure:"mode"`
+
 	// If this node is many blocks behind the tip of the chain, FastSync
 	// allows them to catchup quickly by downloading blocks in parallel
 	// and verifying their commits
@@ -247,6 +282,7 @@ func DefaultBaseConfig() BaseConfig {
 		PrivValidatorState: defaultPrivValStatePath,
 		NodeKey:            defaultNodeKeyPath,
 		Moniker:            defaultMoniker,
+		Mode:               ModeFull,
 		ProxyApp:           "tcp://127.0.0.1:26658",
 		ABCI:               "socket",
 		LogLevel:           DefaultLogLevel,
@@ -305,6 +341,11 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	switch cfg.Mode {
+	case ModeFull, ModeLight:
+	default:
+		return errors.New("unknown mode (must be 'full' or 'light')")
+	}
 	return nil
 }
 
@@ -530,6 +571,13 @@ type P2PConfig struct { //nolint: maligned
 	// Address to listen for incoming connections
 	ListenAddress string `mapstructure:"laddr"`
 
+	// Transport used to connect to peers: "tcp" or "quic". With "quic", every
+	// channel is sent on its own QUIC stream, so that large messages do not
+	// delay small ones. QUIC is served on the UDP port of the listen address
+	// and TCP keeps being served, so that peers without QUIC can still
+	// connect.
+	Transport string `mapstructure:"transport"`
+
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
@@ -546,6 +594,12 @@ type P2PConfig struct { //nolint: maligned
 	// Path to address book
 	AddrBook string `mapstructure:"addr_book_file"`
 
+	// Where the address book is persisted: "file" or "db". With "db", the
+	// address book is stored in the "addrbook" database together with the
+	// history of every address (latency, last failure and its reason), and
+	// the addresses of addr_book_file are migrated to it on first start.
+	AddrBookBackend string `mapstructure:"addr_book_backend"`
+
 	// Set true for strict address routability rules
 	// Set false for private or local networks
 	AddrBookStrict bool `mapstructure:"addr_book_strict"`
@@ -553,6 +607,14 @@ type P2PConfig struct { //nolint: maligned
 	// Maximum number of inbound peers
 	MaxNumInboundPeers int `mapstructure:"max_num_inbound_peers"`
 
+	// When the maximum number of inbound peers is reached, evict the lowest
+	// scoring inbound peer of the most represented netgroup to make room for
+	// a new one with a higher trust score. Peers without any history, such as
+	// new peers, get a neutral score. Persistent and unconditional peers, as
+	// well as the best peers of a few distinct netgroups and the longest
+	// connected peers with at least a neutral score, are never evicted.
+	EvictInboundPeers bool `mapstructure:"evict_inbound_peers"`
+
 	// Maximum number of outbound peers to connect to, excluding persistent peers
 	MaxNumOutboundPeers int `mapstructure:"max_num_outbound_peers"`
 
@@ -562,6 +624,15 @@ type P2PConfig struct { //nolint: maligned
 	// Maximum pause when redialing a persistent peer (if zero, exponential backoff is used)
 	PersistentPeersMaxDialPeriod time.Duration `mapstructure:"persistent_peers_max_dial_period"`
 
+	// Minimum trust score (0-100) of a peer. Peers whose score, computed from
+	// the faults reported by the reactors, falls below it are evicted and
+	// banned for PeerBanDuration. Persistent and unconditional peers are
+	// exempt. 0 disables the check.
+	MinPeerTrustScore int `mapstructure:"min_peer_trust_score"`
+
+	// How long a peer evicted for its trust score is not dialed or accepted
+	PeerBanDuration time.Duration `mapstructure:"peer_ban_duration"`
+
 	// Time to wait before flushing messages out on the connection
 	FlushThrottleTimeout time.Duration `mapstructure:"flush_throttle_timeout"`
 
@@ -574,6 +645,16 @@ type P2PConfig struct { //nolint: maligned
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Comma separated list of <channel ID>:<rate> pairs, limiting the rate at
+	// which packets can be sent on a channel, in bytes/second. Channel IDs are
+	// hex encoded, e.g. "30:512000" limits the mempool channel to 500 kB/s.
+	ChannelSendRates string `mapstructure:"channel_send_rates"`
+
+	// Comma separated list of <channel ID>:<rate> pairs, limiting the rate at
+	// which packets can be received on a channel, in bytes/second, in the
+	// same format as ChannelSendRates.
+	ChannelRecvRates string `mapstructure:"channel_recv_rates"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -583,10 +664,27 @@ type P2PConfig struct { //nolint: maligned
 	// Does not work if the peer-exchange reactor is disabled.
 	SeedMode bool `mapstructure:"seed_mode"`
 
+	// How long a seed node keeps a node in its network map (see the
+	// network_map RPC) after it last crawled or saw the node.
+	NetworkMapRetention time.Duration `mapstructure:"network_map_retention"`
+
 	// Comma separated list of peer IDs to keep private (will not be gossiped to
 	// other peers)
 	PrivatePeerIDs string `mapstructure:"private_peer_ids"`
 
+	// Peer admission: comma separated lists of node IDs and CIDRs peers are
+	// admitted or rejected by. A peer whose ID or IP is denied is rejected.
+	// If an allow list is set, only the peers whose ID or IP is allowed are
+	// admitted. The lists apply to persistent and unconditional peers too, and
+	// can be replaced at runtime with the unsafe update_peer_admission RPC.
+	// Applications which want to decide on peers themselves should use
+	// filter_peers instead, which queries them on /p2p/filter/addr/<IP:PORT>
+	// and /p2p/filter/id/<ID> for every new peer, in addition to these lists.
+	AdmissionAllowIDs   string `mapstructure:"admission_allow_ids"`
+	AdmissionDenyIDs    string `mapstructure:"admission_deny_ids"`
+	AdmissionAllowCIDRs string `mapstructure:"admission_allow_cidrs"`
+	AdmissionDenyCIDRs  string `mapstructure:"admission_deny_cidrs"`
+
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
@@ -607,19 +705,27 @@ func DefaultP2PConfig() *P2PConfig {
 	defaultMConConfig := conn.DefaultMConnConfig()
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
+		Transport:                    P2PTransportTCP,
 		ExternalAddress:              "",
 		UPNP:                         false,
 		AddrBook:                     defaultAddrBookPath,
+		AddrBookBackend:              AddrBookBackendFile,
 		AddrBookStrict:               true,
 		MaxNumInboundPeers:           40,
+		EvictInboundPeers:            false,
 		MaxNumOutboundPeers:          10,
 		PersistentPeersMaxDialPeriod: 0 * time.Second,
+		MinPeerTrustScore:            0,
+		PeerBanDuration:              time.Hour,
 		FlushThrottleTimeout:         defaultMConConfig.FlushThrottle,
 		MaxPacketMsgPayloadSize:      1024, // 1 kB
 		SendRate:                     defaultMConConfig.SendRate,
 		RecvRate:                     defaultMConConfig.RecvRate,
+		ChannelSendRates:             "",
+		ChannelRecvRates:             "",
 		PexReactor:                   true,
 		SeedMode:                     false,
+		NetworkMapRetention:          24 * time.Hour,
 		AllowDuplicateIP:             false,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
@@ -646,6 +752,22 @@ func (cfg *P2PConfig) AddrBookFile() string {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
+	switch cfg.Transport {
+	case P2PTransportTCP, P2PTransportQUIC:
+	default:
+		return fmt.Errorf("unknown transport %q", cfg.Transport)
+	}
+	switch cfg.AddrBookBackend {
+	case AddrBookBackendFile, AddrBookBackendDB:
+	default:
+		return fmt.Errorf("unknown addr_book_backend %q", cfg.AddrBookBackend)
+	}
+	if err := validateCIDRs(cfg.AdmissionAllowCIDRs); err != nil {
+		return fmt.Errorf("admission_allow_cidrs: %w", err)
+	}
+	if err := validateCIDRs(cfg.AdmissionDenyCIDRs); err != nil {
+		return fmt.Errorf("admission_deny_cidrs: %w", err)
+	}
 	if cfg.MaxNumInboundPeers < 0 {
 		return errors.New("max_num_inbound_peers can't be negative")
 	}
@@ -658,6 +780,15 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.PersistentPeersMaxDialPeriod < 0 {
 		return errors.New("persistent_peers_max_dial_period can't be negative")
 	}
+	if cfg.MinPeerTrustScore < 0 || cfg.MinPeerTrustScore > 100 {
+		return errors.New("min_peer_trust_score must be between 0 and 100")
+	}
+	if cfg.PeerBanDuration < 0 {
+		return errors.New("peer_ban_duration can't be negative")
+	}
+	if cfg.NetworkMapRetention < 0 {
+		return errors.New("network_map_retention can't be negative")
+	}
 	if cfg.MaxPacketMsgPayloadSize < 0 {
 		return errors.New("max_packet_msg_payload_size can't be negative")
 	}
@@ -667,9 +798,72 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if _, err := cfg.ChannelSendRateLimits(); err != nil {
+		return fmt.Errorf("channel_send_rates: %w", err)
+	}
+	if _, err := cfg.ChannelRecvRateLimits(); err != nil {
+		return fmt.Errorf("channel_recv_rates: %w", err)
+	}
+	return nil
+}
+
+// validateCIDRs checks that the comma separated list only contains valid
+// CIDRs.
+func validateCIDRs(cidrs string) error {
+	for _, cidr := range strings.Split(cidrs, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// ChannelSendRateLimits parses ChannelSendRates into a map of channel IDs to
+// their send rate, in bytes/second.
+func (cfg *P2PConfig) ChannelSendRateLimits() (map[byte]int64, error) {
+	return parseChannelRates(cfg.ChannelSendRates)
+}
+
+// ChannelRecvRateLimits parses ChannelRecvRates into a map of channel IDs to
+// their receive rate, in bytes/second.
+func (cfg *P2PConfig) ChannelRecvRateLimits() (map[byte]int64, error) {
+	return parseChannelRates(cfg.ChannelRecvRates)
+}
+
+// parseChannelRates parses a comma separated list of <channel ID>:<rate>
+// pairs into a map of channel IDs to their rate.
+func parseChannelRates(s string) (map[byte]int64, error) {
+	rates := make(map[byte]int64)
+	if strings.TrimSpace(s) == "" {
+		return rates, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid pair %q, expected <channel ID>:<rate>", pair)
+		}
+		chID, err := strconv.ParseUint(strings.TrimPrefix(parts[0], "0x"), 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel ID %q: %w", parts[0], err)
+		}
+		rate, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate %q: %w", parts[1], err)
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("rate of channel %q must be positive", parts[0])
+		}
+		if _, ok := rates[byte(chID)]; ok {
+			return nil, fmt.Errorf("duplicate channel ID %q", parts[0])
+		}
+		rates[byte(chID)] = rate
+	}
+	return rates, nil
+}
+
 // FuzzConnConfig is a FuzzedConnection configuration.
 type FuzzConnConfig struct {
 	Mode         int
@@ -822,15 +1016,31 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 
 // StateSyncConfig defines the configuration for the CometBFT state sync service
 type StateSyncConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	Enable              bool          `mapstructure:"enable"`
 	TempDir             string        `mapstructure:"temp_dir"`
 	RPCServers          []string      `mapstructure:"rpc_servers"`
+	GRPCServers         []string      `mapstructure:"grpc_servers"`
 	TrustPeriod         time.Duration `mapstructure:"trust_period"`
 	TrustHeight         int64         `mapstructure:"trust_height"`
 	TrustHash           string        `mapstructure:"trust_hash"`
 	DiscoveryTime       time.Duration `mapstructure:"discovery_time"`
 	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
 	ChunkFetchers       int32         `mapstructure:"chunk_fetchers"`
+
+	// HTTP servers to fetch snapshots and chunks from, in addition to peers
+	SnapshotHTTPServers []string `mapstructure:"snapshot_http_servers"`
+
+	// Directory holding the progress of an ongoing state sync, so that it can
+	// be resumed after a restart. Relative paths are relative to the home
+	// directory.
+	ResumePath string `mapstructure:"resume_dir"`
+
+	// Serve node snapshots of the recent block metas, commits and validator
+	// sets to peers, and fetch them alongside the app snapshot when state
+	// syncing to bootstrap the block store and state database.
+	NodeSnapshots bool `mapstructure:"node_snapshots"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -842,6 +1052,15 @@ func (cfg *StateSyncConfig) TrustHashBytes() []byte {
 	return bytes
 }
 
+// ResumeDir returns the full path to the directory holding the progress of an
+// ongoing state sync, or an empty string if resumption is disabled.
+func (cfg *StateSyncConfig) ResumeDir() string {
+	if cfg.ResumePath == "" {
+		return ""
+	}
+	return rootify(cfg.ResumePath, cfg.RootDir)
+}
+
 // DefaultStateSyncConfig returns a default configuration for the state sync service
 func DefaultStateSyncConfig() *StateSyncConfig {
 	return &StateSyncConfig{
@@ -849,6 +1068,7 @@ func DefaultStateSyncConfig() *StateSyncConfig {
 		DiscoveryTime:       15 * time.Second,
 		ChunkRequestTimeout: 10 * time.Second,
 		ChunkFetchers:       4,
+		ResumePath:          filepath.Join(defaultDataDir, "statesync"),
 	}
 }
 
@@ -874,6 +1094,10 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 			}
 		}
 
+		if len(cfg.GRPCServers) > 0 && len(cfg.GRPCServers) != len(cfg.RPCServers) {
+			return errors.New("grpc_servers must have as many entries as rpc_servers")
+		}
+
 		if cfg.DiscoveryTime != 0 && cfg.DiscoveryTime < 5*time.Second {
 			return errors.New("discovery time must be 0s or greater than five seconds")
 		}
@@ -902,6 +1126,16 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 		if cfg.ChunkFetchers <= 0 {
 			return errors.New("chunk_fetchers is required")
 		}
+
+		for _, server := range cfg.SnapshotHTTPServers {
+			u, err := url.Parse(server)
+			if err != nil {
+				return fmt.Errorf("invalid snapshot_http_servers entry %q: %w", server, err)
+			}
+			if u.Scheme != "http" && u.Scheme != "https" {
+				return fmt.Errorf("invalid snapshot_http_servers entry %q: expected an http or https URL", server)
+			}
+		}
 	}
 
 	return nil
@@ -938,6 +1172,82 @@ func (cfg *FastSyncConfig) ValidateBasic() error {
 	}
 }
 
+//-----------------------------------------------------------------------------
+// LightConfig
+
+// LightConfig defines the configuration for the light node mode
+type LightConfig struct {
+	// RPC servers to fetch the light block at the trust height and the
+	// validator sets from. They don't need to be trusted, as the light blocks
+	// and validator sets are checked against the trusted hashes. Required in
+	// the light mode, as the headers can't be followed past a validator set
+	// change otherwise.
+	RPCServers []string `mapstructure:"rpc_servers"`
+
+	// Trusted height and hash of the header to start following the headers
+	// from. If not set, the headers are followed from the initial height,
+	// using the genesis validators.
+	TrustHeight int64  `mapstructure:"trust_height"`
+	TrustHash   string `mapstructure:"trust_hash"`
+
+	// Period during which the validators of a trusted header can be trusted
+	TrustPeriod time.Duration `mapstructure:"trust_period"`
+}
+
+// DefaultLightConfig returns a default configuration for the light node mode
+func DefaultLightConfig() *LightConfig {
+	return &LightConfig{
+		TrustPeriod: 168 * time.Hour,
+	}
+}
+
+// TestLightConfig returns a default configuration for the light node mode
+func TestLightConfig() *LightConfig {
+	return DefaultLightConfig()
+}
+
+func (cfg *LightConfig) TrustHashBytes() []byte {
+	// validated in ValidateBasic, so we can safely panic here
+	bytes, err := hex.DecodeString(cfg.TrustHash)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// ValidateBasic performs basic validation.
+func (cfg *LightConfig) ValidateBasic() error {
+	for _, server := range cfg.RPCServers {
+		if len(server) == 0 {
+			return errors.New("found empty rpc_servers entry")
+		}
+	}
+
+	if cfg.TrustPeriod <= 0 {
+		return errors.New("trust_period is required")
+	}
+
+	if cfg.TrustHeight < 0 {
+		return errors.New("trust_height can't be negative")
+	}
+
+	if cfg.TrustHeight > 0 {
+		if len(cfg.RPCServers) == 0 {
+			return errors.New("rpc_servers is required with trust_height")
+		}
+
+		if len(cfg.TrustHash) == 0 {
+			return errors.New("trust_hash is required with trust_height")
+		}
+
+		if _, err := hex.DecodeString(cfg.TrustHash); err != nil {
+			return fmt.Errorf("invalid trust_hash: %w", err)
+		}
+	}
+
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // ConsensusConfig
 
@@ -948,6 +1258,15 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// Number of most recent heights to keep in the WAL. When greater than 0,
+	// WAL files only holding older heights are moved to WalArchivePath
+	// (compressed) or deleted if WalArchivePath is empty, instead of being
+	// removed once the WAL exceeds its total size limit.
+	WalRetainHeights int64 `mapstructure:"wal_retain_heights"`
+	// Directory holding the compressed WAL files. Relative paths are
+	// relative to the home directory.
+	WalArchivePath string `mapstructure:"wal_archive_dir"`
+
 	// How long we wait for a proposal block before prevoting nil
 	TimeoutPropose time.Duration `mapstructure:"timeout_propose"`
 	// How much timeout_propose increases with each round
@@ -1058,6 +1377,15 @@ func (cfg *ConsensusConfig) WalFile() string {
 	return rootify(cfg.WalPath, cfg.RootDir)
 }
 
+// WalArchiveDir returns the full path to the directory holding archived WAL
+// files, or an empty string if archival is disabled.
+func (cfg *ConsensusConfig) WalArchiveDir() string {
+	if cfg.WalArchivePath == "" {
+		return ""
+	}
+	return rootify(cfg.WalArchivePath, cfg.RootDir)
+}
+
 // SetWalFile sets the path to the write-ahead log file
 func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
@@ -1066,6 +1394,9 @@ func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *ConsensusConfig) ValidateBasic() error {
+	if cfg.WalRetainHeights < 0 {
+		return errors.New("wal_retain_heights can't be negative")
+	}
 	if cfg.TimeoutPropose < 0 {
 		return errors.New("timeout_propose can't be negative")
 	}
@@ -36,6 +36,13 @@ func TestConfigValidateBasic(t *testing.T) {
 	// tamper with timeout_propose
 	cfg.Consensus.TimeoutPropose = -10 * time.Second
 	assert.Error(t, cfg.ValidateBasic())
+
+	// the light mode requires rpc_servers
+	cfg = DefaultConfig()
+	cfg.Mode = ModeLight
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Light.RPCServers = []string{"127.0.0.1:26657"}
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestTLSConfiguration(t *testing.T) {
@@ -61,6 +68,14 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestBaseConfig()
+	cfg.Mode = ModeLight
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// tamper with mode
+	cfg.Mode = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -95,6 +110,8 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"MinPeerTrustScore",
+		"PeerBanDuration",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -102,6 +119,43 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.MinPeerTrustScore = 101
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MinPeerTrustScore = 0
+
+	cfg.Transport = "udp"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Transport = P2PTransportQUIC
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.AddrBookBackend = "sql"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.AddrBookBackend = AddrBookBackendDB
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.AdmissionDenyCIDRs = "10.0.0.0/8, 1.2.3.4"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.AdmissionDenyCIDRs = "10.0.0.0/8, 1.2.3.4/32,"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, rates := range []string{"30", "30:", "zz:100", "100:100", "30:-1", "30:100,0x30:200"} {
+		cfg.ChannelSendRates = rates
+		assert.Error(t, cfg.ValidateBasic(), rates)
+	}
+	cfg.ChannelSendRates = "30:512000, 0x31:1024"
+	require.NoError(t, cfg.ValidateBasic())
+	rates, err := cfg.ChannelSendRateLimits()
+	require.NoError(t, err)
+	assert.Equal(t, map[byte]int64{0x30: 512000, 0x31: 1024}, rates)
+
+	cfg.ChannelRecvRates = "30:-1"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ChannelRecvRates = "0x20:2048"
+	require.NoError(t, cfg.ValidateBasic())
+	rates, err = cfg.ChannelRecvRateLimits()
+	require.NoError(t, err)
+	assert.Equal(t, map[byte]int64{0x20: 2048}, rates)
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -125,6 +179,42 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Enable = true
+	cfg.RPCServers = []string{"tcp://127.0.0.1:26657", "tcp://127.0.0.2:26657"}
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0123456789abcdef"
+	cfg.SnapshotHTTPServers = []string{"https://snapshots.example.com/chain"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.SnapshotHTTPServers = []string{"snapshots.example.com"}
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.SnapshotHTTPServers = nil
+	cfg.GRPCServers = []string{"tcp://127.0.0.1:9090", "tcp://127.0.0.2:9090"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.GRPCServers = []string{"tcp://127.0.0.1:9090"}
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestLightConfigValidateBasic(t *testing.T) {
+	cfg := TestLightConfig()
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.TrustHeight = 1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.RPCServers = []string{"tcp://127.0.0.1:26657"}
+	cfg.TrustHash = "0123456789abcdef"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.TrustHash = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.TrustHash = "0123456789abcdef"
+	cfg.TrustPeriod = 0
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestFastSyncConfigValidateBasic(t *testing.T) {
@@ -164,6 +254,8 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"WalRetainHeights":                     {func(c *ConsensusConfig) { c.WalRetainHeights = 100 }, false},
+		"WalRetainHeights negative":            {func(c *ConsensusConfig) { c.WalRetainHeights = -1 }, true},
 	}
 
 	for desc, tc := range testcases {
@@ -87,6 +87,12 @@ proxy_app = "{{ .BaseConfig.ProxyApp }}"
 # A custom human readable name for this node
 moniker = "{{ .BaseConfig.Moniker }}"
 
+# Mode of the node: full | light
+# * full (default) - executes the blocks with the ABCI application
+# * light - only follows the headers of the chain over p2p, verifies and
+#   stores them, and serves them over RPC. See the [light] section.
+mode = "{{ .BaseConfig.Mode }}"
+
 # If this node is many blocks behind the tip of the chain, FastSync
 # allows them to catchup quickly by downloading blocks in parallel
 # and verifying their commits
@@ -271,6 +277,13 @@ pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 # Address to listen for incoming connections
 laddr = "{{ .P2P.ListenAddress }}"
 
+# Transport used to connect to peers: "tcp" or "quic". With "quic", every
+# channel is sent on its own QUIC stream, so that large messages (e.g. block
+# parts) do not delay small ones (e.g. votes). QUIC is served on the UDP port
+# of laddr and TCP keeps being served, so that peers without QUIC can still
+# connect.
+transport = "{{ .P2P.Transport }}"
+
 # Address to advertise to peers for them to dial
 # If empty, will use the same port as the laddr,
 # and will introspect on the listener or use UPnP
@@ -290,6 +303,12 @@ upnp = {{ .P2P.UPNP }}
 # Path to address book
 addr_book_file = "{{ js .P2P.AddrBook }}"
 
+# Where the address book is persisted: "file" or "db". With "db", the
+# address book is stored in the "addrbook" database together with the
+# history of every address (latency, last failure and its reason), and
+# the addresses of addr_book_file are migrated to it on first start.
+addr_book_backend = "{{ .P2P.AddrBookBackend }}"
+
 # Set true for strict address routability rules
 # Set false for private or local networks
 addr_book_strict = {{ .P2P.AddrBookStrict }}
@@ -297,6 +316,14 @@ addr_book_strict = {{ .P2P.AddrBookStrict }}
 # Maximum number of inbound peers
 max_num_inbound_peers = {{ .P2P.MaxNumInboundPeers }}
 
+# When the maximum number of inbound peers is reached, evict the lowest scoring
+# inbound peer of the most represented netgroup to make room for a new one with
+# a higher trust score. Peers without any history, such as new peers, get a
+# neutral score. Persistent and unconditional peers, as well as the best peers
+# of a few distinct netgroups and the longest connected peers with at least a
+# neutral score, are never evicted.
+evict_inbound_peers = {{ .P2P.EvictInboundPeers }}
+
 # Maximum number of outbound peers to connect to, excluding persistent peers
 max_num_outbound_peers = {{ .P2P.MaxNumOutboundPeers }}
 
@@ -306,6 +333,16 @@ unconditional_peer_ids = "{{ .P2P.UnconditionalPeerIDs }}"
 # Maximum pause when redialing a persistent peer (if zero, exponential backoff is used)
 persistent_peers_max_dial_period = "{{ .P2P.PersistentPeersMaxDialPeriod }}"
 
+# Minimum trust score (0-100) of a peer. Peers whose score, computed from the
+# faults reported by the reactors, falls below it are evicted and banned for
+# peer_ban_duration. Persistent and unconditional peers are exempt.
+# 0 disables the check.
+min_peer_trust_score = {{ .P2P.MinPeerTrustScore }}
+
+# How long a peer evicted for its trust score is not dialed or accepted. Bans are
+# persisted along with the trust history, so they survive restarts.
+peer_ban_duration = "{{ .P2P.PeerBanDuration }}"
+
 # Time to wait before flushing messages out on the connection
 flush_throttle_timeout = "{{ .P2P.FlushThrottleTimeout }}"
 
@@ -318,6 +355,17 @@ send_rate = {{ .P2P.SendRate }}
 # Rate at which packets can be received, in bytes/second
 recv_rate = {{ .P2P.RecvRate }}
 
+# Comma separated list of <channel ID>:<rate> pairs, limiting the rate at which
+# packets can be sent on a channel, in bytes/second. Channel IDs are hex
+# encoded, e.g. "30:512000" limits the mempool channel to 500 kB/s, so that it
+# can not starve the consensus channels.
+channel_send_rates = "{{ .P2P.ChannelSendRates }}"
+
+# Comma separated list of <channel ID>:<rate> pairs, limiting the rate at which
+# packets can be received on a channel, in bytes/second, in the same format as
+# channel_send_rates. A peer exceeding the rate of a channel is throttled.
+channel_recv_rates = "{{ .P2P.ChannelRecvRates }}"
+
 # Set true to enable the peer-exchange reactor
 pex = {{ .P2P.PexReactor }}
 
@@ -327,9 +375,27 @@ pex = {{ .P2P.PexReactor }}
 # Does not work if the peer-exchange reactor is disabled.
 seed_mode = {{ .P2P.SeedMode }}
 
+# How long a seed node keeps a node in its network map (see the network_map
+# RPC) after it last crawled or saw the node.
+network_map_retention = "{{ .P2P.NetworkMapRetention }}"
+
 # Comma separated list of peer IDs to keep private (will not be gossiped to other peers)
 private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 
+# Peer admission: comma separated lists of node IDs and CIDRs
+# (e.g. "10.0.0.0/8") peers are admitted or rejected by. A peer whose ID or IP
+# is denied is rejected. If an allow list is set, only the peers whose ID or
+# IP is allowed are admitted. The lists apply to persistent and unconditional
+# peers too, and can be replaced at runtime with the unsafe
+# update_peer_admission RPC. Applications which want to decide on peers
+# themselves should use filter_peers instead, which queries them on
+# /p2p/filter/addr/<IP:PORT> and /p2p/filter/id/<ID> for every new peer, in
+# addition to these lists.
+admission_allow_ids = "{{ .P2P.AdmissionAllowIDs }}"
+admission_deny_ids = "{{ .P2P.AdmissionDenyIDs }}"
+admission_allow_cidrs = "{{ .P2P.AdmissionAllowCIDRs }}"
+admission_deny_cidrs = "{{ .P2P.AdmissionDenyCIDRs }}"
+
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
@@ -426,6 +492,12 @@ trust_height = {{ .StateSync.TrustHeight }}
 trust_hash = "{{ .StateSync.TrustHash }}"
 trust_period = "{{ .StateSync.TrustPeriod }}"
 
+# gRPC servers (comma-separated) of the rpc_servers (see rpc.grpc_laddr), in the same order. If set,
+# the light client fetches each light block from them in a single call, instead of fetching the
+# signed header and the validator set from the rpc_servers separately. Evidence of misbehavior is
+# still reported to the rpc_servers.
+grpc_servers = "{{ StringsJoin .StateSync.GRPCServers "," }}"
+
 # Time to spend discovering snapshots before initiating a restore.
 discovery_time = "{{ .StateSync.DiscoveryTime }}"
 
@@ -434,12 +506,32 @@ discovery_time = "{{ .StateSync.DiscoveryTime }}"
 temp_dir = "{{ .StateSync.TempDir }}"
 
 # The timeout duration before re-requesting a chunk, possibly from a different
-# peer (default: 1 minute).
+# peer (default: 1 minute). It also bounds each chunk request to the snapshot
+# HTTP servers.
 chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
 
 # The number of concurrent chunk fetchers to run (default: 1).
 chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 
+# HTTP servers (comma-separated) to fetch snapshots and chunks from, in addition to peers, e.g. a
+# static file server of archived snapshots. Each server lists its snapshots at <url>/snapshots.json
+# and serves chunks at <url>/<height>/<format>/<index>. Chunks are downloaded by the chunk fetchers
+# and interrupted downloads are resumed if the server supports range requests. Like snapshots from
+# peers, they are verified against the app hash obtained from the rpc_servers.
+snapshot_http_servers = "{{ StringsJoin .StateSync.SnapshotHTTPServers "," }}"
+
+# Directory holding the progress of an ongoing state sync: the chosen snapshot, the chunks fetched
+# and the indexes of the chunks applied. If the node is restarted during a state sync, it offers the
+# same snapshot to the application again, and continues from where it stopped if the application
+# responds with RESUME. Relative paths are relative to the home directory. Set to "" to disable.
+resume_dir = "{{ js .StateSync.ResumePath }}"
+
+# Node snapshots hold the block metas, commits and validator sets of the most recent blocks up to a
+# snapshot height. If enabled, they are served to peers, and requested from peers after restoring an
+# app snapshot, to bootstrap the block store and state database with the recent block history. They
+# are verified against the commit obtained from the rpc_servers.
+node_snapshots = {{ .StateSync.NodeSnapshots }}
+
 #######################################################
 ###       Fast Sync Configuration Connections       ###
 #######################################################
@@ -451,6 +543,33 @@ chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 #   be completely removed in one of the upcoming releases
 version = "{{ .FastSync.Version }}"
 
+#######################################################
+###        Light Node Configuration Options         ###
+#######################################################
+[light]
+# A light node (mode = "light") receives the blocks committed by the network from the consensus
+# reactors of its peers, verifies the headers against the validators of the previous header, and
+# stores the headers and commits. It doesn't run an ABCI application, and serves the headers over
+# a header-only RPC.
+
+# RPC servers (comma-separated) to fetch the light block at trust_height and the validator sets
+# from, when they change. The servers don't need to be trusted: the light block and the validator
+# sets are checked against the trusted hashes. Required, as the headers can't be followed past a
+# validator set change otherwise.
+rpc_servers = "{{ StringsJoin .Light.RPCServers "," }}"
+
+# Trusted height and corresponding header hash to start following the headers from, obtained from
+# a trusted source. If not set, the headers are followed from the initial height, which requires
+# the genesis file to contain the initial validators. Once the latest stored header is older than
+# the trust period, the node stops following the headers (see /health) and can't be started
+# again, until a more recent trust_height is set, from which the headers are then followed.
+trust_height = {{ .Light.TrustHeight }}
+trust_hash = "{{ .Light.TrustHash }}"
+
+# Period during which the validators of a header can be trusted. Headers are verified one after
+# the other, so the node must not be stopped for longer than the trust period.
+trust_period = "{{ .Light.TrustPeriod }}"
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -458,6 +577,13 @@ version = "{{ .FastSync.Version }}"
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# Number of most recent heights to keep in the WAL. If greater than 0, WAL
+# files only holding older heights are compressed into wal_archive_dir, or
+# deleted if wal_archive_dir is empty. If 0, the oldest WAL files are deleted
+# once the WAL exceeds 1GB.
+wal_retain_heights = {{ .Consensus.WalRetainHeights }}
+wal_archive_dir = "{{ js .Consensus.WalArchivePath }}"
+
 # How long we wait for a proposal block before prevoting nil
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 # How much timeout_propose increases with each round
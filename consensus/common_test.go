@@ -108,7 +108,7 @@ func (vs *validatorStub) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 	v := vote.ToProto()
-	if err := vs.PrivValidator.SignVote(config.ChainID(), v); err != nil {
+	if err := vs.PrivValidator.SignVote(config.ChainID(), v, false); err != nil {
 		return nil, fmt.Errorf("sign vote failed: %w", err)
 	}
 
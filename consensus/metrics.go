@@ -96,6 +96,9 @@ type Metrics struct {
 
 	// The amount of proposals that failed to be received in time
 	TimedOutProposals metrics.Counter
+
+	// The amount of proposals whose timestamp was not timely under PBTS.
+	UntimelyProposals metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -261,6 +264,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "application_rejected_proposals",
 			Help:      "Number of proposals rejected by the application",
 		}, labels).With(labelsAndValues...),
+		UntimelyProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "untimely_proposals",
+			Help:      "Number of proposals prevoted nil because their timestamp was not timely",
+		}, labels).With(labelsAndValues...),
 		TimedOutProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -303,6 +312,7 @@ func NopMetrics() *Metrics {
 		QuorumPrevoteMessageDelay:    discard.NewGauge(),
 		FullPrevoteMessageDelay:      discard.NewGauge(),
 		ApplicationRejectedProposals: discard.NewCounter(),
+		UntimelyProposals:            discard.NewCounter(),
 		TimedOutProposals:            discard.NewCounter(),
 	}
 }
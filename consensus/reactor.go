@@ -247,13 +247,13 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	msg, err := MsgFromProto(m.(*cmtcons.Message))
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		conR.Switch.StopPeerForError(e.Src, err)
+		conR.Switch.StopPeerForFault(e.Src, err)
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
-		conR.Switch.StopPeerForError(e.Src, err)
+		conR.Switch.StopPeerForFault(e.Src, err)
 		return
 	}
 
@@ -283,7 +283,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			)
 			if err = msg.ValidateHeight(initialHeight); err != nil {
 				conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", msg, "err", err)
-				conR.Switch.StopPeerForError(e.Src, err)
+				conR.Switch.StopPeerForFault(e.Src, err)
 				return
 			}
 			ps.ApplyNewRoundStepMessage(msg)
@@ -327,7 +327,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			// Peer claims to have a maj23 for some BlockID at H,R,S,
 			err := votes.SetPeerMaj23(msg.Round, msg.Type, ps.peer.ID(), msg.BlockID)
 			if err != nil {
-				conR.Switch.StopPeerForError(e.Src, err)
+				conR.Switch.StopPeerForFault(e.Src, err)
 				return
 			}
 			// Respond with a VoteSetBitsMessage showing which votes we have.
@@ -899,7 +899,16 @@ OUTER_LOOP:
 		if blockStoreBase > 0 && prs.Height != 0 && rs.Height >= prs.Height+2 && prs.Height >= blockStoreBase {
 			// Load the block commit for prs.Height,
 			// which contains precommit signatures for prs.Height.
-			if commit := conR.conS.blockStore.LoadBlockCommit(prs.Height); commit != nil {
+			// If vote extensions were enabled at prs.Height, prefer the
+			// extended commit, as the peer needs the extensions to accept
+			// the precommits.
+			var commit types.VoteSetReader
+			if extCommit := conR.conS.blockStore.LoadBlockExtendedCommit(prs.Height); extCommit != nil {
+				commit = extCommit
+			} else if blockCommit := conR.conS.blockStore.LoadBlockCommit(prs.Height); blockCommit != nil {
+				commit = blockCommit
+			}
+			if commit != nil {
 				vote := ps.PickSendVote(commit)
 				if vote != nil {
 					logger.Debug("Picked Catchup commit to send", "height", prs.Height)
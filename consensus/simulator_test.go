@@ -0,0 +1,533 @@
+package consensus
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/KYVENetwork/celestia-core/abci/client"
+	"github.com/KYVENetwork/celestia-core/abci/example/kvstore"
+	cfg "github.com/KYVENetwork/celestia-core/config"
+	cstypes "github.com/KYVENetwork/celestia-core/consensus/types"
+	"github.com/KYVENetwork/celestia-core/crypto/ed25519"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	mempoolv0 "github.com/KYVENetwork/celestia-core/mempool/v0"
+	"github.com/KYVENetwork/celestia-core/p2p"
+	sm "github.com/KYVENetwork/celestia-core/state"
+	"github.com/KYVENetwork/celestia-core/store"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+//-------------------------------------------------------------------------------
+// deterministic consensus simulator
+
+const (
+	simChainID        = "sim-chain"
+	simGossipInterval = 100 * time.Millisecond
+)
+
+var simGenesisTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// simulator runs several consensus States in a single goroutine on a virtual
+// clock. Instead of running their receive routines, it hands each State its
+// messages and timeouts one at a time, in the order of a single event queue.
+// The messages signed by a node are broadcast to the other nodes over a
+// simulated network with configurable latency, drops and partitions, and nodes
+// periodically gossip the proposals, block parts and votes their peers are
+// missing, like the reactor does. Every source of randomness is derived from
+// the seed, so runs with the same seed are identical.
+type simulator struct {
+	t   *testing.T
+	rng *rand.Rand
+
+	now   time.Time
+	seq   uint64
+	queue simEventQueue
+
+	nodes []*simNode
+
+	// network
+	minLatency time.Duration
+	maxLatency time.Duration
+	dropRate   float64
+	partitions []int // messages between nodes in different partitions are dropped
+
+	// trace holds a line for every event which was processed.
+	trace []string
+}
+
+type simNode struct {
+	index  int
+	id     p2p.ID
+	cs     *State
+	ticker *simTicker
+}
+
+type simEventKind int
+
+const (
+	simEventTimeout simEventKind = iota
+	simEventMessage
+	simEventGossip
+)
+
+type simEvent struct {
+	at   time.Time
+	seq  uint64
+	kind simEventKind
+	node int
+	ti   timeoutInfo
+	mi   msgInfo
+}
+
+// simEventQueue is a heap of events ordered by time and then by insertion.
+type simEventQueue []*simEvent
+
+func (q simEventQueue) Len() int { return len(q) }
+func (q simEventQueue) Less(i, j int) bool {
+	if !q[i].at.Equal(q[j].at) {
+		return q[i].at.Before(q[j].at)
+	}
+	return q[i].seq < q[j].seq
+}
+func (q simEventQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *simEventQueue) Push(x interface{}) { *q = append(*q, x.(*simEvent)) }
+func (q *simEventQueue) Pop() interface{} {
+	old := *q
+	ev := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return ev
+}
+
+// simTicker is a TimeoutTicker scheduling timeouts on the simulator's clock.
+// Like timeoutTicker, it only keeps the latest timeout and ignores timeouts
+// for earlier heights, rounds and steps.
+type simTicker struct {
+	sim  *simulator
+	node int
+	ti   timeoutInfo
+	seq  uint64 // event of the latest timeout, older ones are ignored
+}
+
+var _ TimeoutTicker = (*simTicker)(nil)
+
+func (t *simTicker) Start() error             { return nil }
+func (t *simTicker) Stop() error              { return nil }
+func (t *simTicker) Chan() <-chan timeoutInfo { return nil }
+func (t *simTicker) SetLogger(log.Logger)     {}
+func (t *simTicker) ScheduleTimeout(newti timeoutInfo) {
+	ti := t.ti
+	if newti.Height < ti.Height {
+		return
+	} else if newti.Height == ti.Height {
+		if newti.Round < ti.Round {
+			return
+		} else if newti.Round == ti.Round && ti.Step > 0 && newti.Step <= ti.Step {
+			return
+		}
+	}
+
+	t.ti = newti
+	t.seq = t.sim.schedule(&simEvent{
+		at:   t.sim.now.Add(newti.Duration),
+		kind: simEventTimeout,
+		node: t.node,
+		ti:   newti,
+	})
+}
+
+// newSimulator creates a simulator running numValidators validators with equal
+// voting power. The network delivers every message with a latency between 10
+// and 100ms until configured otherwise.
+func newSimulator(t *testing.T, seed int64, numValidators int) *simulator {
+	s := &simulator{
+		t:          t,
+		rng:        rand.New(rand.NewSource(seed)),
+		now:        simGenesisTime,
+		minLatency: 10 * time.Millisecond,
+		maxLatency: 100 * time.Millisecond,
+		partitions: make([]int, numValidators),
+	}
+
+	privVals := make([]types.PrivValidator, numValidators)
+	validators := make([]types.GenesisValidator, numValidators)
+	for i := 0; i < numValidators; i++ {
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("sim-validator-%d", i)))
+		privVals[i] = types.NewMockPVWithParams(privKey, false, false)
+		validators[i] = types.GenesisValidator{PubKey: privKey.PubKey(), Power: 10}
+	}
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		GenesisTime:   simGenesisTime,
+		InitialHeight: 1,
+		ChainID:       simChainID,
+		Validators:    validators,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < numValidators; i++ {
+		s.nodes = append(s.nodes, s.newNode(i, state.Copy(), privVals[i]))
+	}
+	return s
+}
+
+func (s *simulator) newNode(index int, state sm.State, pv types.PrivValidator) *simNode {
+	app := kvstore.NewApplication()
+	mtx := new(cmtsync.Mutex)
+	proxyAppConnCon := abcicli.NewLocalClient(mtx, app)
+	proxyAppConnMem := abcicli.NewLocalClient(mtx, app)
+	mempool := mempoolv0.NewCListMempool(cfg.TestMempoolConfig(),
+		proxyAppConnMem,
+		state.LastBlockHeight,
+		mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
+		mempoolv0.WithPostCheck(sm.TxPostCheck(state)))
+	evpool := sm.EmptyEvidencePool{}
+
+	blockDB := dbm.NewMemDB()
+	stateStore := sm.NewStore(blockDB, sm.StoreOptions{DiscardABCIResponses: false})
+	require.NoError(s.t, stateStore.Save(state))
+	blockStore := store.NewBlockStore(blockDB)
+	blockExec := sm.NewBlockExecutor(stateStore, log.NewNopLogger(), proxyAppConnCon, mempool, evpool)
+
+	cs := NewState(cfg.DefaultConsensusConfig(), state, blockExec, blockStore, mempool, evpool,
+		StateClock(func() time.Time { return s.now }))
+	cs.SetPrivValidator(pv)
+	ticker := &simTicker{sim: s, node: index}
+	cs.SetTimeoutTicker(ticker)
+
+	eventBus := types.NewEventBus()
+	require.NoError(s.t, eventBus.Start())
+	s.t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			s.t.Error(err)
+		}
+	})
+	cs.SetEventBus(eventBus)
+
+	return &simNode{
+		index:  index,
+		id:     p2p.ID(fmt.Sprintf("node%d", index)),
+		cs:     cs,
+		ticker: ticker,
+	}
+}
+
+// schedule adds an event to the queue and returns its sequence number.
+func (s *simulator) schedule(ev *simEvent) uint64 {
+	s.seq++
+	ev.seq = s.seq
+	heap.Push(&s.queue, ev)
+	return ev.seq
+}
+
+// start schedules the first round of every node and the gossip.
+func (s *simulator) start() {
+	for _, n := range s.nodes {
+		n.cs.scheduleRound0(n.cs.GetRoundState())
+	}
+	s.schedule(&simEvent{at: s.now.Add(simGossipInterval), kind: simEventGossip})
+}
+
+// step processes the next event. It returns false if the queue is empty.
+func (s *simulator) step() bool {
+	if len(s.queue) == 0 {
+		return false
+	}
+	ev := heap.Pop(&s.queue).(*simEvent)
+	s.now = ev.at
+
+	switch ev.kind {
+	case simEventTimeout:
+		n := s.nodes[ev.node]
+		if ev.seq != n.ticker.seq {
+			return true // replaced by a later timeout
+		}
+		n.cs.handleTimeout(ev.ti, n.cs.RoundState)
+		s.record(n, "timeout %v", ev.ti)
+		s.flush(n)
+
+	case simEventMessage:
+		n := s.nodes[ev.node]
+		n.cs.handleMsg(ev.mi)
+		s.record(n, "%T from %s", ev.mi.Msg, ev.mi.PeerID)
+		s.flush(n)
+
+	case simEventGossip:
+		for _, from := range s.nodes {
+			for _, to := range s.nodes {
+				if from != to {
+					s.gossip(from, to)
+				}
+			}
+		}
+		s.schedule(&simEvent{at: s.now.Add(simGossipInterval), kind: simEventGossip})
+	}
+	return true
+}
+
+func (s *simulator) record(n *simNode, format string, args ...interface{}) {
+	rs := &n.cs.RoundState
+	s.trace = append(s.trace, fmt.Sprintf("%s %s %d/%d/%s: %s",
+		s.now.Format(time.RFC3339Nano), n.id, rs.Height, rs.Round, rs.Step, fmt.Sprintf(format, args...)))
+}
+
+// flush handles the messages the node sent to itself, broadcasting them to
+// the other nodes, until there are none left.
+func (s *simulator) flush(n *simNode) {
+	for {
+		select {
+		case mi := <-n.cs.internalMsgQueue:
+			n.cs.handleMsg(mi)
+			for _, to := range s.nodes {
+				if to != n {
+					s.send(n, to, mi.Msg)
+				}
+			}
+		case <-n.cs.statsMsgQueue:
+		default:
+			return
+		}
+	}
+}
+
+// send delivers a copy of msg to a node after a random latency, unless the
+// message is dropped.
+func (s *simulator) send(from, to *simNode, msg Message) {
+	if s.partitions[from.index] != s.partitions[to.index] {
+		return
+	}
+	if s.dropRate > 0 && s.rng.Float64() < s.dropRate {
+		return
+	}
+	latency := s.minLatency
+	if s.maxLatency > s.minLatency {
+		latency += time.Duration(s.rng.Int63n(int64(s.maxLatency - s.minLatency)))
+	}
+
+	pb, err := MsgToProto(msg)
+	require.NoError(s.t, err)
+	msg, err = MsgFromProto(pb)
+	require.NoError(s.t, err)
+	s.schedule(&simEvent{
+		at:   s.now.Add(latency),
+		kind: simEventMessage,
+		node: to.index,
+		mi:   msgInfo{msg, from.id},
+	})
+}
+
+// gossip sends the proposal, block parts and votes known by from and missing
+// on to, similarly to the gossip routines of the reactor.
+func (s *simulator) gossip(from, to *simNode) {
+	fs, ts := &from.cs.RoundState, &to.cs.RoundState
+
+	switch {
+	case ts.Height < fs.Height:
+		// help the peer catch up with the committed block
+		commit := from.cs.LoadCommit(ts.Height)
+		meta := from.cs.blockStore.LoadBlockMeta(ts.Height)
+		if commit == nil || meta == nil {
+			return
+		}
+		for i, sig := range commit.Signatures {
+			if !sig.Absent() && ts.Votes.Precommits(commit.Round).GetByIndex(int32(i)) == nil {
+				s.send(from, to, &VoteMessage{commit.GetVote(int32(i))})
+			}
+		}
+		if ts.ProposalBlockParts != nil && ts.ProposalBlockParts.HasHeader(meta.BlockID.PartSetHeader) {
+			for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+				if ts.ProposalBlockParts.GetPart(i) == nil {
+					part := from.cs.blockStore.LoadBlockPart(ts.Height, i)
+					s.send(from, to, &BlockPartMessage{ts.Height, commit.Round, part})
+				}
+			}
+		}
+
+	case ts.Height == fs.Height:
+		if fs.Proposal != nil && ts.Proposal == nil && fs.Round == ts.Round {
+			s.send(from, to, &ProposalMessage{fs.Proposal})
+		}
+		if fs.ProposalBlockParts != nil && ts.ProposalBlockParts != nil &&
+			ts.ProposalBlockParts.HasHeader(fs.ProposalBlockParts.Header()) {
+			for i := 0; i < int(fs.ProposalBlockParts.Total()); i++ {
+				if part := fs.ProposalBlockParts.GetPart(i); part != nil && ts.ProposalBlockParts.GetPart(i) == nil {
+					s.send(from, to, &BlockPartMessage{fs.Height, fs.Round, part})
+				}
+			}
+		}
+		for round := int32(0); round <= fs.Round; round++ {
+			s.gossipVotes(from, to, fs.Votes.Prevotes(round), ts.Votes.Prevotes(round))
+			s.gossipVotes(from, to, fs.Votes.Precommits(round), ts.Votes.Precommits(round))
+		}
+	}
+}
+
+func (s *simulator) gossipVotes(from, to *simNode, have, peerHas *types.VoteSet) {
+	if have == nil {
+		return
+	}
+	for i := 0; i < have.Size(); i++ {
+		if vote := have.GetByIndex(int32(i)); vote != nil && peerHas.GetByIndex(int32(i)) == nil {
+			s.send(from, to, &VoteMessage{vote})
+		}
+	}
+}
+
+// runUntil processes events until cond is true, failing the test if that
+// doesn't happen within the given virtual duration.
+func (s *simulator) runUntil(d time.Duration, cond func() bool) {
+	deadline := s.now.Add(d)
+	for !cond() {
+		if len(s.queue) == 0 || s.queue[0].at.After(deadline) {
+			s.t.Fatalf("condition not met after %v of virtual time", d)
+		}
+		s.step()
+	}
+}
+
+// runFor processes the events of the given virtual duration.
+func (s *simulator) runFor(d time.Duration) {
+	deadline := s.now.Add(d)
+	for len(s.queue) > 0 && !s.queue[0].at.After(deadline) {
+		s.step()
+	}
+	s.now = deadline
+}
+
+// partition splits the network, dropping the messages between nodes which are
+// not in the same group. Nodes not listed are isolated.
+func (s *simulator) partition(groups ...[]int) {
+	for i := range s.partitions {
+		s.partitions[i] = -1 - i
+	}
+	for g, group := range groups {
+		for _, i := range group {
+			s.partitions[i] = g
+		}
+	}
+}
+
+// heal removes all partitions.
+func (s *simulator) heal() {
+	for i := range s.partitions {
+		s.partitions[i] = 0
+	}
+}
+
+// minHeight returns the lowest height the nodes are at.
+func (s *simulator) minHeight() int64 {
+	height := s.nodes[0].cs.Height
+	for _, n := range s.nodes[1:] {
+		if n.cs.Height < height {
+			height = n.cs.Height
+		}
+	}
+	return height
+}
+
+// assertSameBlocks checks the nodes committed the same blocks up to height.
+func (s *simulator) assertSameBlocks(height int64) {
+	for h := int64(1); h <= height; h++ {
+		hash := s.nodes[0].cs.blockStore.LoadBlockMeta(h).BlockID.Hash
+		for _, n := range s.nodes[1:] {
+			assert.Equal(s.t, hash, n.cs.blockStore.LoadBlockMeta(h).BlockID.Hash,
+				"%s committed a different block at height %d", n.id, h)
+		}
+	}
+}
+
+//-------------------------------------------------------------------------------
+// simulations
+
+func TestSimulatorCommitsBlocks(t *testing.T) {
+	s := newSimulator(t, 1, 4)
+	s.start()
+
+	s.runUntil(time.Minute, func() bool { return s.minHeight() > 5 })
+	s.assertSameBlocks(5)
+	for _, n := range s.nodes {
+		assert.EqualValues(t, 0, n.cs.blockStore.LoadBlockCommit(1).Round, "%s", n.id)
+	}
+}
+
+func TestSimulatorIsDeterministic(t *testing.T) {
+	run := func(seed int64) *simulator {
+		s := newSimulator(t, seed, 4)
+		s.dropRate = 0.2
+		s.start()
+		s.runUntil(5*time.Minute, func() bool { return s.minHeight() > 3 })
+		return s
+	}
+
+	s1, s2 := run(42), run(42)
+	require.Equal(t, s1.trace, s2.trace)
+	for h := int64(1); h <= 3; h++ {
+		assert.Equal(t,
+			s1.nodes[0].cs.blockStore.LoadBlockMeta(h).BlockID,
+			s2.nodes[0].cs.blockStore.LoadBlockMeta(h).BlockID)
+	}
+
+	s3 := run(43)
+	assert.NotEqual(t, s1.trace, s3.trace)
+}
+
+func TestSimulatorPartition(t *testing.T) {
+	s := newSimulator(t, 7, 4)
+	s.start()
+	s.runUntil(time.Minute, func() bool { return s.minHeight() > 2 })
+
+	// no side has +2/3 of the voting power, so no height can be committed
+	s.partition([]int{0, 1}, []int{2, 3})
+	s.runFor(time.Second)
+	height := s.minHeight()
+	s.runFor(time.Minute)
+	for _, n := range s.nodes {
+		assert.Equal(t, height, n.cs.Height, "%s", n.id)
+	}
+
+	// once healed, the nodes move to a common round and commit again
+	s.heal()
+	s.runUntil(5*time.Minute, func() bool { return s.minHeight() > height+3 })
+	s.assertSameBlocks(height + 3)
+}
+
+func TestSimulatorLaggingNodeCatchesUp(t *testing.T) {
+	s := newSimulator(t, 3, 4)
+	s.start()
+
+	// the other three validators have +2/3 of the voting power
+	s.partition([]int{0, 1, 2})
+	s.runUntil(time.Minute, func() bool { return s.nodes[0].cs.Height > 4 })
+	// alone, the fourth one never sees +2/3 prevotes after its propose timeout
+	assert.EqualValues(t, 1, s.nodes[3].cs.Height)
+	assert.Equal(t, cstypes.RoundStepPrevote, s.nodes[3].cs.Step)
+
+	s.heal()
+	s.runUntil(5*time.Minute, func() bool { return s.minHeight() > 5 })
+	s.assertSameBlocks(5)
+}
+
+func TestSimulatorRoundChangeWithoutProposer(t *testing.T) {
+	s := newSimulator(t, 5, 4)
+	proposer := s.nodes[0].cs.Validators.GetProposer().Address
+	var others []int
+	for _, n := range s.nodes {
+		if !bytes.Equal(n.cs.privValidatorPubKey.Address(), proposer) {
+			others = append(others, n.index)
+		}
+	}
+	require.Len(t, others, 3)
+
+	// the proposer of round 0 is isolated, so the others commit in a later round
+	s.partition(others)
+	s.start()
+	s.runUntil(time.Minute, func() bool { return s.nodes[others[0]].cs.Height > 1 })
+	assert.Greater(t, s.nodes[others[0]].cs.LoadCommit(1).Round, int32(0))
+}
@@ -15,6 +15,7 @@ import (
 	cfg "github.com/KYVENetwork/celestia-core/config"
 	cstypes "github.com/KYVENetwork/celestia-core/consensus/types"
 	"github.com/KYVENetwork/celestia-core/crypto"
+	auto "github.com/KYVENetwork/celestia-core/libs/autofile"
 	cmtevents "github.com/KYVENetwork/celestia-core/libs/events"
 	"github.com/KYVENetwork/celestia-core/libs/fail"
 	cmtjson "github.com/KYVENetwork/celestia-core/libs/json"
@@ -133,6 +134,9 @@ type State struct {
 	doPrevote      func(height int64, round int32)
 	setProposal    func(proposal *types.Proposal) error
 
+	// returns the current time, used for timeouts and timestamps
+	now func() time.Time
+
 	// closed when we finish shutting down
 	done chan struct{}
 
@@ -175,6 +179,7 @@ func NewState(
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
 		traceClient:      trace.NoOpTracer(),
+		now:              cmttime.Now,
 	}
 
 	// set function defaults (may be overwritten before calling Start)
@@ -182,6 +187,10 @@ func NewState(
 	cs.doPrevote = cs.defaultDoPrevote
 	cs.setProposal = cs.defaultSetProposal
 
+	for _, option := range options {
+		option(cs)
+	}
+
 	// We have no votes, so reconstruct LastCommit from SeenCommit.
 	if state.LastBlockHeight > 0 {
 		cs.reconstructLastCommit(state)
@@ -192,9 +201,6 @@ func NewState(
 	// NOTE: we do not call scheduleRound0 yet, we do that upon Start()
 
 	cs.BaseService = *service.NewBaseService(nil, "State", cs)
-	for _, option := range options {
-		option(cs)
-	}
 
 	return cs
 }
@@ -221,6 +227,12 @@ func SetTraceClient(ec trace.Tracer) StateOption {
 	return func(cs *State) { cs.traceClient = ec }
 }
 
+// StateClock sets the function returning the current time, which is used to
+// compute timeouts and timestamps. It defaults to cmttime.Now.
+func StateClock(now func() time.Time) StateOption {
+	return func(cs *State) { cs.now = now }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -443,13 +455,22 @@ func (cs *State) Wait() {
 // OpenWAL opens a file to log all consensus messages and timeouts for
 // deterministic accountability.
 func (cs *State) OpenWAL(walFile string) (WAL, error) {
-	wal, err := NewWAL(walFile)
+	var groupOptions []func(*auto.Group)
+	if cs.config.WalRetainHeights > 0 {
+		// files are pruned based on heights instead
+		groupOptions = append(groupOptions, auto.GroupTotalSizeLimit(0))
+	}
+
+	wal, err := NewWAL(walFile, groupOptions...)
 	if err != nil {
 		cs.Logger.Error("failed to open WAL", "file", walFile, "err", err)
 		return nil, err
 	}
 
 	wal.SetLogger(cs.Logger.With("wal", walFile))
+	if cs.config.WalRetainHeights > 0 {
+		wal.SetRetention(cs.config.WalRetainHeights, cs.config.WalArchiveDir())
+	}
 
 	if err := wal.Start(); err != nil {
 		cs.Logger.Error("failed to start WAL", "err", err)
@@ -546,8 +567,8 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
-	// cs.Logger.Info("scheduleRound0", "now", cmttime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(cmttime.Now())
+	// cs.Logger.Info("scheduleRound0", "now", cs.now(), "startTime", cs.StartTime)
+	sleepDuration := rs.StartTime.Sub(cs.now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
@@ -571,8 +592,21 @@ func (cs *State) sendInternalMessage(mi msgInfo) {
 }
 
 // Reconstruct LastCommit from SeenCommit, which we saved along with the block,
-// (which happens even before saving the state)
+// (which happens even before saving the state). If vote extensions were
+// enabled at the last height, the extended commit is used instead so that the
+// reconstructed precommits still carry their extensions.
 func (cs *State) reconstructLastCommit(state sm.State) {
+	if state.ConsensusParams.VoteExtensionsEnabled(state.LastBlockHeight) {
+		if extCommit := cs.blockStore.LoadBlockExtendedCommit(state.LastBlockHeight); extCommit != nil {
+			lastPrecommits := extCommit.ToExtendedVoteSet(state.ChainID, state.LastValidators)
+			if !lastPrecommits.HasTwoThirdsMajority() {
+				panic("failed to reconstruct last extended commit; does not have +2/3 maj")
+			}
+			cs.LastCommit = lastPrecommits
+			return
+		}
+	}
+
 	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
 	if seenCommit == nil {
 		panic(fmt.Sprintf(
@@ -672,13 +706,14 @@ func (cs *State) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(cmttime.Now())
+		cs.StartTime = cs.config.Commit(cs.now())
 	} else {
 		cs.StartTime = cs.config.Commit(cs.CommitTime)
 	}
 
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -931,7 +966,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 		cs.enterNewRound(ti.Height, 0)
 
 	case cstypes.RoundStepNewRound:
-		cs.enterPropose(ti.Height, 0)
+		cs.enterPropose(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPropose:
 		if err := cs.eventBus.PublishEventTimeoutPropose(cs.RoundStateEvent()); err != nil {
@@ -977,7 +1012,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(cmttime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 
 	case cstypes.RoundStepNewRound: // after timeoutCommit
@@ -1008,7 +1043,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := cmttime.Now(); cs.StartTime.After(now) {
+	if now := cs.now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
 
@@ -1033,6 +1068,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Debug("resetting proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1093,6 +1129,18 @@ func (cs *State) enterPropose(height int64, round int32) {
 
 	logger.Debug("entering propose step", "current", log.NewLazySprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
 
+	// With PBTS, the proposer waits for its clock to pass the previous block
+	// time, as its local time becomes the time of the proposed block.
+	if cs.state.ConsensusParams.PbtsEnabled(height) && cs.privValidatorPubKey != nil &&
+		cs.isProposer(cs.privValidatorPubKey.Address()) {
+		if now := cs.now(); !now.After(cs.state.LastBlockTime) {
+			wait := cs.state.LastBlockTime.Sub(now) + time.Millisecond
+			logger.Debug("propose step; waiting for the previous block time to pass", "wait", wait)
+			cs.scheduleTimeout(wait, height, round, cstypes.RoundStepNewRound)
+			return
+		}
+	}
+
 	defer func() {
 		// Done enterPropose:
 		cs.updateRoundStep(round, cstypes.RoundStepPropose)
@@ -1171,6 +1219,11 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.TwoThirdPrevoteRound, propBlockID)
+	proposal.Timestamp = cs.now()
+	if cs.state.ConsensusParams.PbtsEnabled(height) {
+		// validators check the block time against their clock via the proposal
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
@@ -1216,16 +1269,17 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 		panic("entered createProposalBlock with privValidator being nil")
 	}
 
-	var commit *types.Commit
+	var lastExtCommit *types.ExtendedCommit
 	switch {
 	case cs.Height == cs.state.InitialHeight:
 		// We're creating a proposal for the first block.
 		// The commit is empty, but not nil.
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		lastExtCommit = &types.ExtendedCommit{}
 
 	case cs.LastCommit.HasTwoThirdsMajority():
-		// Make the commit from LastCommit
-		commit = cs.LastCommit.MakeCommit()
+		// Make the commit from LastCommit. The vote extensions are only
+		// present if they were enabled at the last height.
+		lastExtCommit = cs.LastCommit.MakeExtendedCommit()
 
 	default: // This shouldn't happen.
 		cs.Logger.Error("propose step; cannot propose anything without commit for the previous block")
@@ -1241,7 +1295,7 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, lastExtCommit, proposerAddr, cs.now())
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1292,6 +1346,31 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	// With PBTS, the block time must be the proposal timestamp, which must be
+	// timely unless the block was already prevoted by +2/3 in a previous round.
+	if cs.state.ConsensusParams.PbtsEnabled(height) {
+		// The proposal block may be known from +2/3 votes for it, without the
+		// proposal.
+		if cs.Proposal == nil {
+			logger.Debug("prevote step: proposal is nil; prevoting nil")
+			cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+		if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+			logger.Debug("prevote step: proposal timestamp not equal to block time; prevoting nil",
+				"proposal", cs.Proposal.Timestamp, "block", cs.ProposalBlock.Time)
+			cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+		if cs.Proposal.POLRound == -1 && !cs.proposalIsTimely() {
+			logger.Debug("prevote step: proposal is not timely; prevoting nil",
+				"proposal", cs.Proposal.Timestamp, "received", cs.ProposalReceiveTime)
+			cs.metrics.UntimelyProposals.Add(1)
+			cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+	}
+
 	// Validate proposal block
 	err := cs.blockExec.ValidateBlock(cs.state, cs.ProposalBlock)
 	if err != nil {
@@ -1327,6 +1406,13 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 	cs.signAddVote(cmtproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
 }
 
+// proposalIsTimely returns true if the proposal was received within the
+// bounds of the synchrony params around its timestamp.
+func (cs *State) proposalIsTimely() bool {
+	sp := cs.state.ConsensusParams.Synchrony.InRound(cs.Proposal.Round)
+	return cs.Proposal.IsTimely(cs.ProposalReceiveTime, sp)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1536,7 +1622,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = cmttime.Now()
+		cs.CommitTime = cs.now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1655,8 +1741,18 @@ func (cs *State) finalizeCommit(height int64) {
 		// NOTE: the seenCommit is local justification to commit this block,
 		// but may differ from the LastCommit included in the next block
 		precommits := cs.Votes.Precommits(cs.CommitRound)
-		seenCommit = precommits.MakeCommit()
-		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		if cs.state.ConsensusParams.VoteExtensionsEnabled(block.Height) {
+			// Keep the vote extensions around for the proposer of the next
+			// height, which passes them to the application in PrepareProposal.
+			extCommit := precommits.MakeExtendedCommit()
+			seenCommit = extCommit.ToCommit()
+			if err := cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, extCommit); err != nil {
+				panic(fmt.Sprintf("failed to save block with extended commit: %v", err))
+			}
+		} else {
+			seenCommit = precommits.MakeCommit()
+			cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		}
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		logger.Debug("calling finalizeCommit on already stored block", "height", block.Height)
@@ -1888,6 +1984,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = cs.now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -2073,6 +2170,16 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return
 		}
 
+		// The application has already committed the height of the vote, so the
+		// extension of a late precommit can't be verified anymore. It is
+		// dropped, as the extended commit saved with the block, from which
+		// LastCommit is reconstructed on restart, doesn't include it either.
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			vote = vote.Copy()
+			vote.Extension = nil
+			vote.ExtensionSignature = nil
+		}
+
 		added, err = cs.LastCommit.AddVote(vote)
 		if !added {
 			return
@@ -2102,6 +2209,10 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		return
 	}
 
+	if err := cs.verifyVoteExtension(vote); err != nil {
+		return false, err
+	}
+
 	height := cs.Height
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
@@ -2264,16 +2375,61 @@ func (cs *State) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 
+	extEnabled := cs.state.ConsensusParams.VoteExtensionsEnabled(vote.Height)
+	if extEnabled && msgType == cmtproto.PrecommitType && !vote.BlockID.IsZero() {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		if len(ext) > types.MaxVoteExtensionSize {
+			return nil, fmt.Errorf("vote extension is too big (max: %d)", types.MaxVoteExtensionSize)
+		}
+		vote.Extension = ext
+	}
+
 	v := vote.ToProto()
-	err := cs.privValidator.SignVote(cs.state.ChainID, v)
+	err := cs.privValidator.SignVote(cs.state.ChainID, v, extEnabled)
 	vote.Signature = v.Signature
 	vote.Timestamp = v.Timestamp
+	vote.ExtensionSignature = v.ExtensionSignature
 
 	return vote, err
 }
 
+// verifyVoteExtension checks the vote extension of a precommit received from
+// another validator. If vote extensions are enabled at the height of the
+// vote, a precommit for a block must carry a validly signed extension which
+// the application accepts. Otherwise, votes must not carry an extension.
+func (cs *State) verifyVoteExtension(vote *types.Vote) error {
+	if !cs.state.ConsensusParams.VoteExtensionsEnabled(vote.Height) {
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			return fmt.Errorf("received vote with extension for height %d, but vote extensions are disabled", vote.Height)
+		}
+		return nil
+	}
+
+	if vote.Type != cmtproto.PrecommitType || vote.BlockID.IsZero() {
+		return nil
+	}
+
+	// Our own extensions were produced by the application itself.
+	if cs.privValidatorPubKey != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address()) {
+		return nil
+	}
+
+	_, val := cs.Validators.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return fmt.Errorf("cannot find validator %d in valSet of size %d", vote.ValidatorIndex, cs.Validators.Size())
+	}
+	if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+		return err
+	}
+
+	return cs.blockExec.VerifyVoteExtension(vote)
+}
+
 func (cs *State) voteTime() time.Time {
-	now := cmttime.Now()
+	now := cs.now()
 	minVoteTime := now
 	// Minimum time increment between blocks
 	const timeIota = time.Millisecond
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/KYVENetwork/celestia-core/abci/example/counter"
+	abci "github.com/KYVENetwork/celestia-core/abci/types"
 	cstypes "github.com/KYVENetwork/celestia-core/consensus/types"
 	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
 	"github.com/KYVENetwork/celestia-core/libs/log"
@@ -19,6 +20,7 @@ import (
 	p2pmock "github.com/KYVENetwork/celestia-core/p2p/mock"
 	cmtproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
 	"github.com/KYVENetwork/celestia-core/types"
+	cmttime "github.com/KYVENetwork/celestia-core/types/time"
 )
 
 /*
@@ -239,6 +241,229 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, cmtproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+func TestStatePBTSProposalTimeliness(t *testing.T) {
+	testCases := []struct {
+		name       string
+		blockDelta time.Duration // shift of the block time
+		tsDelta    time.Duration // shift of the proposal timestamp from the block time
+		timely     bool
+	}{
+		{"timely", 0, 0, true},
+		{"block time ahead of the local clock", time.Hour, 0, false},
+		{"block time too far behind the local clock", -time.Hour, 0, false},
+		{"timestamp not equal to block time", 0, time.Millisecond, false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cs1, vss := randState(2)
+			cs1.state.ConsensusParams.Feature.PbtsEnableHeight = cs1.state.InitialHeight
+			if tc.blockDelta < 0 {
+				cs1.state.LastBlockTime = cs1.state.LastBlockTime.Add(tc.blockDelta * 2)
+			}
+			height, round := cs1.Height, cs1.Round
+			vs2 := vss[1]
+
+			proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+			voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+			propBlock, _ := cs1.createProposalBlock()
+			propBlock.Time = propBlock.Time.Add(tc.blockDelta)
+
+			// make the second validator the proposer by incrementing round
+			round++
+			incrementRound(vss[1:]...)
+
+			propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+			blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+			proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+			proposal.Timestamp = propBlock.Time.Add(tc.tsDelta)
+			p := proposal.ToProto()
+			require.NoError(t, vs2.SignProposal(config.ChainID(), p))
+			proposal.Signature = p.Signature
+
+			require.NoError(t, cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"))
+			startTestRound(cs1, height, round)
+
+			ensureProposal(proposalCh, height, round, blockID)
+			ensurePrevote(voteCh, height, round)
+			if tc.timely {
+				validatePrevote(t, cs1, round, vss[0], propBlock.Hash())
+			} else {
+				validatePrevote(t, cs1, round, vss[0], nil)
+			}
+		})
+	}
+}
+
+// voteExtensionApp extends every precommit with the height of the vote and
+// only accepts extensions of this form.
+type voteExtensionApp struct {
+	abci.Application
+}
+
+func (app *voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: []byte(fmt.Sprintf("extension-%d", req.Height))}
+}
+
+func (app *voteExtensionApp) VerifyVoteExtension(
+	req abci.RequestVerifyVoteExtension,
+) abci.ResponseVerifyVoteExtension {
+	if bytes.Equal(req.VoteExtension, []byte(fmt.Sprintf("extension-%d", req.Height))) {
+		return abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}
+	}
+	return abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}
+}
+
+func signExtendedPrecommit(t *testing.T, vs *validatorStub, blockID types.BlockID, ext []byte) *types.Vote {
+	pubKey, err := vs.GetPubKey()
+	require.NoError(t, err)
+	vote := &types.Vote{
+		ValidatorIndex:   vs.Index,
+		ValidatorAddress: pubKey.Address(),
+		Height:           vs.Height,
+		Round:            vs.Round,
+		Timestamp:        cmttime.Now(),
+		Type:             cmtproto.PrecommitType,
+		BlockID:          blockID,
+		Extension:        ext,
+	}
+	v := vote.ToProto()
+	require.NoError(t, vs.SignVote(config.ChainID(), v, true))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+	return vote
+}
+
+func TestStateVoteExtensions(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	state.ConsensusParams.Feature.VoteExtensionsEnableHeight = state.InitialHeight
+	cs1 := newState(state, privVals[0], &voteExtensionApp{counter.NewApplication(true)})
+	vss := []*validatorStub{newValidatorStub(privVals[0], 0), newValidatorStub(privVals[1], 1)}
+	incrementHeight(vss[1:]...)
+	height, round := cs1.Height, cs1.Round
+	ext := []byte(fmt.Sprintf("extension-%d", height))
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	pubKey, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pubKey.Address())
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	blockID := types.BlockID{Hash: rs.ProposalBlock.Hash(), PartSetHeader: rs.ProposalBlockParts.Header()}
+
+	ensurePrevote(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrevoteType, blockID.Hash, blockID.PartSetHeader, vss[1])
+	ensurePrecommit(voteCh, height, round)
+
+	// our own precommit carries the extension provided by the application
+	precommit := cs1.GetRoundState().Votes.Precommits(round).GetByIndex(0)
+	require.NotNil(t, precommit)
+	assert.Equal(t, ext, precommit.Extension)
+	assert.NoError(t, precommit.VerifyExtension(config.ChainID(), pubKey))
+
+	// precommits without a signed extension, or with an extension rejected
+	// by the application, are not added
+	addVotes(cs1,
+		signVote(vss[1], cmtproto.PrecommitType, blockID.Hash, blockID.PartSetHeader),
+		signExtendedPrecommit(t, vss[1], blockID, []byte("invalid")),
+		signExtendedPrecommit(t, vss[1], blockID, ext),
+	)
+	ensureNewBlock(newBlockCh, height)
+
+	// the extensions are stored for the next proposer
+	extCommit := cs1.blockStore.LoadBlockExtendedCommit(height)
+	require.NotNil(t, extCommit)
+	require.Len(t, extCommit.ExtendedSignatures, 2)
+	for _, ecs := range extCommit.ExtendedSignatures {
+		assert.Equal(t, ext, ecs.Extension)
+	}
+}
+
+func TestStateVoteExtensionsLatePrecommit(t *testing.T) {
+	state, privVals := randGenesisState(4, false, 10)
+	state.ConsensusParams.Feature.VoteExtensionsEnableHeight = state.InitialHeight
+	cs1 := newState(state, privVals[0], &voteExtensionApp{counter.NewApplication(true)})
+	// wait for late precommits after the commit
+	consensusConfig := *cs1.config
+	consensusConfig.SkipTimeoutCommit = false
+	consensusConfig.TimeoutCommit = time.Minute
+	cs1.config = &consensusConfig
+	vss := make([]*validatorStub, len(privVals))
+	for i, pv := range privVals {
+		vss[i] = newValidatorStub(pv, int32(i))
+	}
+	incrementHeight(vss[1:]...)
+	height, round := cs1.Height, cs1.Round
+	ext := []byte(fmt.Sprintf("extension-%d", height))
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	pubKey, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pubKey.Address())
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	blockID := types.BlockID{Hash: rs.ProposalBlock.Hash(), PartSetHeader: rs.ProposalBlockParts.Header()}
+
+	ensurePrevote(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrevoteType, blockID.Hash, blockID.PartSetHeader, vss[1:]...)
+	ensurePrecommit(voteCh, height, round)
+
+	addVotes(cs1,
+		signExtendedPrecommit(t, vss[1], blockID, ext),
+		signExtendedPrecommit(t, vss[2], blockID, ext),
+	)
+	ensureNewBlock(newBlockCh, height)
+
+	// the late precommit is added to the last commit without its extension,
+	// which can no longer be verified by the application
+	addVotes(cs1, signExtendedPrecommit(t, vss[3], blockID, ext))
+	require.Eventually(t, func() bool {
+		cs1.mtx.RLock()
+		defer cs1.mtx.RUnlock()
+		return cs1.LastCommit.GetByIndex(3) != nil
+	}, time.Second, 10*time.Millisecond)
+
+	cs1.mtx.RLock()
+	late := cs1.LastCommit.GetByIndex(3)
+	lastExtCommit := cs1.LastCommit.MakeExtendedCommit()
+	cs1.mtx.RUnlock()
+	assert.Empty(t, late.Extension)
+	assert.Empty(t, late.ExtensionSignature)
+	assert.Empty(t, lastExtCommit.ExtendedSignatures[3].Extension)
+	assert.Equal(t, ext, lastExtCommit.ExtendedSignatures[1].Extension)
+
+	// the extended commit stored with the block doesn't include it either
+	extCommit := cs1.blockStore.LoadBlockExtendedCommit(height)
+	require.NotNil(t, extCommit)
+	assert.True(t, extCommit.ExtendedSignatures[3].Absent())
+}
+
+func TestStatePBTSPrevoteWithoutProposal(t *testing.T) {
+	cs1, _ := randState(2)
+	cs1.state.ConsensusParams.Feature.PbtsEnableHeight = cs1.state.InitialHeight
+	height, round := cs1.Height, cs1.Round
+
+	// The proposal block is known from +2/3 votes for it, but the proposal
+	// wasn't received.
+	propBlock, propBlockParts := cs1.createProposalBlock()
+	cs1.ProposalBlock, cs1.ProposalBlockParts = propBlock, propBlockParts
+	require.Nil(t, cs1.Proposal)
+
+	cs1.defaultDoPrevote(height, round)
+	mi := <-cs1.internalMsgQueue
+	msg, ok := mi.Msg.(*VoteMessage)
+	require.True(t, ok)
+	assert.Equal(t, cmtproto.PrevoteType, msg.Vote.Type)
+	assert.True(t, msg.Vote.BlockID.IsZero())
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	cs1, vss := randState(2)
 	cs1.state.ConsensusParams.Block.MaxBytes = 2000
@@ -7,6 +7,7 @@ import (
 	"hash/crc32"
 	"io"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -82,6 +83,14 @@ type BaseWAL struct {
 
 	flushTicker   *time.Ticker
 	flushInterval time.Duration
+
+	// height based retention, see SetRetention
+	retainHeights  int64
+	archiveDir     string
+	fileEndHeights map[int]int64 // last EndHeightMessage of the files of the group, by index
+
+	mtx           sync.Mutex
+	lastEndHeight int64
 }
 
 var _ WAL = &BaseWAL{}
@@ -99,9 +108,10 @@ func NewWAL(walFile string, groupOptions ...func(*auto.Group)) (*BaseWAL, error)
 		return nil, err
 	}
 	wal := &BaseWAL{
-		group:         group,
-		enc:           NewWALEncoder(group),
-		flushInterval: walDefaultFlushInterval,
+		group:          group,
+		enc:            NewWALEncoder(group),
+		flushInterval:  walDefaultFlushInterval,
+		fileEndHeights: make(map[int]int64),
 	}
 	wal.BaseService = *service.NewBaseService(nil, "baseWAL", wal)
 	return wal, nil
@@ -146,6 +156,9 @@ func (wal *BaseWAL) processFlushTicks() {
 			if err := wal.FlushAndSync(); err != nil {
 				wal.Logger.Error("Periodic WAL flush failed", "err", err)
 			}
+			if err := wal.pruneFiles(); err != nil {
+				wal.Logger.Error("Failed to prune WAL files", "err", err)
+			}
 		case <-wal.Quit():
 			return
 		}
@@ -192,6 +205,12 @@ func (wal *BaseWAL) Write(msg WALMessage) error {
 		return err
 	}
 
+	if m, ok := msg.(EndHeightMessage); ok {
+		wal.mtx.Lock()
+		wal.lastEndHeight = m.Height
+		wal.mtx.Unlock()
+	}
+
 	return nil
 }
 
@@ -279,7 +298,7 @@ func (wal *BaseWAL) SearchForEndHeight(
 		gr.Close()
 	}
 
-	return nil, false, nil
+	return wal.searchArchives(height, options)
 }
 
 // A WALEncoder writes custom-encoded WAL messages to an output stream.
@@ -0,0 +1,302 @@
+package consensus
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	auto "github.com/KYVENetwork/celestia-core/libs/autofile"
+	cmtos "github.com/KYVENetwork/celestia-core/libs/os"
+)
+
+const walArchiveExt = ".gz"
+
+// SetRetention makes the WAL keep the files holding the last retainHeights
+// heights. Older files are compressed into archiveDir, or deleted if archiveDir
+// is empty, once the WAL is flushed. Archived files are still searched by
+// SearchForEndHeight.
+//
+// Since files are removed based on heights, the group backing the WAL should
+// be opened without a total size limit (see auto.GroupTotalSizeLimit).
+func (wal *BaseWAL) SetRetention(retainHeights int64, archiveDir string) {
+	wal.retainHeights = retainHeights
+	wal.archiveDir = archiveDir
+}
+
+// pruneFiles archives or deletes the oldest files of the group as long as they
+// only hold heights which are not retained. The head is never pruned.
+func (wal *BaseWAL) pruneFiles() error {
+	wal.mtx.Lock()
+	lastEndHeight := wal.lastEndHeight
+	wal.mtx.Unlock()
+	if wal.retainHeights <= 0 || lastEndHeight <= wal.retainHeights {
+		return nil
+	}
+
+	for {
+		index := wal.group.MinIndex()
+		if index >= wal.group.MaxIndex() {
+			return nil
+		}
+
+		endHeight, err := wal.fileEndHeight(index)
+		if err != nil {
+			return err
+		}
+		// A file holds the messages of the heights up to endHeight+1, so
+		// it can only be pruned if endHeight+1 is not retained.
+		if endHeight == -1 || endHeight+1 > lastEndHeight-wal.retainHeights {
+			return nil
+		}
+
+		if wal.archiveDir != "" {
+			if err := wal.archiveFile(index); err != nil {
+				return err
+			}
+		}
+		if err := wal.group.RemoveOldestFile(); err != nil {
+			return err
+		}
+		delete(wal.fileEndHeights, index)
+		wal.Logger.Debug("Pruned WAL file", "index", index, "end_height", endHeight, "archived", wal.archiveDir != "")
+	}
+}
+
+// fileEndHeight returns the height of the last EndHeightMessage in the file of
+// the group at the given index, or -1 if there is none. Since the file is not
+// the head, it is never written to again and the result is cached.
+func (wal *BaseWAL) fileEndHeight(index int) (int64, error) {
+	if height, ok := wal.fileEndHeights[index]; ok {
+		return height, nil
+	}
+
+	height := int64(-1)
+	_, err := scanWALSegment(wal.group.FilePathForIndex(index), func(msg *TimedWALMessage, _ int64) error {
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			height = m.Height
+		}
+		return nil
+	})
+	if err != nil {
+		return -1, fmt.Errorf("failed to read WAL file %d: %w", index, err)
+	}
+
+	wal.fileEndHeights[index] = height
+	return height, nil
+}
+
+// archiveFile writes a compressed copy of the file of the group at the given
+// index into the archive directory.
+func (wal *BaseWAL) archiveFile(index int) error {
+	if err := cmtos.EnsureDir(wal.archiveDir, 0700); err != nil {
+		return fmt.Errorf("failed to ensure WAL archive directory is in place: %w", err)
+	}
+
+	src, err := os.Open(wal.group.FilePathForIndex(index))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	path := wal.archivePath(index)
+	tmp, err := os.CreateTemp(wal.archiveDir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // no-op once renamed
+
+	zw := gzip.NewWriter(tmp)
+	if _, err := io.Copy(zw, src); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (wal *BaseWAL) archivePath(index int) string {
+	return filepath.Join(wal.archiveDir,
+		fmt.Sprintf("%s.%03d%s", filepath.Base(wal.group.Head.Path), index, walArchiveExt))
+}
+
+// archivedIndexes returns the indexes of the archived files, in ascending
+// order.
+func (wal *BaseWAL) archivedIndexes() ([]int, error) {
+	prefix := filepath.Base(wal.group.Head.Path) + "."
+	entries, err := os.ReadDir(wal.archiveDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var indexes []int
+	for _, e := range entries {
+		name := e.Name()
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, walArchiveExt) {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, prefix), walArchiveExt))
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes, nil
+}
+
+// searchArchives is the counterpart of SearchForEndHeight for the archived
+// files. If found, the returned reader continues with the following archived
+// files and then with the files of the group.
+func (wal *BaseWAL) searchArchives(
+	height int64,
+	options *WALSearchOptions) (rd io.ReadCloser, found bool, err error) {
+	if wal.archiveDir == "" {
+		return nil, false, nil
+	}
+
+	indexes, err := wal.archivedIndexes()
+	if err != nil {
+		return nil, false, err
+	}
+
+	lastHeightFound := int64(-1)
+	for i := len(indexes) - 1; i >= 0; i-- {
+		ar, err := openWALArchive(wal.archivePath(indexes[i]))
+		if err != nil {
+			return nil, false, err
+		}
+
+		dec := NewWALDecoder(&walSegmentReader{rd: ar})
+		for {
+			msg, err := dec.Decode()
+			if err == io.EOF {
+				// no need to look for height in older files if we've seen h < height
+				if lastHeightFound > 0 && lastHeightFound < height {
+					ar.Close()
+					return nil, false, nil
+				}
+				break
+			}
+			if options.IgnoreDataCorruptionErrors && IsDataCorruptionError(err) {
+				wal.Logger.Error("Corrupted entry. Skipping...", "err", err)
+				continue
+			} else if err != nil {
+				ar.Close()
+				return nil, false, err
+			}
+
+			if m, ok := msg.Msg.(EndHeightMessage); ok {
+				lastHeightFound = m.Height
+				if m.Height == height { // found
+					wal.Logger.Info("Found in archive", "height", height, "index", indexes[i])
+					next := make([]string, 0, len(indexes)-i-1)
+					for _, index := range indexes[i+1:] {
+						next = append(next, wal.archivePath(index))
+					}
+					return &walArchiveReader{cur: ar, next: next, group: wal.group}, true, nil
+				}
+			}
+		}
+		ar.Close()
+	}
+
+	return nil, false, nil
+}
+
+// walArchive reads the decompressed content of an archived WAL file.
+type walArchive struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func openWALArchive(path string) (*walArchive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to open WAL archive %s: %w", path, err)
+	}
+	return &walArchive{zr, f}, nil
+}
+
+func (a *walArchive) Close() error {
+	a.Reader.Close()
+	return a.f.Close()
+}
+
+// walArchiveReader reads the remainder of an archived WAL file, followed by
+// the next archived files and finally by the files of the group. Like
+// auto.GroupReader, it fills the buffers passed to Read across files.
+type walArchiveReader struct {
+	cur   io.ReadCloser
+	next  []string
+	group *auto.Group
+	gr    *auto.GroupReader
+}
+
+func (r *walArchiveReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		nn, err := r.cur.Read(p[n:])
+		n += nn
+		if err == io.EOF {
+			if r.gr != nil {
+				break
+			}
+			if err := r.openNext(); err != nil {
+				return n, err
+			}
+		} else if err != nil {
+			return n, err
+		} else if nn == 0 {
+			break
+		}
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+func (r *walArchiveReader) openNext() error {
+	r.cur.Close()
+	if len(r.next) > 0 {
+		ar, err := openWALArchive(r.next[0])
+		if err != nil {
+			return err
+		}
+		r.cur, r.next = ar, r.next[1:]
+		return nil
+	}
+
+	gr, err := r.group.NewReader(r.group.MinIndex())
+	if err != nil {
+		return err
+	}
+	r.cur, r.gr = gr, gr
+	return nil
+}
+
+func (r *walArchiveReader) Close() error {
+	return r.cur.Close()
+}
@@ -0,0 +1,64 @@
+package consensus
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/libs/autofile"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	cmtos "github.com/KYVENetwork/celestia-core/libs/os"
+)
+
+func TestWALRetention(t *testing.T) {
+	for _, archive := range []bool{false, true} {
+		walFile := writeWALSegments(t, 5)
+		archiveDir := ""
+		if archive {
+			archiveDir = filepath.Join(filepath.Dir(walFile), "archive")
+		}
+
+		wal, err := NewWAL(walFile, autofile.GroupTotalSizeLimit(0))
+		require.NoError(t, err)
+		wal.SetLogger(log.TestingLogger())
+		wal.SetRetention(2, archiveDir)
+		wal.lastEndHeight = 5
+
+		// heights 4 and 5 are retained, and the file ending with height 3
+		// holds the beginning of height 4.
+		require.NoError(t, wal.pruneFiles())
+		assert.Equal(t, 2, wal.Group().MinIndex())
+		assert.False(t, cmtos.FileExists(walFile+".001"))
+
+		gr, found, err := wal.SearchForEndHeight(3, &WALSearchOptions{})
+		require.NoError(t, err)
+		require.True(t, found)
+		gr.Close()
+
+		gr, found, err = wal.SearchForEndHeight(1, &WALSearchOptions{})
+		require.NoError(t, err)
+		require.Equal(t, archive, found)
+		if !archive {
+			continue
+		}
+
+		// the reader continues through the archives and the group
+		var heights []int64
+		dec := NewWALDecoder(gr)
+		for {
+			msg, err := dec.Decode()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			if m, ok := msg.Msg.(EndHeightMessage); ok {
+				heights = append(heights, m.Height)
+			}
+		}
+		gr.Close()
+		assert.Equal(t, []int64{2, 3, 4, 5}, heights)
+	}
+}
@@ -0,0 +1,312 @@
+package consensus
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	auto "github.com/KYVENetwork/celestia-core/libs/autofile"
+	cmtos "github.com/KYVENetwork/celestia-core/libs/os"
+)
+
+// The functions in this file operate on a WAL which is not in use, e.g. from
+// the `cometbft wal` command. They rely on every message being written to a
+// single segment of the autofile.Group, which holds because Group.Write and
+// Group.RotateFile are mutually exclusive and the head buffer is flushed
+// before rotation.
+
+// WALSegmentInfo summarises a single file of the group backing a WAL.
+type WALSegmentInfo struct {
+	Index    int    `json:"index"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	Messages int    `json:"messages"`
+	// MinHeight and MaxHeight are the lowest and highest heights of the
+	// EndHeightMessages found in the segment, -1 if there are none.
+	MinHeight int64 `json:"min_height"`
+	MaxHeight int64 `json:"max_height"`
+	// Err is the first error encountered while decoding the segment, nil if
+	// the segment is intact. CorruptedAt is the offset of the first byte of
+	// the message which could not be decoded.
+	Err         error `json:"-"`
+	CorruptedAt int64 `json:"corrupted_at,omitempty"`
+}
+
+// InspectWAL decodes every segment of the WAL at walFile, verifying the
+// checksum of each message, and returns a summary of each segment. Segments
+// are decoded independently, so data corruption in one segment does not
+// prevent the following ones from being checked.
+func InspectWAL(walFile string) ([]WALSegmentInfo, error) {
+	paths, err := walSegmentPaths(walFile)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]WALSegmentInfo, 0, len(paths))
+	for _, seg := range paths {
+		info := WALSegmentInfo{
+			Index:     seg.index,
+			Path:      seg.path,
+			MinHeight: -1,
+			MaxHeight: -1,
+		}
+		fi, err := os.Stat(seg.path)
+		if err != nil {
+			return nil, err
+		}
+		info.Size = fi.Size()
+
+		offset, err := scanWALSegment(seg.path, func(msg *TimedWALMessage, _ int64) error {
+			info.Messages++
+			if m, ok := msg.Msg.(EndHeightMessage); ok {
+				if info.MinHeight == -1 || m.Height < info.MinHeight {
+					info.MinHeight = m.Height
+				}
+				if m.Height > info.MaxHeight {
+					info.MaxHeight = m.Height
+				}
+			}
+			return nil
+		})
+		if IsDataCorruptionError(err) {
+			info.Err = err
+			info.CorruptedAt = offset
+		} else if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// WalkWAL calls fn, in order, for every message of the WAL at walFile which
+// belongs to a height in [fromHeight, toHeight]. The messages of height h are
+// the ones written after the EndHeightMessage of h-1, up to and including the
+// EndHeightMessage of h. Messages preceding the first EndHeightMessage of the
+// WAL are skipped since their height is unknown.
+//
+// An error is returned if data corruption is found before toHeight has ended.
+func WalkWAL(walFile string, fromHeight, toHeight int64, fn func(*TimedWALMessage) error) error {
+	if fromHeight > toHeight {
+		return fmt.Errorf("invalid height range [%d, %d]", fromHeight, toHeight)
+	}
+
+	paths, err := walSegmentPaths(walFile)
+	if err != nil {
+		return err
+	}
+
+	errDone := errors.New("done")
+	height := int64(-1) // height of the messages being read, -1 if unknown
+	for _, seg := range paths {
+		_, err := scanWALSegment(seg.path, func(msg *TimedWALMessage, _ int64) error {
+			m, isEndHeight := msg.Msg.(EndHeightMessage)
+			if isEndHeight {
+				height = m.Height
+			}
+			if height >= fromHeight && height <= toHeight {
+				if err := fn(msg); err != nil {
+					return err
+				}
+			}
+			if isEndHeight {
+				height = m.Height + 1
+			}
+			if height > toHeight {
+				return errDone
+			}
+			return nil
+		})
+		if err == errDone {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read segment %s: %w", seg.path, err)
+		}
+	}
+
+	return nil
+}
+
+// ErrWALNotCorrupted is returned by TruncateWAL if the WAL is intact and the
+// truncation isn't forced.
+var ErrWALNotCorrupted = errors.New("WAL is not corrupted")
+
+// TruncateWAL removes everything written to the WAL at walFile after the last
+// intact EndHeightMessage preceding the first data corruption. This discards
+// the corrupted data as well as the messages of the height which was in
+// progress. Segments following the truncation point are removed. Unless force
+// is true, ErrWALNotCorrupted is returned if the WAL is intact, since
+// truncating it would only discard the messages of the height in progress.
+//
+// The segments which are modified or removed are first copied to a backup
+// directory next to the WAL. It returns the height of the EndHeightMessage the
+// WAL ends with afterwards, and the path of the backup directory.
+//
+// CONTRACT: the WAL must not be in use.
+func TruncateWAL(walFile string, force bool) (int64, string, error) {
+	paths, err := walSegmentPaths(walFile)
+	if err != nil {
+		return -1, "", err
+	}
+
+	var (
+		lastHeight = int64(-1)
+		lastSeg    = -1
+		lastOffset int64
+		corrupted  bool
+	)
+	for i, seg := range paths {
+		_, err := scanWALSegment(seg.path, func(msg *TimedWALMessage, end int64) error {
+			if m, ok := msg.Msg.(EndHeightMessage); ok {
+				lastHeight, lastSeg, lastOffset = m.Height, i, end
+			}
+			return nil
+		})
+		if IsDataCorruptionError(err) {
+			corrupted = true
+			break
+		} else if err != nil {
+			return -1, "", err
+		}
+	}
+	if !corrupted && !force {
+		return -1, "", ErrWALNotCorrupted
+	}
+	if lastSeg == -1 {
+		return -1, "", errors.New("no intact EndHeightMessage found")
+	}
+
+	backupDir, err := backupWALSegments(walFile, paths[lastSeg:])
+	if err != nil {
+		return -1, "", fmt.Errorf("failed to back up the WAL: %w", err)
+	}
+
+	if err := os.Truncate(paths[lastSeg].path, lastOffset); err != nil {
+		return -1, backupDir, fmt.Errorf("failed to truncate %s: %w", paths[lastSeg].path, err)
+	}
+	for _, seg := range paths[lastSeg+1:] {
+		if err := os.Remove(seg.path); err != nil {
+			return -1, backupDir, fmt.Errorf("failed to remove %s: %w", seg.path, err)
+		}
+	}
+	// The truncated segment becomes the head of the group.
+	if head := paths[len(paths)-1].path; lastSeg != len(paths)-1 {
+		if err := os.Rename(paths[lastSeg].path, head); err != nil {
+			return -1, backupDir, fmt.Errorf("failed to rename %s to %s: %w", paths[lastSeg].path, head, err)
+		}
+	}
+
+	return lastHeight, backupDir, nil
+}
+
+// backupWALSegments copies the given segments of the WAL at walFile to a new
+// directory next to the WAL, keeping their names, and returns its path. The
+// name of the directory doesn't start with the name of the WAL, so that it is
+// not mistaken for a segment of the group.
+func backupWALSegments(walFile string, segs []walSegmentPath) (string, error) {
+	dir, err := os.MkdirTemp(filepath.Dir(walFile),
+		fmt.Sprintf("backup-%s-%s-", filepath.Base(walFile), time.Now().UTC().Format("20060102T150405Z")))
+	if err != nil {
+		return "", err
+	}
+	for _, seg := range segs {
+		if err := copyFile(seg.path, filepath.Join(dir, filepath.Base(seg.path))); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// copyFile copies the file at src to dst, syncing dst to disk.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+type walSegmentPath struct {
+	index int
+	path  string
+}
+
+// walSegmentPaths returns the files of the group backing the WAL at walFile,
+// from the oldest to the head.
+func walSegmentPaths(walFile string) ([]walSegmentPath, error) {
+	if !cmtos.FileExists(walFile) {
+		return nil, fmt.Errorf("WAL file %s does not exist", walFile)
+	}
+
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return nil, err
+	}
+	defer group.Head.Close()
+
+	paths := make([]walSegmentPath, 0, group.MaxIndex()-group.MinIndex()+1)
+	for index := group.MinIndex(); index <= group.MaxIndex(); index++ {
+		paths = append(paths, walSegmentPath{index, group.FilePathForIndex(index)})
+	}
+	return paths, nil
+}
+
+// scanWALSegment decodes the messages of a single WAL segment, calling fn with
+// each message and the offset right after it. It stops at the end of the
+// segment or on the first error, returning the offset of the end of the last
+// message decoded.
+func scanWALSegment(path string, fn func(msg *TimedWALMessage, end int64) error) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	rd := &walSegmentReader{rd: bufio.NewReader(f)}
+	dec := NewWALDecoder(rd)
+	var offset int64
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			return offset, nil
+		} else if err != nil {
+			return offset, err
+		}
+		offset = rd.n
+		if err := fn(msg, offset); err != nil {
+			return offset, err
+		}
+	}
+}
+
+// walSegmentReader fills the buffers passed to Read whenever possible, since
+// WALDecoder expects full reads, and counts the bytes read.
+type walSegmentReader struct {
+	rd io.Reader
+	n  int64
+}
+
+func (r *walSegmentReader) Read(p []byte) (int, error) {
+	n, err := io.ReadFull(r.rd, p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,147 @@
+package consensus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/consensus/types"
+	cmttime "github.com/KYVENetwork/celestia-core/types/time"
+)
+
+// writeWALSegments writes a WAL made of one segment per height in [1,
+// numHeights], each holding a timeout message followed by the end of height.
+// The first segment also starts with EndHeightMessage{0}.
+func writeWALSegments(t *testing.T, numHeights int64) string {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	now := cmttime.Now()
+	for h := int64(1); h <= numHeights; h++ {
+		path := walFile
+		if h < numHeights {
+			path = fmt.Sprintf("%s.%03d", walFile, h-1)
+		}
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		enc := NewWALEncoder(f)
+		if h == 1 {
+			require.NoError(t, enc.Encode(&TimedWALMessage{now, EndHeightMessage{0}}))
+		}
+		require.NoError(t, enc.Encode(&TimedWALMessage{now, timeoutInfo{
+			Duration: time.Second, Height: h, Round: 0, Step: types.RoundStepPropose}}))
+		require.NoError(t, enc.Encode(&TimedWALMessage{now, EndHeightMessage{h}}))
+		require.NoError(t, f.Close())
+	}
+	return walFile
+}
+
+// appendGarbage appends an incomplete message to the given segment.
+func appendGarbage(t *testing.T, path string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x00, 0x10, 0xff})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestInspectWAL(t *testing.T) {
+	walFile := writeWALSegments(t, 3)
+	appendGarbage(t, walFile+".001")
+
+	infos, err := InspectWAL(walFile)
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	assert.Equal(t, 3, infos[0].Messages)
+	assert.EqualValues(t, 0, infos[0].MinHeight)
+	assert.EqualValues(t, 1, infos[0].MaxHeight)
+	assert.NoError(t, infos[0].Err)
+
+	assert.Equal(t, 2, infos[1].Messages)
+	assert.EqualValues(t, 2, infos[1].MaxHeight)
+	assert.True(t, IsDataCorruptionError(infos[1].Err))
+	assert.Equal(t, infos[1].Size-9, infos[1].CorruptedAt)
+
+	assert.Equal(t, walFile, infos[2].Path)
+	assert.EqualValues(t, 3, infos[2].MinHeight)
+	assert.NoError(t, infos[2].Err)
+}
+
+func TestWalkWAL(t *testing.T) {
+	walFile := writeWALSegments(t, 3)
+
+	var msgs []*TimedWALMessage
+	err := WalkWAL(walFile, 2, 3, func(msg *TimedWALMessage) error {
+		msgs = append(msgs, msg)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+	assert.EqualValues(t, 2, msgs[0].Msg.(timeoutInfo).Height)
+	assert.Equal(t, EndHeightMessage{2}, msgs[1].Msg)
+	assert.Equal(t, EndHeightMessage{3}, msgs[3].Msg)
+
+	// corruption after the requested range is not reported
+	appendGarbage(t, walFile)
+	err = WalkWAL(walFile, 1, 1, func(msg *TimedWALMessage) error { return nil })
+	require.NoError(t, err)
+	err = WalkWAL(walFile, 3, 4, func(msg *TimedWALMessage) error { return nil })
+	require.Error(t, err)
+}
+
+func TestTruncateWAL(t *testing.T) {
+	walFile := writeWALSegments(t, 3)
+
+	// an intact WAL is only truncated if forced
+	_, _, err := TruncateWAL(walFile, false)
+	require.ErrorIs(t, err, ErrWALNotCorrupted)
+
+	appendGarbage(t, walFile+".001")
+	corrupted, err := os.ReadFile(walFile + ".001")
+	require.NoError(t, err)
+	head, err := os.ReadFile(walFile)
+	require.NoError(t, err)
+
+	height, backupDir, err := TruncateWAL(walFile, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+
+	// the modified and removed segments are backed up
+	bz, err := os.ReadFile(filepath.Join(backupDir, "wal.001"))
+	require.NoError(t, err)
+	assert.Equal(t, corrupted, bz)
+	bz, err = os.ReadFile(filepath.Join(backupDir, "wal"))
+	require.NoError(t, err)
+	assert.Equal(t, head, bz)
+	assert.NoFileExists(t, filepath.Join(backupDir, "wal.000"))
+
+	infos, err := InspectWAL(walFile)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	assert.Equal(t, walFile, infos[1].Path)
+	assert.EqualValues(t, 2, infos[1].MaxHeight)
+	for _, info := range infos {
+		assert.NoError(t, info.Err)
+	}
+
+	wal, err := NewWAL(walFile)
+	require.NoError(t, err)
+	gr, found, err := wal.SearchForEndHeight(2, &WALSearchOptions{})
+	require.NoError(t, err)
+	assert.True(t, found)
+	gr.Close()
+
+	// forcing the truncation of an intact WAL discards the height in progress
+	f, err := os.OpenFile(walFile, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	require.NoError(t, NewWALEncoder(f).Encode(&TimedWALMessage{cmttime.Now(), timeoutInfo{
+		Duration: time.Second, Height: 3, Round: 0, Step: types.RoundStepPropose}}))
+	require.NoError(t, f.Close())
+	height, _, err = TruncateWAL(walFile, true)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+}
@@ -0,0 +1,137 @@
+package merkle
+
+import (
+	"errors"
+	"fmt"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	cmtcrypto "github.com/KYVENetwork/celestia-core/proto/celestiacore/crypto"
+)
+
+const (
+	// ProofOpIAVLCommitment is the type of the ICS23 proofs of IAVL trees, as
+	// used by the substores of the Cosmos SDK.
+	ProofOpIAVLCommitment = "ics23:iavl"
+	// ProofOpSimpleMerkleCommitment is the type of the ICS23 proofs of simple
+	// Merkle trees, as used by the multistore of the Cosmos SDK.
+	ProofOpSimpleMerkleCommitment = "ics23:simple"
+)
+
+// CommitmentOp takes a key and, for an existence proof, a single value as
+// argument and produces the root hash of the ICS23 commitment proof. The
+// structure of the tree is checked against the proof spec of the op type
+// (ics23.IavlSpec or ics23.TendermintSpec, the spec of the simple Merkle trees
+// of this package).
+//
+// If no value is given, the op proves the key is absent from the tree.
+//
+// If the produced root hash matches the expected hash, the
+// proof is good.
+type CommitmentOp struct {
+	Type  string
+	Spec  *ics23.ProofSpec
+	Key   []byte
+	Proof *ics23.CommitmentProof
+}
+
+var _ ProofOperator = CommitmentOp{}
+
+// NewIAVLCommitmentOp returns a CommitmentOp of an IAVL tree.
+func NewIAVLCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpIAVLCommitment,
+		Spec:  ics23.IavlSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
+// NewSimpleMerkleCommitmentOp returns a CommitmentOp of a simple Merkle tree.
+func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpSimpleMerkleCommitment,
+		Spec:  ics23.TendermintSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
+// CommitmentOpDecoder decodes the ProofOps of types ProofOpIAVLCommitment and
+// ProofOpSimpleMerkleCommitment.
+func CommitmentOpDecoder(pop cmtcrypto.ProofOp) (ProofOperator, error) {
+	var spec *ics23.ProofSpec
+	switch pop.Type {
+	case ProofOpIAVLCommitment:
+		spec = ics23.IavlSpec
+	case ProofOpSimpleMerkleCommitment:
+		spec = ics23.TendermintSpec
+	default:
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v or %v",
+			pop.Type, ProofOpIAVLCommitment, ProofOpSimpleMerkleCommitment)
+	}
+
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(pop.Data); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into CommitmentProof: %w", err)
+	}
+	return CommitmentOp{
+		Type:  pop.Type,
+		Spec:  spec,
+		Key:   pop.Key,
+		Proof: proof,
+	}, nil
+}
+
+func (op CommitmentOp) ProofOp() cmtcrypto.ProofOp {
+	bz, err := op.Proof.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return cmtcrypto.ProofOp{
+		Type: op.Type,
+		Key:  op.Key,
+		Data: bz,
+	}
+}
+
+func (op CommitmentOp) String() string {
+	return fmt.Sprintf("CommitmentOp{%v %v}", op.Type, op.GetKey())
+}
+
+func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, fmt.Errorf("root calculation: %w", err)
+	}
+
+	switch len(args) {
+	case 0:
+		if !ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key) {
+			return nil, errors.New("non-membership proof failed to verify")
+		}
+	case 1:
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+			return nil, errors.New("membership proof failed to verify")
+		}
+	default:
+		return nil, fmt.Errorf("expected 0 or 1 args, got %v", len(args))
+	}
+
+	return [][]byte{
+		root,
+	}, nil
+}
+
+func (op CommitmentOp) GetKey() []byte {
+	return op.Key
+}
+
+// StoreKeyPath returns the key path of the key in the named substore of a
+// multistore (e.g. of the Cosmos SDK), as proven by an ICS23 op of the
+// substore followed by an ICS23 op of the multistore.
+func StoreKeyPath(storeName string, key []byte) KeyPath {
+	return KeyPath{}.
+		AppendKey([]byte(storeName), KeyEncodingURL).
+		AppendKey(key, KeyEncodingHex)
+}
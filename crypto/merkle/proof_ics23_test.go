@@ -0,0 +1,237 @@
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
+	cmtcrypto "github.com/KYVENetwork/celestia-core/proto/celestiacore/crypto"
+)
+
+// ics23TestTree is a balanced tree of four leaves, built as an IAVL or simple
+// Merkle tree, with the existence proofs of its leaves.
+type ics23TestTree struct {
+	root   []byte
+	proofs map[string]*ics23.ExistenceProof
+}
+
+func newICS23TestTree(t *testing.T, iavl bool, keys, values [4][]byte) *ics23TestTree {
+	leafOp := &ics23.LeafOp{
+		Hash:         ics23.HashOp_SHA256,
+		PrehashValue: ics23.HashOp_SHA256,
+		Length:       ics23.LengthOp_VAR_PROTO,
+		Prefix:       []byte{0},
+	}
+	if iavl {
+		// height 0, size 1, version 1
+		leafOp.Prefix = []byte{0, 2, 2}
+	}
+	// innerOp returns the op of a node at the level (1 - the parent of the
+	// leaves), whose other child is the sibling.
+	innerOp := func(level int, left bool, sibling []byte) *ics23.InnerOp {
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if !iavl {
+			if left {
+				op.Prefix, op.Suffix = []byte{1}, sibling
+			} else {
+				op.Prefix = append([]byte{1}, sibling...)
+			}
+			return op
+		}
+		// height, size (of 2^level leaves) and version 1, with the length
+		// prefixed children
+		prefix := binary.AppendVarint(nil, int64(level))
+		prefix = binary.AppendVarint(prefix, int64(1)<<level)
+		prefix = binary.AppendVarint(prefix, 1)
+		if left {
+			op.Prefix = append(prefix, 32)
+			op.Suffix = append([]byte{32}, sibling...)
+		} else {
+			op.Prefix = append(append(append(prefix, 32), sibling...), 32)
+		}
+		return op
+	}
+
+	var leaves [4][]byte
+	for i := range leaves {
+		leaf, err := leafOp.Apply(keys[i], values[i])
+		require.NoError(t, err)
+		leaves[i] = leaf
+	}
+	var nodes [2][]byte
+	for i := range nodes {
+		node, err := innerOp(1, true, leaves[2*i+1]).Apply(leaves[2*i])
+		require.NoError(t, err)
+		nodes[i] = node
+	}
+	root, err := innerOp(2, true, nodes[1]).Apply(nodes[0])
+	require.NoError(t, err)
+
+	tree := &ics23TestTree{root: root, proofs: make(map[string]*ics23.ExistenceProof)}
+	for i := range leaves {
+		left := i%2 == 0
+		sibling := leaves[i^1]
+		nodeLeft := i < 2
+		nodeSibling := nodes[1-i/2]
+		tree.proofs[string(keys[i])] = &ics23.ExistenceProof{
+			Key:   keys[i],
+			Value: values[i],
+			Leaf:  leafOp,
+			Path:  []*ics23.InnerOp{innerOp(1, left, sibling), innerOp(2, nodeLeft, nodeSibling)},
+		}
+	}
+	return tree
+}
+
+func (tree *ics23TestTree) exist(key string) *ics23.CommitmentProof {
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{Exist: tree.proofs[key]},
+	}
+}
+
+// malformedExist returns the existence proof of the key, modified by malform.
+func (tree *ics23TestTree) malformedExist(key string, malform func(*ics23.ExistenceProof)) *ics23.CommitmentProof {
+	proof := *tree.proofs[key]
+	leaf := *proof.Leaf
+	proof.Leaf = &leaf
+	proof.Path = append([]*ics23.InnerOp(nil), proof.Path...)
+	malform(&proof)
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: &proof}}
+}
+
+func (tree *ics23TestTree) nonexist(key, left, right string) *ics23.CommitmentProof {
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: &ics23.NonExistenceProof{
+			Key:   []byte(key),
+			Left:  tree.proofs[left],
+			Right: tree.proofs[right],
+		}},
+	}
+}
+
+var (
+	ics23TestKeys   = [4][]byte{[]byte("b"), []byte("d"), []byte("f"), []byte("h")}
+	ics23TestValues = [4][]byte{[]byte("value-b"), []byte("value-d"), []byte("value-f"), []byte("value-h")}
+)
+
+func TestCommitmentOp(t *testing.T) {
+	for _, iavl := range []bool{true, false} {
+		tree := newICS23TestTree(t, iavl, ics23TestKeys, ics23TestValues)
+		newOp := NewSimpleMerkleCommitmentOp
+		if iavl {
+			newOp = NewIAVLCommitmentOp
+		}
+
+		testCases := []struct {
+			name  string
+			op    CommitmentOp
+			args  [][]byte
+			valid bool
+		}{
+			{"exist left-most", newOp([]byte("b"), tree.exist("b")), [][]byte{[]byte("value-b")}, true},
+			{"exist", newOp([]byte("f"), tree.exist("f")), [][]byte{[]byte("value-f")}, true},
+			{"exist right-most", newOp([]byte("h"), tree.exist("h")), [][]byte{[]byte("value-h")}, true},
+			{"exist wrong value", newOp([]byte("f"), tree.exist("f")), [][]byte{[]byte("value-d")}, false},
+			{"exist wrong key", newOp([]byte("d"), tree.exist("f")), [][]byte{[]byte("value-f")}, false},
+			{"exist no value", newOp([]byte("f"), tree.exist("f")), nil, false},
+			{"exist two values", newOp([]byte("f"), tree.exist("f")), [][]byte{[]byte("value-f"), nil}, false},
+			{"exist leaf with inner prefix", newOp([]byte("f"), tree.malformedExist("f", func(p *ics23.ExistenceProof) {
+				p.Leaf.Prefix = []byte{1}
+			})), [][]byte{[]byte("value-f")}, false},
+			{"exist inner prefix too long", newOp([]byte("f"), tree.malformedExist("f", func(p *ics23.ExistenceProof) {
+				op := *p.Path[0]
+				op.Prefix = append(bytes.Repeat([]byte{1}, 64), op.Prefix...)
+				p.Path[0] = &op
+			})), [][]byte{[]byte("value-f")}, false},
+			{"nonexist left-most", newOp([]byte("a"), tree.nonexist("a", "", "b")), nil, true},
+			{"nonexist neighbors", newOp([]byte("c"), tree.nonexist("c", "b", "d")), nil, true},
+			{"nonexist neighbors of subtrees", newOp([]byte("e"), tree.nonexist("e", "d", "f")), nil, true},
+			{"nonexist right-most", newOp([]byte("i"), tree.nonexist("i", "h", "")), nil, true},
+			{"nonexist with value", newOp([]byte("c"), tree.nonexist("c", "b", "d")), [][]byte{[]byte("value-c")}, false},
+			{"nonexist not left-most", newOp([]byte("c"), tree.nonexist("c", "", "d")), nil, false},
+			{"nonexist not right-most", newOp([]byte("g"), tree.nonexist("g", "f", "")), nil, false},
+			{"nonexist not neighbors", newOp([]byte("c"), tree.nonexist("c", "b", "f")), nil, false},
+			{"nonexist existing key", newOp([]byte("d"), tree.nonexist("d", "b", "f")), nil, false},
+			{"nonexist key not between", newOp([]byte("e"), tree.nonexist("e", "b", "d")), nil, false},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			name := "simple " + tc.name
+			if iavl {
+				name = "iavl " + tc.name
+			}
+			t.Run(name, func(t *testing.T) {
+				// round trip through the decoder
+				op, err := CommitmentOpDecoder(tc.op.ProofOp())
+				require.NoError(t, err)
+
+				res, err := op.Run(tc.args)
+				if !tc.valid {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, [][]byte{tree.root}, res)
+			})
+		}
+	}
+}
+
+func TestCommitmentOpSpecs(t *testing.T) {
+	iavlTree := newICS23TestTree(t, true, ics23TestKeys, ics23TestValues)
+	simpleTree := newICS23TestTree(t, false, ics23TestKeys, ics23TestValues)
+
+	// A simple Merkle tree is the tree of this package.
+	var items [][]byte
+	for i := range ics23TestKeys {
+		vhash := sha256.Sum256(ics23TestValues[i])
+		item := append(binary.AppendUvarint(nil, uint64(len(ics23TestKeys[i]))), ics23TestKeys[i]...)
+		item = append(binary.AppendUvarint(item, uint64(len(vhash))), vhash[:]...)
+		items = append(items, item)
+	}
+	assert.Equal(t, HashFromByteSlices(items), simpleTree.root)
+
+	// The proofs are only valid for their own spec.
+	_, err := NewSimpleMerkleCommitmentOp([]byte("d"), iavlTree.exist("d")).Run([][]byte{[]byte("value-d")})
+	assert.Error(t, err)
+	_, err = NewIAVLCommitmentOp([]byte("d"), simpleTree.exist("d")).Run([][]byte{[]byte("value-d")})
+	assert.Error(t, err)
+
+	_, err = CommitmentOpDecoder(cmtcrypto.ProofOp{Type: ProofOpValue})
+	assert.Error(t, err)
+}
+
+func TestCommitmentOpsStoreKeyPath(t *testing.T) {
+	// The bank store of a multistore of four stores.
+	bank := newICS23TestTree(t, true, ics23TestKeys, ics23TestValues)
+	storeNames := [4][]byte{[]byte("acc"), []byte("bank"), []byte("ibc"), []byte("staking")}
+	storeRoots := [4][]byte{tmhash.Sum([]byte("acc")), bank.root, tmhash.Sum([]byte("ibc")), tmhash.Sum([]byte("staking"))}
+	multistore := newICS23TestTree(t, false, storeNames, storeRoots)
+
+	prt := DefaultProofRuntime()
+	storeOp := NewSimpleMerkleCommitmentOp([]byte("bank"), multistore.exist("bank")).ProofOp()
+
+	proof := &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{
+		NewIAVLCommitmentOp([]byte("d"), bank.exist("d")).ProofOp(),
+		storeOp,
+	}}
+	kp := StoreKeyPath("bank", []byte("d"))
+	assert.Equal(t, "/bank/x:64", kp.String())
+	require.NoError(t, prt.VerifyValue(proof, multistore.root, kp.String(), []byte("value-d")))
+	assert.Error(t, prt.VerifyValue(proof, multistore.root, kp.String(), []byte("value-f")))
+	assert.Error(t, prt.VerifyValue(proof, multistore.root, StoreKeyPath("ibc", []byte("d")).String(), []byte("value-d")))
+	assert.Error(t, prt.VerifyAbsence(proof, multistore.root, kp.String()))
+
+	proof = &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{
+		NewIAVLCommitmentOp([]byte("e"), bank.nonexist("e", "d", "f")).ProofOp(),
+		storeOp,
+	}}
+	require.NoError(t, prt.VerifyAbsence(proof, multistore.root, StoreKeyPath("bank", []byte("e")).String()))
+	assert.Error(t, prt.VerifyAbsence(proof, multistore.root, StoreKeyPath("bank", []byte("d")).String()))
+}
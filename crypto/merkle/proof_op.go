@@ -105,22 +105,35 @@ type OpDecoder func(cmtcrypto.ProofOp) (ProofOperator, error)
 
 type ProofRuntime struct {
 	decoders map[string]OpDecoder
+	builtin  map[string]bool // types whose decoder may be replaced once
 }
 
 func NewProofRuntime() *ProofRuntime {
 	return &ProofRuntime{
 		decoders: make(map[string]OpDecoder),
+		builtin:  make(map[string]bool),
 	}
 }
 
+// RegisterOpDecoder registers the decoder of the proof ops of the type. It
+// panics if a decoder was already registered for the type, unless it is a
+// built-in decoder of DefaultProofRuntime, which is then replaced.
 func (prt *ProofRuntime) RegisterOpDecoder(typ string, dec OpDecoder) {
 	_, ok := prt.decoders[typ]
-	if ok {
+	if ok && !prt.builtin[typ] {
 		panic("already registered for type " + typ)
 	}
+	delete(prt.builtin, typ)
 	prt.decoders[typ] = dec
 }
 
+// registerBuiltinOpDecoder registers a decoder which may be replaced with
+// RegisterOpDecoder.
+func (prt *ProofRuntime) registerBuiltinOpDecoder(typ string, dec OpDecoder) {
+	prt.RegisterOpDecoder(typ, dec)
+	prt.builtin[typ] = true
+}
+
 func (prt *ProofRuntime) Decode(pop cmtcrypto.ProofOp) (ProofOperator, error) {
 	decoder := prt.decoders[pop.Type]
 	if decoder == nil {
@@ -174,11 +187,14 @@ func (prt *ProofRuntime) VerifyFromKeys(proof *cmtcrypto.ProofOps, root []byte,
 	return poz.VerifyFromKeys(root, keys, args)
 }
 
-// DefaultProofRuntime only knows about value proofs.
-// To use e.g. IAVL proofs, register op-decoders as
-// defined in the IAVL package.
+// DefaultProofRuntime knows about value proofs and the ICS23 proofs of IAVL
+// and simple Merkle trees, as used by the Cosmos SDK stores.
+// To use other proofs, register their op-decoders. The built-in decoders may
+// be replaced, e.g. by the decoder of the Cosmos SDK for the ICS23 proofs.
 func DefaultProofRuntime() (prt *ProofRuntime) {
 	prt = NewProofRuntime()
-	prt.RegisterOpDecoder(ProofOpValue, ValueOpDecoder)
+	prt.registerBuiltinOpDecoder(ProofOpValue, ValueOpDecoder)
+	prt.registerBuiltinOpDecoder(ProofOpIAVLCommitment, CommitmentOpDecoder)
+	prt.registerBuiltinOpDecoder(ProofOpSimpleMerkleCommitment, CommitmentOpDecoder)
 	return
 }
@@ -203,6 +203,25 @@ func bz(s string) []byte {
 	return []byte(s)
 }
 
+func TestProofRuntimeRegisterOpDecoder(t *testing.T) {
+	prt := DefaultProofRuntime()
+	decoded := false
+	dec := func(pop cmtcrypto.ProofOp) (ProofOperator, error) {
+		decoded = true
+		return CommitmentOpDecoder(pop)
+	}
+
+	// the built-in decoders can be replaced once
+	require.NotPanics(t, func() { prt.RegisterOpDecoder(ProofOpIAVLCommitment, dec) })
+	_, err := prt.Decode(cmtcrypto.ProofOp{Type: ProofOpIAVLCommitment})
+	require.NoError(t, err)
+	assert.True(t, decoded)
+	assert.Panics(t, func() { prt.RegisterOpDecoder(ProofOpIAVLCommitment, dec) })
+
+	prt.RegisterOpDecoder("test", dec)
+	assert.Panics(t, func() { prt.RegisterOpDecoder("test", dec) })
+}
+
 func TestProofValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName      string
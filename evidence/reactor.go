@@ -72,7 +72,7 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	evis, err := evidenceListFromProto(e.Message)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		evR.Switch.StopPeerForError(e.Src, err)
+		evR.Switch.StopPeerForFault(e.Src, err)
 		return
 	}
 
@@ -82,9 +82,10 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
 			// punish peer
-			evR.Switch.StopPeerForError(e.Src, err)
+			evR.Switch.StopPeerForFault(e.Src, err)
 			return
 		case nil:
+			evR.Switch.MarkPeerAsGood(e.Src)
 		default:
 			// continue to the next piece of evidence
 			evR.Logger.Error("Evidence has not been added", "evidence", evis, "err", err)
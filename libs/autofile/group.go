@@ -298,6 +298,22 @@ func (g *Group) checkTotalSizeLimit() {
 	}
 }
 
+// RemoveOldestFile removes the file with the lowest index of the group. It
+// returns an error if that file is the head.
+func (g *Group) RemoveOldestFile() error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.minIndex == g.maxIndex {
+		return errors.New("can't remove the head of the group")
+	}
+	if err := os.Remove(filePathForIndex(g.Head.Path, g.minIndex, g.maxIndex)); err != nil {
+		return err
+	}
+	g.minIndex++
+	return nil
+}
+
 // RotateFile causes group to close the current head and assign it some index.
 // Note it does not create a new head.
 func (g *Group) RotateFile() {
@@ -410,6 +426,14 @@ func (g *Group) readGroupInfo() GroupInfo {
 	return GroupInfo{minIndex, maxIndex, totalSize, headSize}
 }
 
+// FilePathForIndex returns the path of the file holding the given index,
+// which is the head path if index is the max index of the group.
+func (g *Group) FilePathForIndex(index int) string {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return filePathForIndex(g.Head.Path, index, g.maxIndex)
+}
+
 func filePathForIndex(headPath string, index int, maxIndex int) string {
 	if index == maxIndex {
 		return headPath
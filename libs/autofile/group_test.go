@@ -288,3 +288,26 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestRemoveOldestFile(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+
+	require.Error(t, g.RemoveOldestFile(), "the head can't be removed")
+
+	for i := 0; i < 2; i++ {
+		err := g.WriteLine("Line")
+		require.NoError(t, err)
+		err = g.FlushAndSync()
+		require.NoError(t, err)
+		g.RotateFile()
+	}
+
+	require.NoError(t, g.RemoveOldestFile())
+	assert.Equal(t, 1, g.MinIndex())
+	assert.False(t, cmtos.FileExists(g.Head.Path+".000"))
+	assert.True(t, cmtos.FileExists(g.Head.Path+".001"))
+	assertGroupInfo(t, g.ReadGroupInfo(), 1, 2, 5, 0)
+
+	// Cleanup
+	destroyTestGroup(t, g)
+}
@@ -0,0 +1,227 @@
+package light
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	"github.com/KYVENetwork/celestia-core/light/store"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+// CheckpointBundle is a minimal chain of light blocks, in ascending height
+// order, which bridges the validator set changes between a trusted light block
+// and a recent one. Each light block is signed by enough validators of the
+// previous one for it to be verified with skipping verification, so that a
+// light client can be bootstrapped from the bundle offline, instead of
+// fetching and verifying the light blocks in between from its providers.
+//
+// The bundle itself is not signed: its light blocks carry the commit
+// signatures of the validators, and the bundle is anchored at the trusted
+// light block of the trust options, so it is as trustworthy as the trust
+// options, whoever exported it.
+type CheckpointBundle struct {
+	ChainID     string              `json:"chain_id"`
+	LightBlocks []*types.LightBlock `json:"light_blocks"`
+}
+
+// NewCheckpointBundle creates a checkpoint bundle out of a chain of verified
+// light blocks, in ascending height order. Only the light blocks needed to
+// bridge the validator set changes are kept: each light block is followed by
+// the highest light block which can be verified from it using trustLevel, at
+// the time of that light block.
+func NewCheckpointBundle(
+	chainID string,
+	lightBlocks []*types.LightBlock,
+	trustingPeriod time.Duration,
+	maxClockDrift time.Duration,
+	trustLevel cmtmath.Fraction,
+) (*CheckpointBundle, error) {
+	if len(lightBlocks) == 0 {
+		return nil, errors.New("no light blocks")
+	}
+
+	bundle := &CheckpointBundle{ChainID: chainID, LightBlocks: []*types.LightBlock{lightBlocks[0]}}
+	for from := 0; from < len(lightBlocks)-1; {
+		to := len(lightBlocks) - 1
+		for ; to > from; to-- {
+			if verifyCheckpoint(lightBlocks[from], lightBlocks[to], trustingPeriod, maxClockDrift, trustLevel) == nil {
+				break
+			}
+		}
+		if to == from {
+			return nil, fmt.Errorf("no light block after height %d can be verified from it", lightBlocks[from].Height)
+		}
+		bundle.LightBlocks = append(bundle.LightBlocks, lightBlocks[to])
+		from = to
+	}
+	return bundle, nil
+}
+
+// ValidateBasic performs basic validation of the light blocks, without
+// verifying them against each other.
+func (b *CheckpointBundle) ValidateBasic() error {
+	if len(b.LightBlocks) == 0 {
+		return errors.New("no light blocks")
+	}
+	for i, lb := range b.LightBlocks {
+		if err := lb.ValidateBasic(b.ChainID); err != nil {
+			return fmt.Errorf("invalid light block #%d: %w", i, err)
+		}
+		if i > 0 && lb.Height <= b.LightBlocks[i-1].Height {
+			return fmt.Errorf("light block #%d at height %d is not above height %d",
+				i, lb.Height, b.LightBlocks[i-1].Height)
+		}
+	}
+	return nil
+}
+
+// Verify verifies the checkpoint bundle offline. The first light block must be
+// the one of the trust options, within the trusting period at now, and each
+// following light block must be verifiable from the previous one using
+// trustLevel, at the time of the following light block. The last light block
+// must not be ahead of now by more than maxClockDrift.
+func (b *CheckpointBundle) Verify(
+	trustOptions TrustOptions,
+	trustLevel cmtmath.Fraction,
+	maxClockDrift time.Duration,
+	now time.Time,
+) error {
+	if err := b.ValidateBasic(); err != nil {
+		return err
+	}
+
+	first := b.LightBlocks[0]
+	if first.Height != trustOptions.Height || !bytes.Equal(first.Hash(), trustOptions.Hash) {
+		return fmt.Errorf("first light block %X at height %d does not match trusted hash %X at height %d",
+			first.Hash(), first.Height, trustOptions.Hash, trustOptions.Height)
+	}
+	if HeaderExpired(first.SignedHeader, trustOptions.Period, now) {
+		return ErrOldHeaderExpired{first.Time.Add(trustOptions.Period), now}
+	}
+	err := first.ValidatorSet.VerifyCommitLight(b.ChainID, first.Commit.BlockID, first.Height, first.Commit)
+	if err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+
+	for i := 1; i < len(b.LightBlocks); i++ {
+		err := verifyCheckpoint(b.LightBlocks[i-1], b.LightBlocks[i], trustOptions.Period, maxClockDrift, trustLevel)
+		if err != nil {
+			return fmt.Errorf("failed to verify light block at height %d: %w", b.LightBlocks[i].Height, err)
+		}
+	}
+
+	last := b.LightBlocks[len(b.LightBlocks)-1]
+	if !last.Time.Before(now.Add(maxClockDrift)) {
+		return fmt.Errorf("last light block time %v is ahead of now %v (max clock drift %v)",
+			last.Time, now, maxClockDrift)
+	}
+	return nil
+}
+
+// verifyCheckpoint verifies the light block to from the light block from, at
+// the time of to, i.e. as it could have been verified when to was committed.
+func verifyCheckpoint(
+	from, to *types.LightBlock,
+	trustingPeriod time.Duration,
+	maxClockDrift time.Duration,
+	trustLevel cmtmath.Fraction,
+) error {
+	return Verify(from.SignedHeader, from.ValidatorSet, to.SignedHeader, to.ValidatorSet,
+		trustingPeriod, to.Time, maxClockDrift, trustLevel)
+}
+
+// NewClientFromCheckpointBundle returns a new light client, bootstrapped from
+// the checkpoint bundle anchored at the trust options, which must be within
+// the trusting period. The bundle is verified offline at the current time
+// before any provider is contacted.
+// The last light block is then cross-checked with the witnesses, and the light
+// blocks are saved to the trusted store.
+//
+// See all Option(s) for the additional configuration.
+func NewClientFromCheckpointBundle(
+	ctx context.Context,
+	chainID string,
+	trustOptions TrustOptions,
+	bundle *CheckpointBundle,
+	primary provider.Provider,
+	witnesses []provider.Provider,
+	trustedStore store.Store,
+	options ...Option) (*Client, error) {
+
+	if err := trustOptions.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid TrustOptions: %w", err)
+	}
+	if bundle.ChainID != chainID {
+		return nil, fmt.Errorf("checkpoint bundle is for chain %s, expected %s", bundle.ChainID, chainID)
+	}
+
+	c, err := NewClientFromTrustedStore(chainID, trustOptions.Period, primary, witnesses, trustedStore, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bundle.Verify(trustOptions, c.trustLevel, c.maxClockDrift, time.Now()); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint bundle: %w", err)
+	}
+
+	last := bundle.LightBlocks[len(bundle.LightBlocks)-1]
+	if err := c.compareFirstHeaderWithWitnesses(ctx, last.SignedHeader); err != nil {
+		return nil, err
+	}
+
+	for _, lb := range bundle.LightBlocks {
+		if err := c.updateTrustedLightBlock(lb); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// ExportCheckpointBundle creates a checkpoint bundle out of the light blocks in
+// the trusted store, from the first to the latest trusted light block. As only
+// the light blocks verified by the client are stored, the light blocks needed
+// to verify them from each other are fetched from the primary, using skipping
+// verification.
+func (c *Client) ExportCheckpointBundle(ctx context.Context) (*CheckpointBundle, error) {
+	firstHeight, err := c.trustedStore.FirstLightBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	lastHeight, err := c.trustedStore.LastLightBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	if firstHeight <= 0 || lastHeight <= 0 {
+		return nil, errors.New("no trusted light blocks")
+	}
+
+	var stored []*types.LightBlock
+	err = c.trustedStore.IterateLightBlocks(firstHeight, lastHeight, func(lb *types.LightBlock) bool {
+		stored = append(stored, lb)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lightBlocks := []*types.LightBlock{stored[0]}
+	for _, to := range stored[1:] {
+		from := lightBlocks[len(lightBlocks)-1]
+		if verifyCheckpoint(from, to, c.trustingPeriod, c.maxClockDrift, c.trustLevel) == nil {
+			lightBlocks = append(lightBlocks, to)
+			continue
+		}
+		trace, err := c.verifySkipping(ctx, c.primary, from, to, to.Time)
+		if err != nil {
+			return nil, fmt.Errorf("can't bridge light blocks at heights %d and %d: %w", from.Height, to.Height, err)
+		}
+		lightBlocks = append(lightBlocks, trace[1:]...)
+	}
+
+	return NewCheckpointBundle(c.chainID, lightBlocks, c.trustingPeriod, c.maxClockDrift, c.trustLevel)
+}
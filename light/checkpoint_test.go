@@ -0,0 +1,150 @@
+package light_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtjson "github.com/KYVENetwork/celestia-core/libs/json"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	"github.com/KYVENetwork/celestia-core/light"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	mockp "github.com/KYVENetwork/celestia-core/light/provider/mock"
+	dbs "github.com/KYVENetwork/celestia-core/light/store/db"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+func TestClient_CheckpointBundle(t *testing.T) {
+	// the validator set changes by 20% at every height. The bundle is imported
+	// at the current time, so the light blocks are recent.
+	start := time.Now().Add(-2 * time.Hour)
+	node := mockp.New(genMockNode(chainID, 50, 10, 2, start))
+	first, err := node.LightBlock(ctx, 1)
+	require.NoError(t, err)
+	options := light.TrustOptions{
+		Period: 4 * time.Hour,
+		Height: 1,
+		Hash:   first.Hash(),
+	}
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		options,
+		node,
+		[]provider.Provider{node},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.SequentialVerification(),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+	_, err = c.VerifyLightBlockAtHeight(ctx, 50, time.Now())
+	require.NoError(t, err)
+
+	// only the light blocks bridging the validator set changes are exported
+	bundle, err := c.ExportCheckpointBundle(ctx)
+	require.NoError(t, err)
+	require.Greater(t, len(bundle.LightBlocks), 2)
+	require.Less(t, len(bundle.LightBlocks), 50)
+	assert.EqualValues(t, 1, bundle.LightBlocks[0].Height)
+	assert.EqualValues(t, 50, bundle.LightBlocks[len(bundle.LightBlocks)-1].Height)
+
+	bz, err := cmtjson.Marshal(bundle)
+	require.NoError(t, err)
+	imported := &light.CheckpointBundle{}
+	require.NoError(t, cmtjson.Unmarshal(bz, imported))
+	require.NoError(t, imported.Verify(options, light.DefaultTrustLevel, 10*time.Second, time.Now()))
+
+	// the bundle is verified before contacting any provider
+	_, err = light.NewClientFromCheckpointBundle(
+		ctx,
+		chainID,
+		light.TrustOptions{Period: options.Period, Height: 1, Hash: hash("other")},
+		imported,
+		deadNode,
+		[]provider.Provider{deadNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+	)
+	assert.Error(t, err)
+
+	// the anchor must be within the trusting period
+	_, err = light.NewClientFromCheckpointBundle(
+		ctx,
+		chainID,
+		light.TrustOptions{Period: time.Hour, Height: 1, Hash: first.Hash()},
+		imported,
+		deadNode,
+		[]provider.Provider{deadNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+	)
+	assert.Error(t, err)
+
+	c, err = light.NewClientFromCheckpointBundle(
+		ctx,
+		chainID,
+		options,
+		imported,
+		node,
+		[]provider.Provider{node},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+	height, err := c.LastTrustedHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 50, height)
+}
+
+func TestCheckpointBundle_Verify(t *testing.T) {
+	node := mockp.New(genMockNode(chainID, 10, 10, 2, bTime))
+	lbs := make([]*types.LightBlock, 0, 10)
+	for height := int64(1); height <= 10; height++ {
+		lb, err := node.LightBlock(ctx, height)
+		require.NoError(t, err)
+		lbs = append(lbs, lb)
+	}
+	bundle, err := light.NewCheckpointBundle(chainID, lbs, 4*time.Hour, 10*time.Second, light.DefaultTrustLevel)
+	require.NoError(t, err)
+	options := light.TrustOptions{Period: 4 * time.Hour, Height: 1, Hash: lbs[0].Hash()}
+	now := bTime.Add(2 * time.Hour)
+
+	testCases := []struct {
+		name    string
+		modify  func(b *light.CheckpointBundle) *light.CheckpointBundle
+		now     time.Time
+		wantErr bool
+	}{
+		{"valid", func(b *light.CheckpointBundle) *light.CheckpointBundle { return b }, now, false},
+		{"expired", func(b *light.CheckpointBundle) *light.CheckpointBundle { return b }, bTime.Add(8 * time.Hour), true},
+		{"anchor expired", func(b *light.CheckpointBundle) *light.CheckpointBundle { return b },
+			bTime.Add(4*time.Hour + 5*time.Minute), true},
+		{"ahead of now", func(b *light.CheckpointBundle) *light.CheckpointBundle { return b }, bTime, true},
+		{"other chain", func(b *light.CheckpointBundle) *light.CheckpointBundle {
+			return &light.CheckpointBundle{ChainID: "other", LightBlocks: b.LightBlocks}
+		}, now, true},
+		{"not anchored at trusted light block", func(b *light.CheckpointBundle) *light.CheckpointBundle {
+			return &light.CheckpointBundle{ChainID: chainID, LightBlocks: b.LightBlocks[1:]}
+		}, now, true},
+		{"gap in validator set changes", func(b *light.CheckpointBundle) *light.CheckpointBundle {
+			return &light.CheckpointBundle{ChainID: chainID, LightBlocks: []*types.LightBlock{lbs[0], lbs[9]}}
+		}, now, true},
+		{"descending heights", func(b *light.CheckpointBundle) *light.CheckpointBundle {
+			return &light.CheckpointBundle{ChainID: chainID, LightBlocks: []*types.LightBlock{lbs[0], lbs[2], lbs[1]}}
+		}, now, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.modify(bundle).Verify(options, light.DefaultTrustLevel, 10*time.Second, tc.now)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
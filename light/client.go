@@ -39,6 +39,11 @@ const (
 
 	// 10s is sufficient for most networks.
 	defaultMaxBlockLag = 10 * time.Second
+
+	// concurrentPrimariesTimeout bounds the light block requests to concurrent
+	// primaries, which outlive the request of the caller in order to cross-check
+	// the remaining responses.
+	concurrentPrimariesTimeout = 30 * time.Second
 )
 
 // Option sets a parameter for the light client.
@@ -73,12 +78,30 @@ func SkippingVerification(trustLevel cmtmath.Fraction) Option {
 // client stores. When Prune() is run, all light blocks that are earlier than
 // the h amount of light blocks will be removed from the store.
 // Default: 1000. A pruning size of 0 will not prune the light client at all.
-func PruningSize(h uint16) Option {
+func PruningSize(h uint32) Option {
 	return func(c *Client) {
 		c.pruningSize = h
 	}
 }
 
+// PruningPeriod option sets the maximum age of the light blocks that the light
+// client stores. When Prune() is run, all light blocks with a header time
+// earlier than now - d will be removed from the store, except the latest one.
+// Default: 0, i.e. light blocks are not pruned by age.
+func PruningPeriod(d time.Duration) Option {
+	return func(c *Client) {
+		c.pruningPeriod = d
+	}
+}
+
+// EvidenceSinks option sets the sinks, which evidence of light client attacks
+// is reported to, in addition to the providers. No sinks are set by default.
+func EvidenceSinks(sinks ...EvidenceSink) Option {
+	return func(c *Client) {
+		c.evidenceSinks = sinks
+	}
+}
+
 // ConfirmationFunction option can be used to prompt to confirm an action. For
 // example, remove newer headers if the light client is being reset with an
 // older header. No confirmation is required by default!
@@ -125,6 +148,17 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// ConcurrentPrimaries option configures the light client to request light
+// blocks from the primary and the first n-1 witnesses in parallel. The first
+// light block received is used and the others are cross-checked against it in
+// the background, which avoids latency spikes when the primary is slow.
+// Default: 1 (only the primary is requested).
+func ConcurrentPrimaries(n uint16) Option {
+	return func(c *Client) {
+		c.concurrentPrimaries = n
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -138,6 +172,8 @@ type Client struct {
 	maxRetryAttempts uint16 // see MaxRetryAttempts option
 	maxClockDrift    time.Duration
 	maxBlockLag      time.Duration
+	// See ConcurrentPrimaries option
+	concurrentPrimaries uint16
 
 	// Mutex for locking during changes of the light clients providers
 	providerMutex cmtsync.Mutex
@@ -151,8 +187,12 @@ type Client struct {
 	// Highest trusted light block from the store (height=H).
 	latestTrustedBlock *types.LightBlock
 
-	// See RemoveNoLongerTrustedHeadersPeriod option
-	pruningSize uint16
+	// See PruningSize option
+	pruningSize uint32
+	// See PruningPeriod option
+	pruningPeriod time.Duration
+	// See EvidenceSinks option
+	evidenceSinks []EvidenceSink
 	// See ConfirmationFunction option
 	confirmationFn func(action string) bool
 
@@ -444,13 +484,13 @@ func (c *Client) Update(ctx context.Context, now time.Time) (*types.LightBlock,
 		return nil, nil
 	}
 
-	latestBlock, err := c.lightBlockFromPrimary(ctx, 0)
+	latestBlock, source, err := c.lightBlockAndSourceFromPrimary(ctx, 0)
 	if err != nil {
 		return nil, err
 	}
 
 	if latestBlock.Height > lastTrustedHeight {
-		err = c.verifyLightBlock(ctx, latestBlock, now)
+		err = c.verifyLightBlock(ctx, latestBlock, source, now)
 		if err != nil {
 			return nil, err
 		}
@@ -485,12 +525,12 @@ func (c *Client) VerifyLightBlockAtHeight(ctx context.Context, height int64, now
 	}
 
 	// Request the light block from primary
-	l, err := c.lightBlockFromPrimary(ctx, height)
+	l, source, err := c.lightBlockAndSourceFromPrimary(ctx, height)
 	if err != nil {
 		return nil, err
 	}
 
-	return l, c.verifyLightBlock(ctx, l, now)
+	return l, c.verifyLightBlock(ctx, l, source, now)
 }
 
 // VerifyHeader verifies a new header against the trusted state. It returns
@@ -543,7 +583,7 @@ func (c *Client) VerifyHeader(ctx context.Context, newHeader *types.Header, now
 	}
 
 	// Request the header and the vals.
-	l, err = c.lightBlockFromPrimary(ctx, newHeader.Height)
+	l, source, err := c.lightBlockAndSourceFromPrimary(ctx, newHeader.Height)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve light block from primary to verify against: %w", err)
 	}
@@ -552,15 +592,23 @@ func (c *Client) VerifyHeader(ctx context.Context, newHeader *types.Header, now
 		return fmt.Errorf("light block header %X does not match newHeader %X", l.Hash(), newHeader.Hash())
 	}
 
-	return c.verifyLightBlock(ctx, l, now)
+	return c.verifyLightBlock(ctx, l, source, now)
 }
 
-func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.LightBlock, now time.Time) error {
+// verifyLightBlock verifies the new light block, which was received from source, and saves it to
+// the trusted store.
+func (c *Client) verifyLightBlock(
+	ctx context.Context,
+	newLightBlock *types.LightBlock,
+	source provider.Provider,
+	now time.Time,
+) error {
 	c.logger.Info("VerifyHeader", "height", newLightBlock.Height, "hash", newLightBlock.Hash())
 
 	var (
-		verifyFunc func(ctx context.Context, trusted *types.LightBlock, new *types.LightBlock, now time.Time) error
-		err        error
+		verifyFunc func(ctx context.Context, source provider.Provider, trusted *types.LightBlock,
+			new *types.LightBlock, now time.Time) error
+		err error
 	)
 
 	switch c.verificationMode {
@@ -580,7 +628,7 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 	switch {
 	// Verifying forwards
 	case newLightBlock.Height >= c.latestTrustedBlock.Height:
-		err = verifyFunc(ctx, c.latestTrustedBlock, newLightBlock, now)
+		err = verifyFunc(ctx, source, c.latestTrustedBlock, newLightBlock, now)
 
 	// Verifying backwards
 	case newLightBlock.Height < firstBlockHeight:
@@ -598,7 +646,7 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 		if err != nil {
 			return fmt.Errorf("can't get signed header before height %d: %w", newLightBlock.Height, err)
 		}
-		err = verifyFunc(ctx, closestBlock, newLightBlock, now)
+		err = verifyFunc(ctx, source, closestBlock, newLightBlock, now)
 	}
 	if err != nil {
 		c.logger.Error("Can't verify", "err", err)
@@ -612,6 +660,7 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 // see VerifyHeader
 func (c *Client) verifySequential(
 	ctx context.Context,
+	source provider.Provider,
 	trustedBlock *types.LightBlock,
 	newLightBlock *types.LightBlock,
 	now time.Time) error {
@@ -619,6 +668,7 @@ func (c *Client) verifySequential(
 	var (
 		verifiedBlock = trustedBlock
 		interimBlock  *types.LightBlock
+		interimSource provider.Provider
 		err           error
 		trace         = []*types.LightBlock{trustedBlock}
 	)
@@ -628,7 +678,7 @@ func (c *Client) verifySequential(
 		if height == newLightBlock.Height { // last light block
 			interimBlock = newLightBlock
 		} else { // intermediate light blocks
-			interimBlock, err = c.lightBlockFromPrimary(ctx, height)
+			interimBlock, interimSource, err = c.lightBlockAndSourceFromPrimary(ctx, height)
 			if err != nil {
 				return ErrVerificationFailed{From: verifiedBlock.Height, To: height, Reason: err}
 			}
@@ -654,13 +704,14 @@ func (c *Client) verifySequential(
 					return err
 				}
 
-				// If some intermediate header is invalid, replace the primary and try
-				// again.
-				c.logger.Error("primary sent invalid header -> replacing", "err", err)
+				// If some intermediate header is invalid, remove the provider which sent
+				// it and try again.
+				c.logger.Error("provider sent invalid header -> removing", "err", err, "provider", interimSource)
 
-				replacementBlock, removeErr := c.findNewPrimary(ctx, newLightBlock.Height, true)
+				replacementBlock, replacementSource, removeErr := c.removeFaultyProvider(ctx, interimSource,
+					newLightBlock.Height)
 				if removeErr != nil {
-					c.logger.Debug("failed to replace primary. Returning original error", "err", removeErr)
+					c.logger.Debug("failed to remove provider. Returning original error", "err", removeErr)
 					return err
 				}
 
@@ -673,6 +724,7 @@ func (c *Client) verifySequential(
 				}
 
 				// attempt to verify header again
+				source = replacementSource
 				height--
 
 				continue
@@ -693,7 +745,7 @@ func (c *Client) verifySequential(
 	//
 	// CORRECTNESS ASSUMPTION: there's at least 1 correct full node
 	// (primary or one of the witnesses).
-	return c.detectDivergence(ctx, trace, now)
+	return c.detectDivergence(ctx, trace, source, now)
 }
 
 // see VerifyHeader
@@ -772,15 +824,17 @@ func (c *Client) verifySkipping(
 	}
 }
 
-// verifySkippingAgainstPrimary does verifySkipping plus it compares new header with
-// witnesses and replaces primary if it sends the light client an invalid header
+// verifySkippingAgainstPrimary does verifySkipping, requesting the interim light blocks from the
+// source of the new light block, plus it compares new header with the other providers and
+// replaces the source if it sends the light client an invalid header
 func (c *Client) verifySkippingAgainstPrimary(
 	ctx context.Context,
+	source provider.Provider,
 	trustedBlock *types.LightBlock,
 	newLightBlock *types.LightBlock,
 	now time.Time) error {
 
-	trace, err := c.verifySkipping(ctx, c.primary, trustedBlock, newLightBlock, now)
+	trace, err := c.verifySkipping(ctx, source, trustedBlock, newLightBlock, now)
 
 	switch errors.Unwrap(err).(type) {
 	case ErrInvalidHeader:
@@ -791,11 +845,11 @@ func (c *Client) verifySkippingAgainstPrimary(
 			return err
 		}
 
-		// If some intermediate header is invalid, replace the primary and try
-		// again.
-		c.logger.Error("primary sent invalid header -> replacing", "err", err)
+		// If some intermediate header is invalid, replace the primary which sent it
+		// and try again.
+		c.logger.Error("provider sent invalid header -> replacing", "err", err, "provider", source)
 
-		replacementBlock, removeErr := c.findNewPrimary(ctx, newLightBlock.Height, true)
+		replacementBlock, replacementSource, removeErr := c.removeFaultyProvider(ctx, source, newLightBlock.Height)
 		if removeErr != nil {
 			c.logger.Error("failed to replace primary. Returning original error", "err", removeErr)
 			return err
@@ -810,14 +864,14 @@ func (c *Client) verifySkippingAgainstPrimary(
 		}
 
 		// attempt to verify the header again
-		return c.verifySkippingAgainstPrimary(ctx, trustedBlock, replacementBlock, now)
+		return c.verifySkippingAgainstPrimary(ctx, replacementSource, trustedBlock, replacementBlock, now)
 	case nil:
 		// Compare header with the witnesses to ensure it's not a fork.
 		// More witnesses we have, more chance to notice one.
 		//
 		// CORRECTNESS ASSUMPTION: there's at least 1 correct full node
 		// (primary or one of the witnesses).
-		if cmpErr := c.detectDivergence(ctx, trace, now); cmpErr != nil {
+		if cmpErr := c.detectDivergence(ctx, trace, source, now); cmpErr != nil {
 			return cmpErr
 		}
 	default:
@@ -920,6 +974,12 @@ func (c *Client) updateTrustedLightBlock(l *types.LightBlock) error {
 		}
 	}
 
+	if c.pruningPeriod > 0 {
+		if err := c.trustedStore.PruneBefore(time.Now().Add(-c.pruningPeriod)); err != nil {
+			return fmt.Errorf("prune before: %w", err)
+		}
+	}
+
 	if c.latestTrustedBlock == nil || l.Height > c.latestTrustedBlock.Height {
 		c.latestTrustedBlock = l
 	}
@@ -941,7 +1001,7 @@ func (c *Client) backwards(
 	)
 
 	for verifiedHeader.Height > newHeader.Height {
-		interimBlock, err := c.lightBlockFromPrimary(ctx, verifiedHeader.Height-1)
+		interimBlock, interimSource, err := c.lightBlockAndSourceFromPrimary(ctx, verifiedHeader.Height-1)
 		if err != nil {
 			return fmt.Errorf("failed to obtain the header at height #%d: %w", verifiedHeader.Height-1, err)
 		}
@@ -953,12 +1013,13 @@ func (c *Client) backwards(
 			"newHash", interimHeader.Hash())
 		if err := VerifyBackwards(interimHeader, verifiedHeader); err != nil {
 			// verification has failed
-			c.logger.Error("backwards verification failed, replacing primary...", "err", err, "primary", c.primary)
+			c.logger.Error("backwards verification failed, removing provider...", "err", err,
+				"provider", interimSource)
 
-			// the client tries to see if it can get a witness to continue with the request
-			newPrimarysBlock, replaceErr := c.findNewPrimary(ctx, newHeader.Height, true)
+			// the client tries to see if it can get another provider to continue with the request
+			newPrimarysBlock, _, replaceErr := c.removeFaultyProvider(ctx, interimSource, newHeader.Height)
 			if replaceErr != nil {
-				c.logger.Debug("failed to replace primary. Returning original error", "err", replaceErr)
+				c.logger.Debug("failed to remove provider. Returning original error", "err", replaceErr)
 				return err
 			}
 
@@ -987,32 +1048,289 @@ func (c *Client) backwards(
 //     where the initial request came from
 //  3. If the provider provides an invalid light block, is deemed unreliable or returns
 //     any other error, the primary is permanently dropped and is replaced by a witness.
+//
+// If concurrent primaries are enabled, the light block is first requested from all of them, and
+// the primary alone is only requested if none of them returns a light block.
 func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*types.LightBlock, error) {
+	l, _, err := c.lightBlockAndSourceFromPrimary(ctx, height)
+	return l, err
+}
+
+// lightBlockAndSourceFromPrimary is like lightBlockFromPrimary, but also returns the provider which
+// sent the light block, which is a witness if concurrent primaries are enabled or the primary was
+// replaced.
+func (c *Client) lightBlockAndSourceFromPrimary(
+	ctx context.Context,
+	height int64,
+) (*types.LightBlock, provider.Provider, error) {
+	if c.concurrentPrimaries > 1 {
+		response, err := c.lightBlockFromPrimaries(ctx, height)
+		if err == nil || ctx.Err() != nil {
+			return response.lb, response.provider, err
+		}
+		c.logger.Info("error from light block request from concurrent primaries, falling back to primary",
+			"error", err, "height", height)
+	}
+
 	c.providerMutex.Lock()
-	l, err := c.primary.LightBlock(ctx, height)
+	primary := c.primary
+	l, err := primary.LightBlock(ctx, height)
 	c.providerMutex.Unlock()
 
+	return c.handlePrimaryResponse(ctx, primary, height, l, err)
+}
+
+// handlePrimaryResponse handles the response of the primary to a light block request, replacing
+// the primary (see replacePrimary) if it failed.
+func (c *Client) handlePrimaryResponse(
+	ctx context.Context,
+	primary provider.Provider,
+	height int64,
+	l *types.LightBlock,
+	err error,
+) (*types.LightBlock, provider.Provider, error) {
 	switch err {
 	case nil:
 		// Everything went smoothly. We reset the lightBlockRequests and return the light block
-		return l, nil
+		return l, primary, nil
 
 	case context.Canceled, context.DeadlineExceeded:
-		return l, err
+		return l, primary, err
 
 	case provider.ErrNoResponse, provider.ErrLightBlockNotFound, provider.ErrHeightTooHigh:
 		// we find a new witness to replace the primary
 		c.logger.Info("error from light block request from primary, replacing...",
-			"error", err, "height", height, "primary", c.primary)
-		return c.findNewPrimary(ctx, height, false)
+			"error", err, "height", height, "primary", primary)
+		return c.replacePrimary(ctx, primary, height, false)
 
 	default:
 		// The light client has most likely received either provider.ErrUnreliableProvider or provider.ErrBadLightBlock
 		// These errors mean that the light client should drop the primary and try with another provider instead
 		c.logger.Info("error from light block request from primary, removing...",
-			"error", err, "height", height, "primary", c.primary)
-		return c.findNewPrimary(ctx, height, true)
+			"error", err, "height", height, "primary", primary)
+		return c.replacePrimary(ctx, primary, height, true)
+	}
+}
+
+// replacePrimary replaces the failed primary using findNewPrimary, and returns the light block at
+// the height and the new primary. The primary may have been replaced concurrently already, in which
+// case the light block is requested from the current primary instead.
+func (c *Client) replacePrimary(
+	ctx context.Context,
+	failed provider.Provider,
+	height int64,
+	remove bool,
+) (*types.LightBlock, provider.Provider, error) {
+	c.providerMutex.Lock()
+	if c.primary != failed {
+		c.providerMutex.Unlock()
+		return c.lightBlockAndSourceFromPrimary(ctx, height)
+	}
+	defer c.providerMutex.Unlock()
+
+	l, err := c.findNewPrimary(ctx, height, remove)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, c.primary, nil
+}
+
+// removeFaultyProvider removes the provider which sent an invalid light block, and returns the light
+// block at the height from the primary, along with its source. A faulty primary is replaced (see
+// replacePrimary), while a faulty witness is removed from the witnesses.
+func (c *Client) removeFaultyProvider(
+	ctx context.Context,
+	faulty provider.Provider,
+	height int64,
+) (*types.LightBlock, provider.Provider, error) {
+	c.providerMutex.Lock()
+	for index, witness := range c.witnesses {
+		if witness != faulty {
+			continue
+		}
+		err := c.removeWitnesses([]int{index})
+		c.providerMutex.Unlock()
+		if err != nil {
+			return nil, nil, err
+		}
+		return c.lightBlockAndSourceFromPrimary(ctx, height)
+	}
+	c.providerMutex.Unlock()
+
+	return c.replacePrimary(ctx, faulty, height, true)
+}
+
+type primaryResponse struct {
+	lb       *types.LightBlock
+	provider provider.Provider
+	err      error
+}
+
+// lightBlockFromPrimaries concurrently requests the light block at the specified height from the
+// primary and the first concurrentPrimaries-1 witnesses, and returns the first response with a light
+// block. The remaining responses are cross-checked against it in the background. If no provider
+// returns a light block, it returns the last error.
+func (c *Client) lightBlockFromPrimaries(ctx context.Context, height int64) (primaryResponse, error) {
+	c.providerMutex.Lock()
+	providers := make([]provider.Provider, 0, c.concurrentPrimaries)
+	providers = append(providers, c.primary)
+	providers = append(providers, c.witnesses[:cmtmath.MinInt(len(c.witnesses), int(c.concurrentPrimaries)-1)]...)
+	c.providerMutex.Unlock()
+
+	// the requests are detached from ctx, so that the slower providers can still be cross-checked
+	// once the first light block has been returned
+	reqCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), concurrentPrimariesTimeout)
+	responsesC := make(chan primaryResponse, len(providers))
+	for _, p := range providers {
+		go func(p provider.Provider) {
+			lb, err := p.LightBlock(reqCtx, height)
+			responsesC <- primaryResponse{lb, p, err}
+		}(p)
+	}
+
+	var lastError error
+	for i := 0; i < len(providers); i++ {
+		select {
+		case response := <-responsesC:
+			if response.err != nil {
+				lastError = response.err
+				c.logger.Debug("error on light block request from concurrent primary",
+					"error", response.err, "height", height, "provider", response.provider)
+				continue
+			}
+			remaining := len(providers) - i - 1
+			go func() {
+				defer cancel()
+				c.crossCheck(reqCtx, response, responsesC, remaining)
+			}()
+			return response, nil
+
+		case <-ctx.Done():
+			cancel()
+			return primaryResponse{}, ctx.Err()
+		}
+	}
+
+	cancel()
+	return primaryResponse{}, lastError
+}
+
+// crossCheck compares the remaining responses of the concurrent primaries with the light block
+// that was used, and handles the light blocks conflicting with it.
+func (c *Client) crossCheck(ctx context.Context, used primaryResponse, responsesC <-chan primaryResponse, remaining int) {
+	for i := 0; i < remaining; i++ {
+		response := <-responsesC
+		if response.err != nil || response.lb.Height != used.lb.Height ||
+			bytes.Equal(response.lb.Hash(), used.lb.Hash()) {
+			continue
+		}
+
+		c.logger.Error("conflicting light block from concurrent primary", "height", used.lb.Height,
+			"provider", response.provider, "hash", response.lb.Hash(),
+			"usedProvider", used.provider, "usedHash", used.lb.Hash())
+		c.handleConflictingPrimary(ctx, used, response)
+	}
+}
+
+// handleConflictingPrimary handles a light block from a concurrent primary that conflicts with the
+// light block that was used. Until the used light block is verified, the conflict is left to the
+// detector, which compares the verified light block with all the providers but its source. Once
+// it is verified, the conflicting provider, primary or witness, is examined like in the detector,
+// which sends evidence of an attack if there was one, and is removed otherwise (see
+// removeFaultyProviders).
+func (c *Client) handleConflictingPrimary(ctx context.Context, used, conflict primaryResponse) {
+	trusted, err := c.trustedStore.LightBlock(used.lb.Height)
+	if err != nil || !bytes.Equal(trusted.Hash(), used.lb.Hash()) {
+		c.logger.Info("conflicting light block is not compared with a verified light block, leaving it to the detector",
+			"height", used.lb.Height, "provider", conflict.provider)
+		return
+	}
+	common, err := c.trustedStore.LightBlockBefore(used.lb.Height)
+	if err != nil {
+		c.logger.Info("no verified light block before conflicting light block", "height", used.lb.Height, "err", err)
+		return
+	}
+
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+
+	// the detector may have handled the conflicting provider already
+	if !c.hasProvider(conflict.provider) {
+		return
+	}
+	err = c.handleConflictingHeaders(ctx, []*types.LightBlock{common, trusted}, used.provider, conflict.lb,
+		conflict.provider, time.Now())
+	if err != nil {
+		c.logger.Error("light client attack detected by concurrent primaries", "err", err)
+		return
+	}
+	if err := c.removeFaultyProviders([]provider.Provider{conflict.provider}, used.provider); err != nil {
+		c.logger.Error("failed to remove conflicting provider", "provider", conflict.provider, "err", err)
+	}
+}
+
+// providersExcept returns the primary and the witnesses, except p. If p is the primary, these are
+// all the witnesses.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) providersExcept(p provider.Provider) []provider.Provider {
+	if p == c.primary {
+		return append([]provider.Provider(nil), c.witnesses...)
+	}
+	providers := make([]provider.Provider, 0, len(c.witnesses)+1)
+	providers = append(providers, c.primary)
+	for _, witness := range c.witnesses {
+		if witness != p {
+			providers = append(providers, witness)
+		}
 	}
+	return providers
+}
+
+// hasProvider returns true if p is the primary or one of the witnesses.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) hasProvider(p provider.Provider) bool {
+	return p == c.primary || containsProvider(c.witnesses, p)
+}
+
+// removeFaultyProviders removes the faulty providers found by the detector. A faulty primary is
+// replaced by source, whose light block has been verified, which is removed from the witnesses.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) removeFaultyProviders(faulty []provider.Provider, source provider.Provider) error {
+	var (
+		replacePrimary bool
+		indexes        []int
+	)
+	if c.primary != source && containsProvider(faulty, c.primary) {
+		replacePrimary = true
+	}
+	for index, witness := range c.witnesses {
+		if (replacePrimary && witness == source) || containsProvider(faulty, witness) {
+			indexes = append(indexes, index)
+		}
+	}
+	if len(indexes) > 0 {
+		if err := c.removeWitnesses(indexes); err != nil {
+			return err
+		}
+	}
+
+	if replacePrimary {
+		c.logger.Info("replacing faulty primary", "primary", c.primary, "newPrimary", source)
+		c.primary = source
+	}
+	return nil
+}
+
+func containsProvider(providers []provider.Provider, p provider.Provider) bool {
+	for _, q := range providers {
+		if q == p {
+			return true
+		}
+	}
+	return false
 }
 
 // NOTE: requires a providerMutex lock
@@ -1043,10 +1361,9 @@ type witnessResponse struct {
 // a valid light block as the new primary. The remove option indicates whether the primary should be
 // entire removed or just appended to the back of the witnesses list. This method also handles witness
 // errors. If no witness is available, it returns the last error of the witness.
+//
+// NOTE: requires a providerMutex lock
 func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool) (*types.LightBlock, error) {
-	c.providerMutex.Lock()
-	defer c.providerMutex.Unlock()
-
 	if len(c.witnesses) == 0 {
 		return nil, ErrNoWitnesses
 	}
@@ -780,6 +780,141 @@ func TestClientReplacesPrimaryWithWitnessIfPrimaryIsUnavailable(t *testing.T) {
 	assert.Equal(t, 2, len(c.Witnesses()))
 }
 
+// slowProvider is a provider responding after a delay.
+type slowProvider struct {
+	provider.Provider
+	delay time.Duration
+}
+
+func (p *slowProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(p.delay):
+	}
+	return p.Provider.LightBlock(ctx, height)
+}
+
+func TestClient_ConcurrentPrimaries(t *testing.T) {
+	// the primary is slow and responds with a light block conflicting with the
+	// witnesses, which is signed by only 1/3 of the validators
+	slowPrimary := &slowProvider{
+		Provider: mockp.New(
+			chainID,
+			map[int64]*types.SignedHeader{
+				1: h1,
+				2: keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+					hash("app_hash2"), hash("cons_hash"), hash("results_hash"),
+					len(keys)-1, len(keys), types.BlockID{Hash: h1.Hash()}),
+				3: h3,
+			},
+			valSet,
+		),
+		delay: 200 * time.Millisecond,
+	}
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		slowPrimary,
+		[]provider.Provider{mockp.New(chainID, headerSet, valSet), mockp.New(chainID, headerSet, valSet)},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.ConcurrentPrimaries(2),
+	)
+	require.NoError(t, err)
+
+	// the light block of the first witness to respond is used
+	l, err := c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, h2.Hash(), l.Hash())
+
+	// the conflicting primary is replaced by that witness once the detector has examined its light block
+	assert.NotEqual(t, slowPrimary, c.Primary())
+	assert.Equal(t, 1, len(c.Witnesses()))
+}
+
+func TestClient_ConcurrentPrimariesDetectsAttackByPrimary(t *testing.T) {
+	// the primary is slow and responds with a valid light block conflicting
+	// with the witnesses
+	conflictingHeader := keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+		hash("app_hash2"), hash("cons_hash"), hash("results_hash"), 0, len(keys), types.BlockID{Hash: h1.Hash()})
+	slowPrimary := &slowProvider{
+		Provider: mockp.New(
+			chainID,
+			map[int64]*types.SignedHeader{1: h1, 2: conflictingHeader, 3: h3},
+			valSet,
+		),
+		delay: 200 * time.Millisecond,
+	}
+	witness := mockp.New(chainID, headerSet, valSet)
+	sink := &evidenceSink{}
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		slowPrimary,
+		[]provider.Provider{witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.ConcurrentPrimaries(2),
+		light.EvidenceSinks(sink),
+	)
+	require.NoError(t, err)
+
+	// the light block of the witness is used, and compared with the one of the primary
+	_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+	assert.Equal(t, light.ErrLightClientAttack, err)
+
+	evAgainstPrimary := &types.LightClientAttackEvidence{
+		ConflictingBlock: &types.LightBlock{SignedHeader: conflictingHeader, ValidatorSet: vals},
+		CommonHeight:     1,
+	}
+	assert.True(t, witness.HasEvidence(evAgainstPrimary))
+	assert.Len(t, sink.evidence, 2)
+}
+
+func TestClient_ConcurrentPrimariesRemovesFaultyWitness(t *testing.T) {
+	// the witness is the fastest to respond, but serves an interim header
+	// signed by only 1/3 of the validators
+	faultyWitness := mockp.New(
+		chainID,
+		map[int64]*types.SignedHeader{
+			1: h1,
+			2: keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+				hash("app_hash"), hash("cons_hash"), hash("results_hash"),
+				len(keys)-1, len(keys), types.BlockID{Hash: h1.Hash()}),
+			3: h3,
+		},
+		valSet,
+	)
+	primary := &slowProvider{Provider: fullNode, delay: 200 * time.Millisecond}
+	witness := &slowProvider{Provider: fullNode, delay: 100 * time.Millisecond}
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		primary,
+		[]provider.Provider{faultyWitness, witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.ConcurrentPrimaries(2),
+		light.SequentialVerification(),
+	)
+	require.NoError(t, err)
+
+	l, err := c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, h3.Hash(), l.Hash())
+
+	// the witness which sent the invalid header is removed, not the primary
+	assert.Equal(t, primary, c.Primary())
+	assert.Equal(t, []provider.Provider{witness}, c.Witnesses())
+}
+
 func TestClient_BackwardsVerification(t *testing.T) {
 	{
 		trustHeader, _ := largeFullNode.LightBlock(ctx, 6)
@@ -1029,6 +1164,33 @@ func TestClientPrunesHeadersAndValidatorSets(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClientPrunesHeadersAndValidatorSetsByAge(t *testing.T) {
+	trustedStore := dbs.New(dbm.NewMemDB(), chainID)
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		fullNode,
+		[]provider.Provider{fullNode},
+		trustedStore,
+		light.Logger(log.TestingLogger()),
+		light.PruningSize(0),
+		// prune light blocks older than bTime + 1h
+		light.PruningPeriod(time.Since(bTime)-time.Hour),
+	)
+	require.NoError(t, err)
+	_, err = c.TrustedLightBlock(1)
+	require.NoError(t, err)
+
+	h, err := c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), h.Height)
+
+	_, err = c.TrustedLightBlock(1)
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, trustedStore.Size())
+}
+
 func TestClientEnsureValidHeadersAndValSets(t *testing.T) {
 	emptyValSet := &types.ValidatorSet{
 		Validators: nil,
@@ -17,22 +17,28 @@ import (
 
 // detectDivergence is a second wall of defense for the light client.
 //
-// It takes the target verified header and compares it with the headers of a set of
-// witness providers that the light client is connected to. If a conflicting header
-// is returned it verifies and examines the conflicting header against the verified
-// trace that was produced from the primary. If successful, it produces two sets of evidence
-// and sends them to the opposite provider before halting.
+// It takes the target verified header and compares it with the headers of all the
+// providers that the light client is connected to, except the source of the target
+// header. These are the witnesses and, if the source is a witness (see ConcurrentPrimaries),
+// the primary. If a conflicting header is returned it verifies and examines the conflicting
+// header against the verified trace that was produced from the source. If successful, it
+// produces two sets of evidence and sends them to the opposite provider before halting.
 //
 // If there are no conflictinge headers, the light client deems the verified target header
 // trusted and saves it to the trusted store.
-func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.LightBlock, now time.Time) error {
+func (c *Client) detectDivergence(
+	ctx context.Context,
+	primaryTrace []*types.LightBlock,
+	source provider.Provider,
+	now time.Time,
+) error {
 	if primaryTrace == nil || len(primaryTrace) < 2 {
 		return errors.New("nil or single block primary trace")
 	}
 	var (
 		headerMatched      bool
 		lastVerifiedHeader = primaryTrace[len(primaryTrace)-1].SignedHeader
-		witnessesToRemove  = make([]int, 0)
+		faultyProviders    = make([]provider.Provider, 0)
 	)
 	c.logger.Debug("Running detector against trace", "endBlockHeight", lastVerifiedHeader.Height,
 		"endBlockHash", lastVerifiedHeader.Hash, "length", len(primaryTrace))
@@ -40,15 +46,16 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 	c.providerMutex.Lock()
 	defer c.providerMutex.Unlock()
 
-	if len(c.witnesses) == 0 {
+	providers := c.providersExcept(source)
+	if len(providers) == 0 {
 		return ErrNoWitnesses
 	}
 
-	// launch one goroutine per witness to retrieve the light block of the target height
-	// and compare it with the header from the primary
-	errc := make(chan error, len(c.witnesses))
-	for i, witness := range c.witnesses {
-		go c.compareNewHeaderWithWitness(ctx, errc, lastVerifiedHeader, witness, i)
+	// launch one goroutine per provider to retrieve the light block of the target height
+	// and compare it with the header from the source
+	errc := make(chan error, len(providers))
+	for i, p := range providers {
+		go c.compareNewHeaderWithWitness(ctx, errc, lastVerifiedHeader, p, i)
 	}
 
 	// handle errors from the header comparisons as they come in
@@ -65,20 +72,20 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 			//
 			// We combine these actions together, verifying the witnesses headers and outputting the trace
 			// which captures the bifurcation point and if successful provides the information to create valid evidence.
-			err := c.handleConflictingHeaders(ctx, primaryTrace, e.Block, e.WitnessIndex, now)
+			err := c.handleConflictingHeaders(ctx, primaryTrace, source, e.Block, providers[e.WitnessIndex], now)
 			if err != nil {
 				// return information of the attack
 				return err
 			}
 			// if attempt to generate conflicting headers failed then remove witness
-			witnessesToRemove = append(witnessesToRemove, e.WitnessIndex)
+			faultyProviders = append(faultyProviders, providers[e.WitnessIndex])
 
 		case errBadWitness:
 			// these are all melevolent errors and should result in removing the
 			// witness
 			c.logger.Info("witness returned an error during header comparison, removing...",
-				"witness", c.witnesses[e.WitnessIndex], "err", err)
-			witnessesToRemove = append(witnessesToRemove, e.WitnessIndex)
+				"witness", providers[e.WitnessIndex], "err", err)
+			faultyProviders = append(faultyProviders, providers[e.WitnessIndex])
 		default:
 			// Benign errors which can be ignored unless there was a context
 			// canceled
@@ -90,7 +97,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 	}
 
 	// remove witnesses that have misbehaved
-	if err := c.removeWitnesses(witnessesToRemove); err != nil {
+	if err := c.removeFaultyProviders(faultyProviders, source); err != nil {
 		return err
 	}
 
@@ -204,24 +211,39 @@ func (c *Client) compareNewHeaderWithWitness(ctx context.Context, errc chan erro
 	errc <- nil
 }
 
-// sendEvidence sends evidence to a provider on a best effort basis.
+// EvidenceSink receives the evidence of light client attacks detected by the
+// light client, e.g. to persist it or to broadcast it to other full nodes.
+type EvidenceSink interface {
+	ReportEvidence(ctx context.Context, ev *types.LightClientAttackEvidence) error
+}
+
+// sendEvidence sends evidence to a provider and to the evidence sinks on a best
+// effort basis.
 func (c *Client) sendEvidence(ctx context.Context, ev *types.LightClientAttackEvidence, receiver provider.Provider) {
 	err := receiver.ReportEvidence(ctx, ev)
 	if err != nil {
 		c.logger.Error("Failed to report evidence to provider", "ev", ev, "provider", receiver)
 	}
+
+	for _, sink := range c.evidenceSinks {
+		if err := sink.ReportEvidence(ctx, ev); err != nil {
+			c.logger.Error("Failed to report evidence to sink", "ev", ev, "err", err)
+		}
+	}
 }
 
 // handleConflictingHeaders handles the primary style of attack, which is where a primary and witness have
-// two headers of the same height but with different hashes
+// two headers of the same height but with different hashes. The primary is the source of the trace,
+// which may be a witness itself if concurrent primaries are enabled, and the supporting witness any
+// other provider, including the primary.
 func (c *Client) handleConflictingHeaders(
 	ctx context.Context,
 	primaryTrace []*types.LightBlock,
+	source provider.Provider,
 	challendingBlock *types.LightBlock,
-	witnessIndex int,
+	supportingWitness provider.Provider,
 	now time.Time,
 ) error {
-	supportingWitness := c.witnesses[witnessIndex]
 	witnessTrace, primaryBlock, err := c.examineConflictingHeaderAgainstTrace(
 		ctx,
 		primaryTrace,
@@ -239,7 +261,7 @@ func (c *Client) handleConflictingHeaders(
 	commonBlock, trustedBlock := witnessTrace[0], witnessTrace[len(witnessTrace)-1]
 	evidenceAgainstPrimary := newLightClientAttackEvidence(primaryBlock, trustedBlock, commonBlock)
 	c.logger.Error("ATTEMPTED ATTACK DETECTED. Sending evidence against primary by witness", "ev", evidenceAgainstPrimary,
-		"primary", c.primary, "witness", supportingWitness)
+		"primary", source, "witness", supportingWitness)
 	c.sendEvidence(ctx, evidenceAgainstPrimary, supportingWitness)
 
 	if primaryBlock.Commit.Round != witnessTrace[len(witnessTrace)-1].Commit.Round {
@@ -255,11 +277,11 @@ func (c *Client) handleConflictingHeaders(
 		ctx,
 		witnessTrace,
 		primaryBlock,
-		c.primary,
+		source,
 		now,
 	)
 	if err != nil {
-		c.logger.Info("Error validating primary's divergent header", "primary", c.primary, "err", err)
+		c.logger.Info("Error validating primary's divergent header", "primary", source, "err", err)
 		return ErrLightClientAttack
 	}
 
@@ -267,8 +289,8 @@ func (c *Client) handleConflictingHeaders(
 	commonBlock, trustedBlock = primaryTrace[0], primaryTrace[len(primaryTrace)-1]
 	evidenceAgainstWitness := newLightClientAttackEvidence(witnessBlock, trustedBlock, commonBlock)
 	c.logger.Error("Sending evidence against witness by primary", "ev", evidenceAgainstWitness,
-		"primary", c.primary, "witness", supportingWitness)
-	c.sendEvidence(ctx, evidenceAgainstWitness, c.primary)
+		"primary", source, "witness", supportingWitness)
+	c.sendEvidence(ctx, evidenceAgainstWitness, source)
 	// We return the error and don't process anymore witnesses
 	return ErrLightClientAttack
 }
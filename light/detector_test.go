@@ -1,6 +1,7 @@
 package light_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -43,6 +44,7 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		primaryValidators[height] = forgedVals
 	}
 	primary := mockp.New(chainID, primaryHeaders, primaryValidators)
+	sink := &evidenceSink{}
 
 	c, err := light.NewClient(
 		ctx,
@@ -57,6 +59,7 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		dbs.New(dbm.NewMemDB(), chainID),
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
+		light.EvidenceSinks(sink),
 	)
 	require.NoError(t, err)
 
@@ -87,6 +90,12 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		CommonHeight: 4,
 	}
 	assert.True(t, primary.HasEvidence(evAgainstWitness))
+
+	// Check evidence was also reported to the sink.
+	if assert.Len(t, sink.evidence, 2) {
+		assert.Equal(t, evAgainstPrimary.Hash(), sink.evidence[0].Hash())
+		assert.Equal(t, evAgainstWitness.Hash(), sink.evidence[1].Hash())
+	}
 }
 
 func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
@@ -429,3 +438,13 @@ func TestClientDivergentTraces4(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, 1, len(c.Witnesses()))
 }
+
+// evidenceSink collects the evidence reported to it.
+type evidenceSink struct {
+	evidence []*types.LightClientAttackEvidence
+}
+
+func (s *evidenceSink) ReportEvidence(_ context.Context, ev *types.LightClientAttackEvidence) error {
+	s.evidence = append(s.evidence, ev)
+	return nil
+}
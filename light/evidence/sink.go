@@ -0,0 +1,327 @@
+package evidence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtjson "github.com/KYVENetwork/celestia-core/libs/json"
+	"github.com/KYVENetwork/celestia-core/libs/service"
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	"github.com/KYVENetwork/celestia-core/light"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const (
+	defaultRetryInterval = 30 * time.Second
+
+	// maxBlocksPerCheck is the maximum number of blocks fetched from a full node
+	// per retry, when checking whether evidence has been committed.
+	maxBlocksPerCheck = 100
+)
+
+var _ light.EvidenceSink = (*Sink)(nil)
+
+// Record is a piece of evidence reported to the Sink.
+type Record struct {
+	Evidence   types.Evidence `json:"evidence"`
+	ReportedAt time.Time      `json:"reported_at"`
+	// Number of times the evidence has been broadcast to the full nodes.
+	Broadcasts int `json:"broadcasts"`
+	// Height of the last block checked for the evidence.
+	CheckedHeight int64 `json:"checked_height"`
+	// Height of the block, which the evidence has been committed in (0 - not
+	// committed yet).
+	CommitHeight int64 `json:"commit_height"`
+}
+
+// Committed returns true if the evidence has been seen committed.
+func (r *Record) Committed() bool {
+	return r.CommitHeight > 0
+}
+
+// ResultEvidence is the result of the light_evidence RPC endpoint of the light
+// proxy.
+type ResultEvidence struct {
+	Evidence []*Record `json:"evidence"`
+}
+
+// Sink is a light.EvidenceSink, which persists the reported evidence and
+// broadcasts it to full nodes (see broadcast_evidence) until it is seen
+// committed in a block by one of them.
+type Sink struct {
+	service.BaseService
+
+	db      dbm.DB
+	clients []rpcclient.Client
+
+	retryInterval time.Duration
+
+	mtx      cmtsync.Mutex
+	reported chan struct{}
+}
+
+// SinkOption allow you to tweak Sink.
+type SinkOption func(*Sink)
+
+// RetryInterval option sets the interval between broadcasts of evidence, which
+// has not been committed yet. Default: 30s.
+func RetryInterval(d time.Duration) SinkOption {
+	return func(s *Sink) {
+		s.retryInterval = d
+	}
+}
+
+// NewSink returns a new Sink, which persists evidence in db and broadcasts it
+// to clients. Evidence is only broadcast while the Sink is running.
+func NewSink(db dbm.DB, clients []rpcclient.Client, opts ...SinkOption) *Sink {
+	s := &Sink{
+		db:            db,
+		clients:       clients,
+		retryInterval: defaultRetryInterval,
+		reported:      make(chan struct{}, 1),
+	}
+	s.BaseService = *service.NewBaseService(nil, "EvidenceSink", s)
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// OnStart starts broadcasting the evidence, which has not been committed yet.
+func (s *Sink) OnStart() error {
+	go s.broadcastRoutine()
+	return nil
+}
+
+// ReportEvidence persists the evidence and schedules it for broadcasting.
+// Evidence, which has already been reported, is ignored.
+func (s *Sink) ReportEvidence(_ context.Context, ev *types.LightClientAttackEvidence) error {
+	if ev == nil {
+		return errors.New("no evidence was provided")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	_, err := s.record(ev.Hash())
+	if err == nil { // already reported
+		return nil
+	}
+	if !errors.Is(err, errRecordNotFound) {
+		return err
+	}
+
+	rec := &Record{
+		Evidence:      ev,
+		ReportedAt:    time.Now(),
+		CheckedHeight: ev.Height(),
+	}
+	if err := s.saveRecord(rec); err != nil {
+		return err
+	}
+
+	select {
+	case s.reported <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Evidence returns all the evidence reported, in the order it was reported.
+func (s *Sink) Evidence() ([]*Record, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	recs, err := s.records()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(recs, func(i, j int) bool {
+		return recs[i].ReportedAt.Before(recs[j].ReportedAt)
+	})
+	return recs, nil
+}
+
+func (s *Sink) broadcastRoutine() {
+	ticker := time.NewTicker(s.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		s.broadcastPending()
+
+		select {
+		case <-ticker.C:
+		case <-s.reported:
+		case <-s.Quit():
+			return
+		}
+	}
+}
+
+// broadcastPending checks whether the evidence, which has not been committed
+// yet, has been committed in the meantime, and broadcasts it otherwise.
+// Evidence, which has expired without being committed, is dropped.
+func (s *Sink) broadcastPending() {
+	s.mtx.Lock()
+	recs, err := s.records()
+	s.mtx.Unlock()
+	if err != nil {
+		s.Logger.Error("Failed to load evidence", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.retryInterval)
+	defer cancel()
+
+	for _, rec := range recs {
+		if rec.Committed() {
+			continue
+		}
+
+		if expired := s.checkCommitted(ctx, rec); expired {
+			s.Logger.Info("Dropping expired evidence", "ev", rec.Evidence)
+			s.mtx.Lock()
+			err := s.db.DeleteSync(recordKey(rec.Evidence.Hash()))
+			s.mtx.Unlock()
+			if err != nil {
+				s.Logger.Error("Failed to delete evidence", "ev", rec.Evidence, "err", err)
+			}
+			continue
+		}
+		if !rec.Committed() {
+			s.broadcast(ctx, rec)
+		}
+
+		s.mtx.Lock()
+		err := s.saveRecord(rec)
+		s.mtx.Unlock()
+		if err != nil {
+			s.Logger.Error("Failed to save evidence", "ev", rec.Evidence, "err", err)
+		}
+	}
+}
+
+// checkCommitted looks for the evidence in the blocks after the last block
+// checked, using the first full node, which responds. It returns true if the
+// evidence has not been committed up to the latest block, and is too old to
+// be committed anymore (see types.EvidenceParams).
+func (s *Sink) checkCommitted(ctx context.Context, rec *Record) (expired bool) {
+	for _, client := range s.clients {
+		status, err := client.Status(ctx)
+		if err != nil {
+			s.Logger.Debug("Failed to get status", "err", err)
+			continue
+		}
+		toHeight := status.SyncInfo.LatestBlockHeight
+		if toHeight > rec.CheckedHeight+maxBlocksPerCheck {
+			toHeight = rec.CheckedHeight + maxBlocksPerCheck
+		}
+
+		for height := rec.CheckedHeight + 1; height <= toHeight; height++ {
+			res, err := client.Block(ctx, &height)
+			if err != nil {
+				s.Logger.Debug("Failed to get block", "height", height, "err", err)
+				break
+			}
+			if res == nil || res.Block == nil {
+				s.Logger.Debug("Block not found", "height", height)
+				break
+			}
+			if res.Block.Evidence.Evidence.Has(rec.Evidence) {
+				rec.CommitHeight = height
+				s.Logger.Info("Evidence committed", "ev", rec.Evidence, "height", height)
+				return false
+			}
+			rec.CheckedHeight = height
+		}
+		if rec.CheckedHeight < status.SyncInfo.LatestBlockHeight {
+			return false
+		}
+		return s.isExpired(ctx, client, rec, status)
+	}
+	return false
+}
+
+// isExpired returns true if the evidence is older than both the maximum
+// age in blocks and in time of the evidence parameters at the latest height.
+func (s *Sink) isExpired(ctx context.Context, client rpcclient.Client, rec *Record, status *ctypes.ResultStatus) bool {
+	height := status.SyncInfo.LatestBlockHeight
+	res, err := client.ConsensusParams(ctx, &height)
+	if err != nil {
+		s.Logger.Debug("Failed to get consensus params", "height", height, "err", err)
+		return false
+	}
+	var (
+		params       = res.ConsensusParams.Evidence
+		ageDuration  = status.SyncInfo.LatestBlockTime.Sub(rec.Evidence.Time())
+		ageNumBlocks = height - rec.Evidence.Height()
+	)
+	return ageNumBlocks > params.MaxAgeNumBlocks &&
+		ageDuration > params.MaxAgeDuration
+}
+
+// broadcast sends the evidence to all the full nodes on a best effort basis.
+func (s *Sink) broadcast(ctx context.Context, rec *Record) {
+	for _, client := range s.clients {
+		if _, err := client.BroadcastEvidence(ctx, rec.Evidence); err != nil {
+			s.Logger.Error("Failed to broadcast evidence", "ev", rec.Evidence, "err", err)
+		}
+	}
+	rec.Broadcasts++
+}
+
+var errRecordNotFound = errors.New("evidence not found")
+
+func (s *Sink) record(hash []byte) (*Record, error) {
+	bz, err := s.db.Get(recordKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, errRecordNotFound
+	}
+	rec := &Record{}
+	if err := cmtjson.Unmarshal(bz, rec); err != nil {
+		return nil, fmt.Errorf("unmarshal error: %w", err)
+	}
+	return rec, nil
+}
+
+func (s *Sink) records() ([]*Record, error) {
+	itr, err := dbm.IteratePrefix(s.db, []byte(recordKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var recs []*Record
+	for ; itr.Valid(); itr.Next() {
+		rec := &Record{}
+		if err := cmtjson.Unmarshal(itr.Value(), rec); err != nil {
+			return nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		recs = append(recs, rec)
+	}
+	return recs, itr.Error()
+}
+
+func (s *Sink) saveRecord(rec *Record) error {
+	bz, err := cmtjson.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshaling evidence: %w", err)
+	}
+	return s.db.SetSync(recordKey(rec.Evidence.Hash()), bz)
+}
+
+const recordKeyPrefix = "evidence/"
+
+func recordKey(hash []byte) []byte {
+	return []byte(fmt.Sprintf("%s%X", recordKeyPrefix, hash))
+}
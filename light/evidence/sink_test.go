@@ -0,0 +1,198 @@
+package evidence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/KYVENetwork/celestia-core/crypto"
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+func TestSink(t *testing.T) {
+	db := dbm.NewMemDB()
+	node := &fullNode{latestHeight: 5}
+	sink := NewSink(db, []rpcclient.Client{node}, RetryInterval(10*time.Millisecond))
+	sink.SetLogger(log.TestingLogger())
+
+	ev := randLightClientAttackEvidence(4)
+	require.NoError(t, sink.ReportEvidence(ctx, ev))
+	// Evidence reported twice is stored once.
+	require.NoError(t, sink.ReportEvidence(ctx, ev))
+
+	recs, err := sink.Evidence()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.Equal(t, ev.Hash(), recs[0].Evidence.Hash())
+	assert.False(t, recs[0].Committed())
+
+	// Evidence is broadcast until it is committed.
+	require.NoError(t, sink.Start())
+	t.Cleanup(func() { _ = sink.Stop() })
+	require.Eventually(t, func() bool { return node.numBroadcasts() >= 3 }, time.Second, 5*time.Millisecond)
+
+	node.commit(ev)
+	require.Eventually(t, func() bool {
+		recs, err := sink.Evidence()
+		require.NoError(t, err)
+		return recs[0].Committed()
+	}, time.Second, 5*time.Millisecond)
+
+	recs, err = sink.Evidence()
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, recs[0].CommitHeight)
+
+	broadcasts := node.numBroadcasts()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, broadcasts, node.numBroadcasts())
+
+	// Evidence is persisted.
+	recs, err = NewSink(db, nil).Evidence()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.EqualValues(t, 6, recs[0].CommitHeight)
+}
+
+func TestSinkDropsExpiredEvidence(t *testing.T) {
+	db := dbm.NewMemDB()
+	params := types.DefaultEvidenceParams()
+	params.MaxAgeNumBlocks = 2
+	params.MaxAgeDuration = time.Minute
+	node := &fullNode{latestHeight: 10, latestTime: time.Now(), evidenceParams: &params}
+	sink := NewSink(db, []rpcclient.Client{node}, RetryInterval(10*time.Millisecond))
+	sink.SetLogger(log.TestingLogger())
+
+	// The evidence is old enough in blocks, but not in time.
+	ev := randLightClientAttackEvidence(4)
+	require.NoError(t, sink.ReportEvidence(ctx, ev))
+	require.NoError(t, sink.Start())
+	t.Cleanup(func() { _ = sink.Stop() })
+	require.Eventually(t, func() bool { return node.numBroadcasts() >= 3 }, time.Second, 5*time.Millisecond)
+
+	recs, err := sink.Evidence()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+
+	// Once it is too old to be committed, it is no longer broadcast.
+	node.mtx.Lock()
+	node.latestTime = time.Now().Add(time.Hour)
+	node.mtx.Unlock()
+	require.Eventually(t, func() bool {
+		recs, err := sink.Evidence()
+		require.NoError(t, err)
+		return len(recs) == 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSinkMissingBlock(t *testing.T) {
+	node := &fullNode{latestHeight: 5, missingBlocks: true}
+	sink := NewSink(dbm.NewMemDB(), []rpcclient.Client{node}, RetryInterval(10*time.Millisecond))
+	sink.SetLogger(log.TestingLogger())
+
+	require.NoError(t, sink.ReportEvidence(ctx, randLightClientAttackEvidence(4)))
+	require.NoError(t, sink.Start())
+	t.Cleanup(func() { _ = sink.Stop() })
+	require.Eventually(t, func() bool { return node.numBroadcasts() >= 3 }, time.Second, 5*time.Millisecond)
+
+	recs, err := sink.Evidence()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.False(t, recs[0].Committed())
+	assert.EqualValues(t, 4, recs[0].CheckedHeight)
+}
+
+var ctx = context.Background()
+
+// fullNode is a full node, which commits evidence broadcast to it on demand.
+type fullNode struct {
+	rpcclient.Client
+
+	mtx            cmtsync.Mutex
+	latestHeight   int64
+	latestTime     time.Time
+	evidenceParams *types.EvidenceParams
+	evidence       map[int64]types.EvidenceList
+	missingBlocks  bool
+	broadcasts     int
+}
+
+func (n *fullNode) Status(context.Context) (*ctypes.ResultStatus, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+		LatestBlockHeight: n.latestHeight,
+		LatestBlockTime:   n.latestTime,
+	}}, nil
+}
+
+func (n *fullNode) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	params := types.DefaultConsensusParams()
+	if n.evidenceParams != nil {
+		params.Evidence = *n.evidenceParams
+	}
+	return &ctypes.ResultConsensusParams{BlockHeight: *height, ConsensusParams: *params}, nil
+}
+
+func (n *fullNode) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.missingBlocks {
+		return &ctypes.ResultBlock{}, nil
+	}
+	return &ctypes.ResultBlock{Block: &types.Block{Evidence: types.EvidenceData{Evidence: n.evidence[*height]}}}, nil
+}
+
+func (n *fullNode) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.broadcasts++
+	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
+}
+
+func (n *fullNode) numBroadcasts() int {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.broadcasts
+}
+
+// commit commits the evidence in a new block.
+func (n *fullNode) commit(ev types.Evidence) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.latestHeight++
+	n.evidence = map[int64]types.EvidenceList{n.latestHeight: {ev}}
+}
+
+func randLightClientAttackEvidence(commonHeight int64) *types.LightClientAttackEvidence {
+	vals, _ := types.RandValidatorSet(2, 1)
+	return &types.LightClientAttackEvidence{
+		ConflictingBlock: &types.LightBlock{
+			SignedHeader: &types.SignedHeader{
+				Header: &types.Header{
+					ChainID:        "test-chain",
+					Height:         commonHeight + 1,
+					Time:           time.Now(),
+					ValidatorsHash: vals.Hash(),
+					AppHash:        crypto.CRandBytes(tmhash.Size),
+				},
+				Commit: &types.Commit{},
+			},
+			ValidatorSet: vals,
+		},
+		CommonHeight:     commonHeight,
+		TotalVotingPower: vals.TotalVotingPower(),
+		Timestamp:        time.Now(),
+	}
+}
@@ -0,0 +1,768 @@
+package headersync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	cs "github.com/KYVENetwork/celestia-core/consensus"
+	cstypes "github.com/KYVENetwork/celestia-core/consensus/types"
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	"github.com/KYVENetwork/celestia-core/light"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	"github.com/KYVENetwork/celestia-core/light/store"
+	"github.com/KYVENetwork/celestia-core/p2p"
+	cmtcons "github.com/KYVENetwork/celestia-core/proto/celestiacore/consensus"
+	cmtproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const (
+	maxMsgSize = 1048576 // 1MB; NOTE: keep in sync with the consensus reactor
+
+	// announceInterval is the interval, after which the height to sync is
+	// announced again in a new round, if no header has been verified, so that
+	// the peers send the block parts and the precommits again.
+	announceInterval = 10 * time.Second
+
+	// fetchInterval is the interval between the attempts to fetch a changed
+	// validator set from the providers.
+	fetchInterval = time.Second
+
+	// fetchTimeout is the timeout of a single validator set request.
+	fetchTimeout = 10 * time.Second
+
+	maxClockDrift = 10 * time.Second
+
+	// maxPendingVotes is the maximum number of precommits buffered until the
+	// validator set of the height to sync is known.
+	maxPendingVotes = 10000
+
+	// maxPrecommitRounds is the maximum number of rounds of the height to sync,
+	// whose precommits are kept. The precommits of further rounds are dropped.
+	maxPrecommitRounds = 10
+)
+
+// errFaultyParts is returned if block parts sent by peers fail the checks
+// against the block ID precommitted by 2/3+ of the validators: either a part
+// doesn't match the part set header, or the block assembled out of the parts
+// doesn't match the block hash.
+type errFaultyParts struct {
+	height int64
+	err    error
+	// peers, which sent the faulty parts
+	peers []p2p.Peer
+}
+
+func (e errFaultyParts) Error() string {
+	return fmt.Sprintf("faulty block parts at height %d: %v", e.height, e.err)
+}
+
+func (e errFaultyParts) Unwrap() error {
+	return e.err
+}
+
+// pendingPart is a block part received before the block ID is known, and the
+// peer which sent it.
+type pendingPart struct {
+	part *types.Part
+	peer p2p.Peer
+}
+
+// Reactor is a p2p reactor of a light node, which follows the chain header by
+// header. It speaks the consensus protocol to the full nodes, announcing the
+// height to sync as a node catching up would, so that they send it the block
+// parts and the precommits of the commit. The header is verified against the
+// previous one with light.VerifyAdjacent, and saved to the store with its
+// commit and validator set.
+//
+// The validator set of a height is known from the previous light block, unless
+// it has changed, in which case it is fetched from the providers and checked
+// against the NextValidatorsHash of the previous header.
+//
+// The sync stops if it can't continue without intervention, i.e. the latest
+// light block has expired or a changed validator set can't be fetched for lack
+// of providers (see Err).
+type Reactor struct {
+	p2p.BaseReactor
+
+	chainID        string
+	initialHeight  int64
+	store          store.Store
+	providers      []provider.Provider
+	trustingPeriod time.Duration
+
+	mtx cmtsync.Mutex
+	// error, which has stopped the sync (nil - syncing)
+	err error
+	// latest verified light block (nil - none before the initial height)
+	latest *types.LightBlock
+	// height to sync and its validator set (nil - not fetched yet)
+	height int64
+	vals   *types.ValidatorSet
+	// round announced to the peers
+	round        int32
+	lastProgress time.Time
+	// precommits of the height to sync by round
+	precommits   map[int32]*types.VoteSet
+	pendingVotes []*types.Vote
+	// block precommitted by 2/3+ of the validators and its parts
+	blockID      *types.BlockID
+	parts        *types.PartSet
+	pendingParts map[uint32]pendingPart
+	// peers, which sent the block parts added to the part set
+	partPeers   map[p2p.ID]p2p.Peer
+	peerHeights map[p2p.ID]int64
+}
+
+// NewReactor returns a new Reactor, following the chain from the latest light
+// block in the store, or from the genesis validators if the store is empty.
+// As the headers are verified at the current time, the chain can only be
+// followed from a light block within the trusting period; an older store has
+// to be bootstrapped from a recent trusted header instead (see Bootstrap), and
+// an error is returned for it. The providers are only used to fetch the
+// validator sets, which have changed.
+func NewReactor(
+	genDoc *types.GenesisDoc,
+	store store.Store,
+	providers []provider.Provider,
+	trustingPeriod time.Duration,
+) (*Reactor, error) {
+	r := &Reactor{
+		chainID:        genDoc.ChainID,
+		initialHeight:  genDoc.InitialHeight,
+		store:          store,
+		providers:      providers,
+		trustingPeriod: trustingPeriod,
+		peerHeights:    make(map[p2p.ID]int64),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor("HeaderSync", r)
+
+	lastHeight, err := store.LastLightBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	if lastHeight > 0 {
+		latest, err := store.LightBlock(lastHeight)
+		if err != nil {
+			return nil, err
+		}
+		if light.HeaderExpired(latest.SignedHeader, trustingPeriod, time.Now()) {
+			return nil, errExpired(latest)
+		}
+		r.setLatest(latest)
+		return r, nil
+	}
+
+	if len(genDoc.Validators) == 0 {
+		return nil, errors.New("no genesis validators to verify the initial header; set trust_height and trust_hash")
+	}
+	validators := make([]*types.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = types.NewValidator(val.PubKey, val.Power)
+	}
+	r.height = genDoc.InitialHeight
+	r.vals = types.NewValidatorSet(validators)
+	r.resetHeight()
+	return r, nil
+}
+
+// Bootstrap saves the light block at the trusted height to the store, so that
+// the reactor follows the chain from there. The store must be empty or only
+// hold light blocks below the trusted height, e.g. ones which have expired.
+// The light block is fetched from the first provider, which responds with a
+// light block matching the trusted hash.
+func Bootstrap(
+	ctx context.Context,
+	chainID string,
+	store store.Store,
+	providers []provider.Provider,
+	trustOptions light.TrustOptions,
+) error {
+	if err := trustOptions.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid TrustOptions: %w", err)
+	}
+
+	err := errors.New("no providers")
+	for _, p := range providers {
+		var lb *types.LightBlock
+		lb, err = p.LightBlock(ctx, trustOptions.Height)
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(lb.Hash(), trustOptions.Hash) {
+			err = fmt.Errorf("light block %X from %v does not match trusted hash %X", lb.Hash(), p, trustOptions.Hash)
+			continue
+		}
+		err = lb.ValidatorSet.VerifyCommitLight(chainID, lb.Commit.BlockID, lb.Height, lb.Commit)
+		if err != nil {
+			err = fmt.Errorf("invalid commit from %v: %w", p, err)
+			continue
+		}
+		return store.SaveLightBlock(lb)
+	}
+	return fmt.Errorf("can't fetch light block at height %d: %w", trustOptions.Height, err)
+}
+
+// OnStart implements service.Service.
+func (r *Reactor) OnStart() error {
+	go r.syncRoutine()
+	return nil
+}
+
+// GetChannels implements Reactor. The channels are those of the consensus
+// reactor.
+func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  cs.StateChannel,
+			Priority:            6,
+			SendQueueCapacity:   100,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		},
+		{
+			ID:                  cs.DataChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		},
+		{
+			ID:                  cs.VoteChannel,
+			Priority:            7,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  100 * 100,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		},
+		{
+			ID:                  cs.VoteSetBitsChannel,
+			Priority:            1,
+			SendQueueCapacity:   2,
+			RecvBufferCapacity:  1024,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		},
+	}
+}
+
+// AddPeer implements Reactor by announcing the height to sync to the peer.
+func (r *Reactor) AddPeer(peer p2p.Peer) {
+	r.mtx.Lock()
+	msg := r.newRoundStepMessage()
+	r.mtx.Unlock()
+
+	p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+		ChannelID: cs.StateChannel,
+		Message:   msg,
+	}, r.Logger)
+}
+
+// RemovePeer implements Reactor.
+func (r *Reactor) RemovePeer(peer p2p.Peer, _ interface{}) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	delete(r.peerHeights, peer.ID())
+}
+
+// ReceiveEnvelope implements Reactor. Only the block parts and the precommits
+// of the height to sync are used; all other messages are ignored.
+func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
+	if !r.IsRunning() {
+		r.Logger.Debug("Receive", "src", e.Src, "chId", e.ChannelID)
+		return
+	}
+	m := e.Message
+	if wm, ok := m.(p2p.Wrapper); ok {
+		m = wm.Wrap()
+	}
+	cm, ok := m.(*cmtcons.Message)
+	if !ok {
+		err := fmt.Errorf("unexpected message type %T", m)
+		r.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
+		r.Switch.StopPeerForFault(e.Src, err)
+		return
+	}
+	msg, err := cs.MsgFromProto(cm)
+	if err != nil {
+		r.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
+		r.Switch.StopPeerForFault(e.Src, err)
+		return
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		r.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		r.Switch.StopPeerForFault(e.Src, err)
+		return
+	}
+
+	var verified bool
+	switch msg := msg.(type) {
+	case *cs.NewRoundStepMessage:
+		r.mtx.Lock()
+		r.peerHeights[e.Src.ID()] = msg.Height
+		r.mtx.Unlock()
+	case *cs.BlockPartMessage:
+		verified, err = r.addPart(msg.Height, msg.Part, e.Src)
+	case *cs.VoteMessage:
+		verified, err = r.addVote(msg.Vote)
+	}
+	if err != nil {
+		r.handleVerifyError(err)
+		return
+	}
+	if verified {
+		r.broadcastNewRoundStep()
+	}
+}
+
+// Receive implements Reactor.
+func (r *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
+	msg := &cmtcons.Message{}
+	err := proto.Unmarshal(msgBytes, msg)
+	if err != nil {
+		r.Logger.Error("Error decoding message", "src", peer, "chId", chID, "err", err)
+		r.Switch.StopPeerForFault(peer, err)
+		return
+	}
+	uw, err := msg.Unwrap()
+	if err != nil {
+		r.Logger.Error("Error decoding message", "src", peer, "chId", chID, "err", err)
+		r.Switch.StopPeerForFault(peer, err)
+		return
+	}
+	r.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: chID,
+		Src:       peer,
+		Message:   uw,
+	})
+}
+
+// LatestLightBlock returns the latest verified light block or nil, if none
+// has been verified yet.
+func (r *Reactor) LatestLightBlock() *types.LightBlock {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.latest
+}
+
+// NetworkHeight returns the height of the latest block committed by the
+// peers, as announced by them (0 - unknown).
+func (r *Reactor) NetworkHeight() int64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	var height int64
+	for _, h := range r.peerHeights {
+		if h-1 > height {
+			height = h - 1
+		}
+	}
+	return height
+}
+
+// Err returns the error, which has stopped the sync, or nil if the reactor is
+// syncing. The sync can't continue without restarting the node, either with
+// providers to fetch the changed validator sets from, or bootstrapped from a
+// recent trusted header.
+func (r *Reactor) Err() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.err
+}
+
+// Store returns the store of the verified light blocks.
+func (r *Reactor) Store() store.Store {
+	return r.store
+}
+
+func (r *Reactor) syncRoutine() {
+	fetchTicker := time.NewTicker(fetchInterval)
+	defer fetchTicker.Stop()
+	announceTicker := time.NewTicker(announceInterval)
+	defer announceTicker.Stop()
+
+	for {
+		select {
+		case <-fetchTicker.C:
+			verified, err := r.fetchValidators()
+			if err != nil {
+				r.handleVerifyError(err)
+			} else if verified {
+				r.broadcastNewRoundStep()
+			}
+		case <-announceTicker.C:
+			r.mtx.Lock()
+			stalled := time.Since(r.lastProgress) >= announceInterval
+			if stalled {
+				// A new round makes the peers send the block parts again.
+				r.round++
+			}
+			r.mtx.Unlock()
+			if stalled {
+				r.broadcastNewRoundStep()
+			}
+		case <-r.Quit():
+			return
+		}
+	}
+}
+
+// fetchValidators fetches the validator set of the height to sync, if it has
+// changed. It returns true if a header has been verified as a result. If there
+// are no providers, the sync is stopped.
+func (r *Reactor) fetchValidators() (bool, error) {
+	r.mtx.Lock()
+	if r.vals != nil || r.err != nil {
+		r.mtx.Unlock()
+		return false, nil
+	}
+	height, valsHash := r.height, r.latest.NextValidatorsHash
+	if len(r.providers) == 0 {
+		r.err = fmt.Errorf("validator set changed at height %d, but there are no providers to fetch it from", height)
+		r.mtx.Unlock()
+		return false, r.err
+	}
+	r.mtx.Unlock()
+
+	vals, err := r.fetchValidatorSet(height, valsHash)
+	if err != nil {
+		r.Logger.Debug("Failed to fetch validator set", "height", height, "err", err)
+		return false, nil
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.height != height || r.vals != nil {
+		return false, nil
+	}
+	r.vals = vals
+	votes := r.pendingVotes
+	r.pendingVotes = nil
+	for _, vote := range votes {
+		r.addPrecommit(vote)
+	}
+	return r.tryVerify()
+}
+
+// fetchValidatorSet fetches the validator set of the height from the first
+// provider, which responds with a validator set matching the hash.
+func (r *Reactor) fetchValidatorSet(height int64, hash []byte) (*types.ValidatorSet, error) {
+	err := errors.New("no providers")
+	for _, p := range r.providers {
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		var lb *types.LightBlock
+		lb, err = p.LightBlock(ctx, height)
+		cancel()
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(lb.ValidatorSet.Hash(), hash) {
+			err = fmt.Errorf("validator set %X from %v does not match expected hash %X", lb.ValidatorSet.Hash(), p, hash)
+			continue
+		}
+		return lb.ValidatorSet, nil
+	}
+	return nil, err
+}
+
+// addVote adds a precommit of the height to sync. It returns true if a header
+// has been verified as a result.
+func (r *Reactor) addVote(vote *types.Vote) (bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if vote.Height != r.height || vote.Type != cmtproto.PrecommitType {
+		return false, nil
+	}
+	if r.vals == nil {
+		if len(r.pendingVotes) < maxPendingVotes {
+			r.pendingVotes = append(r.pendingVotes, vote)
+		}
+		return false, nil
+	}
+	r.addPrecommit(vote)
+	return r.tryVerify()
+}
+
+// addPart adds a block part of the height to sync, sent by the peer. It
+// returns true if a header has been verified as a result.
+func (r *Reactor) addPart(height int64, part *types.Part, peer p2p.Peer) (bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if height != r.height {
+		return false, nil
+	}
+	if r.parts == nil {
+		// The parts can only be checked once the block ID is known, so only one
+		// part per index is kept until then.
+		if _, ok := r.pendingParts[part.Index]; !ok && part.Index < types.MaxBlockPartsCount {
+			r.pendingParts[part.Index] = pendingPart{part: part, peer: peer}
+		}
+		return false, nil
+	}
+	if err := r.addBlockPart(part, peer); err != nil {
+		return false, errFaultyParts{height: height, err: err, peers: []p2p.Peer{peer}}
+	}
+	return r.tryVerify()
+}
+
+// addBlockPart adds the block part sent by the peer to the part set. It
+// returns an error if the part doesn't match the part set header.
+// CONTRACT: r.mtx is locked and r.parts is set.
+func (r *Reactor) addBlockPart(part *types.Part, peer p2p.Peer) error {
+	added, err := r.parts.AddPart(part)
+	if err != nil {
+		return err
+	}
+	if added {
+		r.partPeers[peer.ID()] = peer
+	}
+	return nil
+}
+
+// addPendingParts adds the block parts received before the block ID was known
+// to the part set. It returns errFaultyParts for the peers, whose parts don't
+// match the part set header.
+// CONTRACT: r.mtx is locked and r.parts is set.
+func (r *Reactor) addPendingParts() error {
+	var (
+		peers   []p2p.Peer
+		lastErr error
+	)
+	for _, pending := range r.pendingParts {
+		if err := r.addBlockPart(pending.part, pending.peer); err != nil {
+			peers = append(peers, pending.peer)
+			lastErr = err
+		}
+	}
+	r.pendingParts = nil
+	if len(peers) > 0 {
+		return errFaultyParts{height: r.height, err: lastErr, peers: peers}
+	}
+	return nil
+}
+
+// handleVerifyError logs the error of a header verification, and stops the
+// peers which sent faulty block parts. Other errors are not caused by the
+// peers, as the parts have been checked against the block ID precommitted by
+// 2/3+ of the validators.
+func (r *Reactor) handleVerifyError(err error) {
+	var faultyErr errFaultyParts
+	if errors.As(err, &faultyErr) {
+		r.Logger.Error("Received faulty block parts", "err", err)
+		for _, peer := range faultyErr.peers {
+			r.Switch.StopPeerForFault(peer, err)
+		}
+		return
+	}
+	r.Logger.Error("Failed to verify header", "err", err)
+}
+
+// addPrecommit adds the precommit to the vote set of its round, and sets the
+// block ID once 2/3+ of the validators have precommitted a block.
+// CONTRACT: r.mtx is locked and r.vals is set.
+func (r *Reactor) addPrecommit(vote *types.Vote) {
+	voteSet, ok := r.precommits[vote.Round]
+	if !ok {
+		if len(r.precommits) >= maxPrecommitRounds {
+			r.Logger.Debug("Dropping precommit of too many rounds", "height", r.height, "round", vote.Round)
+			return
+		}
+		voteSet = types.NewVoteSet(r.chainID, r.height, vote.Round, cmtproto.PrecommitType, r.vals)
+	}
+	added, err := voteSet.AddVote(vote)
+	if err != nil {
+		r.Logger.Debug("Failed to add precommit", "height", r.height, "round", vote.Round, "err", err)
+		return
+	}
+	if !added {
+		return
+	}
+	r.precommits[vote.Round] = voteSet
+
+	if r.blockID != nil {
+		return
+	}
+	blockID, ok := voteSet.TwoThirdsMajority()
+	if !ok || blockID.IsZero() {
+		return
+	}
+	r.blockID = &blockID
+	r.parts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+}
+
+// tryVerify verifies and saves the header of the height to sync, once all the
+// block parts have been received. It returns true if the header has been
+// verified.
+//
+// If the block fails verification, the height to sync is started over in a
+// new round. errFaultyParts is returned if the block doesn't match the block
+// hash, so that the peers which sent the parts are stopped. If the latest
+// light block has expired, the sync is stopped instead.
+// CONTRACT: r.mtx is locked.
+func (r *Reactor) tryVerify() (bool, error) {
+	if r.err != nil || r.parts == nil {
+		return false, nil
+	}
+	if err := r.addPendingParts(); err != nil {
+		return false, err
+	}
+	if !r.parts.IsComplete() {
+		return false, nil
+	}
+
+	lb, err := r.lightBlock()
+	if err != nil {
+		var faultyErr errFaultyParts
+		switch {
+		case errors.As(err, &light.ErrOldHeaderExpired{}):
+			r.err = errExpired(r.latest)
+			return false, r.err
+		case errors.As(err, &faultyErr):
+			for _, peer := range r.partPeers {
+				faultyErr.peers = append(faultyErr.peers, peer)
+			}
+			err = faultyErr
+		}
+		round := r.round
+		r.resetHeight()
+		r.round = round + 1
+		return false, err
+	}
+	if err := r.store.SaveLightBlock(lb); err != nil {
+		return false, fmt.Errorf("failed to save light block: %w", err)
+	}
+
+	r.Logger.Info("Verified header", "height", lb.Height, "hash", lb.Hash())
+	r.setLatest(lb)
+	return true, nil
+}
+
+// block assembles the block of the height to sync out of the block parts. It
+// returns errFaultyParts if the block doesn't match the block hash.
+// CONTRACT: r.mtx is locked and all the block parts have been received.
+func (r *Reactor) block() (*types.Block, error) {
+	bz, err := io.ReadAll(r.parts.GetReader())
+	if err != nil {
+		return nil, err
+	}
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(bz, pbb); err != nil {
+		return nil, fmt.Errorf("unmarshal block: %w", err)
+	}
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(block.Hash(), r.blockID.Hash) {
+		err := fmt.Errorf("block hash %X does not match precommitted hash %X", block.Hash(), r.blockID.Hash)
+		return nil, errFaultyParts{height: r.height, err: err}
+	}
+	return block, nil
+}
+
+// lightBlock assembles the light block of the height to sync out of the block
+// parts and the precommits, and verifies it.
+// CONTRACT: r.mtx is locked and all the block parts have been received.
+func (r *Reactor) lightBlock() (*types.LightBlock, error) {
+	block, err := r.block()
+	if err != nil {
+		return nil, err
+	}
+
+	var commit *types.Commit
+	for _, voteSet := range r.precommits {
+		if blockID, ok := voteSet.TwoThirdsMajority(); ok && blockID.Equals(*r.blockID) {
+			commit = voteSet.MakeCommit()
+			break
+		}
+	}
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &block.Header, Commit: commit},
+		ValidatorSet: r.vals,
+	}
+	if err := lb.ValidateBasic(r.chainID); err != nil {
+		return nil, err
+	}
+
+	if r.latest == nil {
+		// The initial header is verified against the genesis validators.
+		if lb.Height != r.initialHeight {
+			return nil, fmt.Errorf("expected initial height %d, got %d", r.initialHeight, lb.Height)
+		}
+		return lb, lb.ValidatorSet.VerifyCommitLight(r.chainID, lb.Commit.BlockID, lb.Height, lb.Commit)
+	}
+	// The latest light block must be within the trusting period, otherwise the
+	// store has to be bootstrapped again from a trusted header.
+	err = light.VerifyAdjacent(r.latest.SignedHeader, lb.SignedHeader, lb.ValidatorSet,
+		r.trustingPeriod, time.Now(), maxClockDrift)
+	return lb, err
+}
+
+// setLatest advances to the height after the verified light block.
+// CONTRACT: r.mtx is locked.
+func (r *Reactor) setLatest(lb *types.LightBlock) {
+	r.latest = lb
+	r.height = lb.Height + 1
+	r.vals = nil
+	if bytes.Equal(lb.NextValidatorsHash, lb.ValidatorSet.Hash()) {
+		r.vals = lb.ValidatorSet
+	}
+	r.resetHeight()
+}
+
+// resetHeight resets the state of the height to sync.
+// CONTRACT: r.mtx is locked.
+func (r *Reactor) resetHeight() {
+	r.round = 0
+	r.lastProgress = time.Now()
+	r.precommits = make(map[int32]*types.VoteSet)
+	r.pendingVotes = nil
+	r.blockID = nil
+	r.parts = nil
+	r.pendingParts = make(map[uint32]pendingPart)
+	r.partPeers = make(map[p2p.ID]p2p.Peer)
+}
+
+// errExpired returns the error stopping the sync, once the latest light block
+// has expired.
+func errExpired(latest *types.LightBlock) error {
+	return fmt.Errorf("latest light block at height %d has expired; bootstrap from a recent trusted header",
+		latest.Height)
+}
+
+func (r *Reactor) broadcastNewRoundStep() {
+	r.mtx.Lock()
+	msg := r.newRoundStepMessage()
+	r.mtx.Unlock()
+
+	r.Switch.BroadcastEnvelope(p2p.Envelope{
+		ChannelID: cs.StateChannel,
+		Message:   msg,
+	})
+}
+
+// newRoundStepMessage announces the height to sync, as a node waiting for the
+// commit of the previous height does. The peers then send the block parts and
+// the precommits of the height (see gossipDataForCatchup).
+// CONTRACT: r.mtx is locked.
+func (r *Reactor) newRoundStepMessage() *cmtcons.NewRoundStep {
+	lastCommitRound := int32(-1)
+	if r.latest != nil {
+		lastCommitRound = r.latest.Commit.Round
+	}
+	return &cmtcons.NewRoundStep{
+		Height:          r.height,
+		Round:           r.round,
+		Step:            uint32(cstypes.RoundStepNewHeight),
+		LastCommitRound: lastCommitRound,
+	}
+}
@@ -0,0 +1,386 @@
+package headersync
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/KYVENetwork/celestia-core/config"
+	cs "github.com/KYVENetwork/celestia-core/consensus"
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	mockp "github.com/KYVENetwork/celestia-core/light/provider/mock"
+	dbs "github.com/KYVENetwork/celestia-core/light/store/db"
+	"github.com/KYVENetwork/celestia-core/p2p"
+	p2pmock "github.com/KYVENetwork/celestia-core/p2p/mock"
+	cmtcons "github.com/KYVENetwork/celestia-core/proto/celestiacore/consensus"
+	cmtproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const chainID = "test-chain"
+
+func TestReactorVerifiesHeaders(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	newVals, newPrivVals := types.RandValidatorSet(3, 10)
+	genTime := time.Now().Add(-30 * time.Minute)
+
+	genDoc := &types.GenesisDoc{ChainID: chainID, GenesisTime: genTime, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+
+	// The validator set changes at height 3, so it is fetched from the provider.
+	chain := newTestChain(t)
+	chain.addBlock(vals, vals, privVals, genTime.Add(time.Minute))
+	chain.addBlock(vals, newVals, privVals, genTime.Add(2*time.Minute))
+	chain.addBlock(newVals, newVals, newPrivVals, genTime.Add(3*time.Minute))
+	prov := mockp.New(chainID, map[int64]*types.SignedHeader{3: chain.signedHeaders[2]},
+		map[int64]*types.ValidatorSet{3: newVals})
+
+	r, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), []provider.Provider{prov}, time.Hour)
+	require.NoError(t, err)
+	startReactor(t, r)
+	peer := p2pmock.NewPeer(nil)
+
+	// Parts received before the precommits are kept until the block ID is known.
+	chain.sendParts(r, peer, 1)
+	chain.sendPrecommits(r, peer, 1)
+	assertLatestHeight(t, r, 1)
+
+	chain.sendPrecommits(r, peer, 2)
+	chain.sendParts(r, peer, 2)
+	assertLatestHeight(t, r, 2)
+
+	chain.sendPrecommits(r, peer, 3)
+	chain.sendParts(r, peer, 3)
+	require.Eventually(t, func() bool {
+		latest := r.LatestLightBlock()
+		return latest != nil && latest.Height == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for height := int64(1); height <= 3; height++ {
+		lb, err := r.Store().LightBlock(height)
+		require.NoError(t, err)
+		assert.Equal(t, chain.signedHeaders[height-1].Hash(), lb.Hash())
+	}
+
+	// A header precommitted by unknown validators is not verified.
+	chain.addBlock(vals, vals, privVals, genTime.Add(4*time.Minute))
+	chain.sendPrecommits(r, peer, 4)
+	chain.sendParts(r, peer, 4)
+	assertLatestHeight(t, r, 3)
+}
+
+func TestReactorRejectsInvalidBlock(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	genTime := time.Now().Add(-30 * time.Minute)
+	genDoc := &types.GenesisDoc{ChainID: chainID, GenesisTime: genTime, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+
+	// The block at height 2 is precommitted, but its time is before the one of
+	// the block at height 1.
+	chain := newTestChain(t)
+	chain.addBlock(vals, vals, privVals, genTime.Add(time.Minute))
+	chain.addBlock(vals, vals, privVals, genTime)
+
+	r, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), nil, time.Hour)
+	require.NoError(t, err)
+	startReactor(t, r)
+	peer := p2pmock.NewPeer(nil)
+
+	chain.sendPrecommits(r, peer, 1)
+	chain.sendParts(r, peer, 1)
+	assertLatestHeight(t, r, 1)
+
+	partsPeer := p2pmock.NewPeer(nil)
+	chain.sendPrecommits(r, peer, 2)
+	chain.sendParts(r, partsPeer, 2)
+	assertLatestHeight(t, r, 1)
+
+	// the block matches the precommitted block ID, so the peer which sent the
+	// parts is not at fault, and the height is started over
+	assert.True(t, partsPeer.IsRunning())
+	assert.True(t, peer.IsRunning())
+	assert.NoError(t, r.Err())
+	r.mtx.Lock()
+	assert.Nil(t, r.blockID)
+	assert.Empty(t, r.precommits)
+	assert.EqualValues(t, 1, r.round)
+	r.mtx.Unlock()
+}
+
+func TestReactorStopsPeerForFaultyPart(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	genTime := time.Now().Add(-30 * time.Minute)
+	genDoc := &types.GenesisDoc{ChainID: chainID, GenesisTime: genTime, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+	chain := newTestChain(t)
+	chain.addBlock(vals, vals, privVals, genTime.Add(time.Minute))
+	chain.addBlock(vals, vals, privVals, genTime.Add(2*time.Minute))
+
+	r, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), nil, time.Hour)
+	require.NoError(t, err)
+	startReactor(t, r)
+	peer := p2pmock.NewPeer(nil)
+
+	// a faulty part received before the block ID is known
+	pendingPeer := p2pmock.NewPeer(nil)
+	chain.sendFaultyPart(r, pendingPeer, 1)
+	chain.sendPrecommits(r, peer, 1)
+	assert.False(t, pendingPeer.IsRunning())
+	chain.sendParts(r, peer, 1)
+	assertLatestHeight(t, r, 1)
+
+	// a faulty part received once the block ID is known
+	partPeer := p2pmock.NewPeer(nil)
+	chain.sendPrecommits(r, peer, 2)
+	chain.sendFaultyPart(r, partPeer, 2)
+	assert.False(t, partPeer.IsRunning())
+	chain.sendParts(r, peer, 2)
+	assertLatestHeight(t, r, 2)
+	assert.True(t, peer.IsRunning())
+}
+
+func TestReactorStopsSyncForExpiredHeader(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	genTime := time.Now().Add(-30 * time.Minute)
+	genDoc := &types.GenesisDoc{ChainID: chainID, GenesisTime: genTime, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+	chain := newTestChain(t)
+	chain.addBlock(vals, vals, privVals, genTime.Add(time.Minute))
+	chain.addBlock(vals, vals, privVals, genTime.Add(2*time.Minute))
+
+	// the header at height 1 expires before the one at height 2 is received
+	store := dbs.New(dbm.NewMemDB(), chainID)
+	r, err := NewReactor(genDoc, store, nil, 10*time.Minute)
+	require.NoError(t, err)
+	startReactor(t, r)
+	peer := p2pmock.NewPeer(nil)
+
+	chain.sendPrecommits(r, peer, 1)
+	chain.sendParts(r, peer, 1)
+	assertLatestHeight(t, r, 1)
+	require.NoError(t, r.Err())
+
+	chain.sendPrecommits(r, peer, 2)
+	chain.sendParts(r, peer, 2)
+	assertLatestHeight(t, r, 1)
+	assert.Error(t, r.Err())
+	assert.True(t, peer.IsRunning())
+
+	// the expired store has to be bootstrapped again
+	_, err = NewReactor(genDoc, store, nil, 10*time.Minute)
+	assert.Error(t, err)
+}
+
+func TestReactorStopsSyncWithoutProviders(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	newVals, _ := types.RandValidatorSet(3, 10)
+	genTime := time.Now().Add(-30 * time.Minute)
+	genDoc := &types.GenesisDoc{ChainID: chainID, GenesisTime: genTime, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+
+	// The validator set changes at height 2, but there are no providers.
+	chain := newTestChain(t)
+	chain.addBlock(vals, newVals, privVals, genTime.Add(time.Minute))
+
+	r, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), nil, time.Hour)
+	require.NoError(t, err)
+	startReactor(t, r)
+	peer := p2pmock.NewPeer(nil)
+
+	chain.sendPrecommits(r, peer, 1)
+	chain.sendParts(r, peer, 1)
+	assertLatestHeight(t, r, 1)
+	require.Eventually(t, func() bool {
+		return r.Err() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReactorStopsPeerForMalformedMessage(t *testing.T) {
+	vals, _ := types.RandValidatorSet(4, 10)
+	genDoc := &types.GenesisDoc{ChainID: chainID, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+	r, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), nil, time.Hour)
+	require.NoError(t, err)
+	startReactor(t, r)
+
+	peer := p2pmock.NewPeer(nil)
+	r.Receive(cs.DataChannel, peer, []byte{0xff, 0xff})
+	assert.False(t, peer.IsRunning())
+
+	peer = p2pmock.NewPeer(nil)
+	r.ReceiveEnvelope(p2p.Envelope{ChannelID: cs.DataChannel, Src: peer, Message: &cmtproto.Header{}})
+	assert.False(t, peer.IsRunning())
+}
+
+func TestReactorCapsPrecommitRounds(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	genDoc := &types.GenesisDoc{ChainID: chainID, InitialHeight: 1}
+	for _, val := range vals.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+	r, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), nil, time.Hour)
+	require.NoError(t, err)
+	startReactor(t, r)
+	peer := p2pmock.NewPeer(nil)
+
+	for round := int32(0); round < 2*maxPrecommitRounds; round++ {
+		vote := &types.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           1,
+			Round:            round,
+			Timestamp:        time.Now(),
+			ValidatorAddress: vals.Validators[0].Address,
+			ValidatorIndex:   0,
+		}
+		var privVal types.PrivValidator
+		for _, pv := range privVals {
+			pubKey, err := pv.GetPubKey()
+			require.NoError(t, err)
+			if bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
+				privVal = pv
+			}
+		}
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote(chainID, v, false))
+		r.ReceiveEnvelope(p2p.Envelope{ChannelID: cs.VoteChannel, Src: peer, Message: &cmtcons.Vote{Vote: v}})
+	}
+
+	r.mtx.Lock()
+	assert.Len(t, r.precommits, maxPrecommitRounds)
+	r.mtx.Unlock()
+	assert.True(t, peer.IsRunning())
+}
+
+func TestReactorRequiresTrustedHeader(t *testing.T) {
+	genDoc := &types.GenesisDoc{ChainID: chainID, InitialHeight: 1}
+	_, err := NewReactor(genDoc, dbs.New(dbm.NewMemDB(), chainID), nil, time.Hour)
+	assert.Error(t, err)
+}
+
+func startReactor(t *testing.T, r *Reactor) {
+	r.SetLogger(log.TestingLogger())
+	sw := p2p.MakeSwitch(config.DefaultP2PConfig(), 0, chainID, "1.0.0", func(_ int, sw *p2p.Switch) *p2p.Switch {
+		sw.AddReactor("HEADERSYNC", r)
+		return sw
+	})
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func assertLatestHeight(t *testing.T, r *Reactor, height int64) {
+	t.Helper()
+	latest := r.LatestLightBlock()
+	require.NotNil(t, latest)
+	assert.Equal(t, height, latest.Height)
+}
+
+// testChain is a chain of blocks, committed by the precommits of the
+// validators.
+type testChain struct {
+	t             *testing.T
+	parts         []*types.PartSet
+	precommits    [][]*types.Vote
+	signedHeaders []*types.SignedHeader
+}
+
+func newTestChain(t *testing.T) *testChain {
+	return &testChain{t: t}
+}
+
+func (c *testChain) addBlock(vals, nextVals *types.ValidatorSet, privVals []types.PrivValidator, blockTime time.Time) {
+	height := int64(len(c.signedHeaders)) + 1
+
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	var lastBlockID types.BlockID
+	if height > 1 {
+		lastCommit = c.signedHeaders[height-2].Commit
+		lastBlockID = lastCommit.BlockID
+	}
+	block := types.MakeBlock(height, nil, lastCommit, nil)
+	block.ChainID = chainID
+	block.Time = blockTime
+	block.LastBlockID = lastBlockID
+	block.ValidatorsHash = vals.Hash()
+	block.NextValidatorsHash = nextVals.Hash()
+	block.ConsensusHash = tmhash.Sum([]byte("consensus"))
+	block.AppHash = tmhash.Sum([]byte("app"))
+	block.LastResultsHash = tmhash.Sum([]byte("results"))
+	block.ProposerAddress = vals.Validators[0].Address
+
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+	voteSet := types.NewVoteSet(chainID, height, 0, cmtproto.PrecommitType, vals)
+	var precommits []*types.Vote
+	for _, privVal := range privVals {
+		vote, err := types.MakeVote(height, blockID, vals, privVal, chainID, blockTime.Add(time.Second))
+		require.NoError(c.t, err)
+		_, err = voteSet.AddVote(vote)
+		require.NoError(c.t, err)
+		precommits = append(precommits, vote)
+	}
+
+	c.parts = append(c.parts, parts)
+	c.precommits = append(c.precommits, precommits)
+	c.signedHeaders = append(c.signedHeaders, &types.SignedHeader{Header: &block.Header, Commit: voteSet.MakeCommit()})
+}
+
+func (c *testChain) sendParts(r *Reactor, peer p2p.Peer, height int64) {
+	parts := c.parts[height-1]
+	for i := 0; i < int(parts.Total()); i++ {
+		part, err := parts.GetPart(i).ToProto()
+		require.NoError(c.t, err)
+		r.ReceiveEnvelope(p2p.Envelope{
+			ChannelID: cs.DataChannel,
+			Src:       peer,
+			Message:   &cmtcons.BlockPart{Height: height, Round: 0, Part: *part},
+		})
+	}
+}
+
+// sendFaultyPart sends the first block part of the height with its bytes
+// altered, so that it doesn't match its proof.
+func (c *testChain) sendFaultyPart(r *Reactor, peer p2p.Peer, height int64) {
+	part, err := c.parts[height-1].GetPart(0).ToProto()
+	require.NoError(c.t, err)
+	part.Bytes = append([]byte{}, part.Bytes...)
+	part.Bytes[0] ^= 0xff
+	r.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: cs.DataChannel,
+		Src:       peer,
+		Message:   &cmtcons.BlockPart{Height: height, Round: 0, Part: *part},
+	})
+}
+
+func (c *testChain) sendPrecommits(r *Reactor, peer p2p.Peer, height int64) {
+	for _, vote := range c.precommits[height-1] {
+		r.ReceiveEnvelope(p2p.Envelope{
+			ChannelID: cs.VoteChannel,
+			Src:       peer,
+			Message:   &cmtcons.Vote{Vote: vote.ToProto()},
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package headersync
+
+import (
+	"errors"
+	"fmt"
+
+	cmtbytes "github.com/KYVENetwork/celestia-core/libs/bytes"
+	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
+	"github.com/KYVENetwork/celestia-core/p2p"
+	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
+	rpcserver "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/server"
+	rpctypes "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/types"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const (
+	defaultPerPage = 30
+	maxPerPage     = 100
+)
+
+// RPCRoutes returns the header-only RPC routes of a light node, served from
+// the headers verified by the reactor.
+func RPCRoutes(r *Reactor, nodeInfo p2p.NodeInfo) map[string]*rpcserver.RPCFunc {
+	env := &environment{reactor: r, nodeInfo: nodeInfo}
+	return map[string]*rpcserver.RPCFunc{
+		"health":         rpcserver.NewRPCFunc(env.Health, ""),
+		"status":         rpcserver.NewRPCFunc(env.Status, ""),
+		"header":         rpcserver.NewRPCFunc(env.Header, "height", rpcserver.Cacheable("height")),
+		"header_by_hash": rpcserver.NewRPCFunc(env.HeaderByHash, "hash", rpcserver.Cacheable()),
+		"commit":         rpcserver.NewRPCFunc(env.Commit, "height", rpcserver.Cacheable("height")),
+		"validators":     rpcserver.NewRPCFunc(env.Validators, "height,page,per_page", rpcserver.Cacheable("height")),
+	}
+}
+
+type environment struct {
+	reactor  *Reactor
+	nodeInfo p2p.NodeInfo
+}
+
+// Health returns an empty result, if the light node is running and syncing,
+// or the error which has stopped the sync.
+func (env *environment) Health(*rpctypes.Context) (*ctypes.ResultHealth, error) {
+	if err := env.reactor.Err(); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultHealth{}, nil
+}
+
+// Status returns the node info and the first and latest verified headers.
+func (env *environment) Status(*rpctypes.Context) (*ctypes.ResultStatus, error) {
+	var syncInfo ctypes.SyncInfo
+
+	if latest := env.reactor.LatestLightBlock(); latest != nil {
+		syncInfo.LatestBlockHash = latest.Hash()
+		syncInfo.LatestAppHash = latest.AppHash
+		syncInfo.LatestBlockHeight = latest.Height
+		syncInfo.LatestBlockTime = latest.Time
+
+		firstHeight, err := env.reactor.Store().FirstLightBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		first, err := env.reactor.Store().LightBlock(firstHeight)
+		if err != nil {
+			return nil, err
+		}
+		syncInfo.EarliestBlockHash = first.Hash()
+		syncInfo.EarliestAppHash = first.AppHash
+		syncInfo.EarliestBlockHeight = first.Height
+		syncInfo.EarliestBlockTime = first.Time
+	}
+	syncInfo.CatchingUp = syncInfo.LatestBlockHeight < env.reactor.NetworkHeight()
+
+	result := &ctypes.ResultStatus{SyncInfo: syncInfo}
+	if nodeInfo, ok := env.nodeInfo.(p2p.DefaultNodeInfo); ok {
+		result.NodeInfo = nodeInfo
+	}
+	return result, nil
+}
+
+// Header returns the verified header at the height (nil - the latest).
+func (env *environment) Header(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultHeader, error) {
+	lb, err := env.lightBlock(heightPtr)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultHeader{Header: lb.Header}, nil
+}
+
+// HeaderByHash returns the verified header with the hash.
+func (env *environment) HeaderByHash(_ *rpctypes.Context, hash cmtbytes.HexBytes) (*ctypes.ResultHeader, error) {
+	lb, err := env.reactor.Store().LightBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultHeader{Header: lb.Header}, nil
+}
+
+// Commit returns the verified header and its commit at the height (nil - the
+// latest).
+func (env *environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
+	lb, err := env.lightBlock(heightPtr)
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultCommit(lb.Header, lb.Commit, true), nil
+}
+
+// Validators returns the validator set of the verified header at the height
+// (nil - the latest).
+func (env *environment) Validators(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+	pagePtr, perPagePtr *int,
+) (*ctypes.ResultValidators, error) {
+	lb, err := env.lightBlock(heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount := len(lb.ValidatorSet.Validators)
+	perPage := defaultPerPage
+	if perPagePtr != nil && *perPagePtr > 0 {
+		perPage = cmtmath.MinInt(*perPagePtr, maxPerPage)
+	}
+	page := 1
+	if pagePtr != nil {
+		page = *pagePtr
+	}
+	pages := cmtmath.MaxInt((totalCount-1)/perPage+1, 1)
+	if page <= 0 || page > pages {
+		return nil, fmt.Errorf("page should be within [1, %d] range, given %d", pages, page)
+	}
+
+	skipCount := (page - 1) * perPage
+	v := lb.ValidatorSet.Validators[skipCount : skipCount+cmtmath.MinInt(perPage, totalCount-skipCount)]
+	return &ctypes.ResultValidators{
+		BlockHeight: lb.Height,
+		Validators:  v,
+		Count:       len(v),
+		Total:       totalCount,
+	}, nil
+}
+
+func (env *environment) lightBlock(heightPtr *int64) (*types.LightBlock, error) {
+	if heightPtr == nil {
+		latest := env.reactor.LatestLightBlock()
+		if latest == nil {
+			return nil, errors.New("no headers verified yet")
+		}
+		return latest, nil
+	}
+	return env.reactor.Store().LightBlock(*heightPtr)
+}
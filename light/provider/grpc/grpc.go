@@ -0,0 +1,231 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	cmtproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	coregrpc "github.com/KYVENetwork/celestia-core/rpc/grpc"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const (
+	// timeout is used for requests of single light blocks.
+	timeout = 5 * time.Second
+
+	// maxRetryAttempts is the number of attempts of a request failing because
+	// the server is unavailable or the request timed out.
+	maxRetryAttempts = 5
+)
+
+// GRPC is a provider using the gRPC LightBlockAPI of a full node, which returns
+// a light block in a single call, as opposed to the signed header and the pages
+// of the validator set being fetched separately by the HTTP provider.
+//
+// The LightBlockAPI has no endpoint to report evidence, so evidence is reported
+// through the RPC of the full node instead, if an evidence client is given.
+type GRPC struct {
+	chainID  string
+	remote   string
+	client   coregrpc.LightBlockAPIClient
+	evidence rpcclient.EvidenceClient
+}
+
+var _ provider.Provider = (*GRPC)(nil)
+
+// New creates a gRPC provider, connecting to the gRPC server of a full node
+// (see rpc.grpc_laddr) at remote, e.g. tcp://127.0.0.1:9090. Evidence is
+// reported through the evidence client, usually the RPC client of the same
+// full node, which may be nil.
+func New(chainID, remote string, evidence rpcclient.EvidenceClient) (*GRPC, error) {
+	client, err := coregrpc.StartLightBlockGRPCClient(remote)
+	if err != nil {
+		return nil, err
+	}
+	p := NewWithClient(chainID, client, evidence)
+	p.remote = remote
+	return p, nil
+}
+
+// NewWithClient allows you to provide a custom client.
+func NewWithClient(chainID string, client coregrpc.LightBlockAPIClient, evidence rpcclient.EvidenceClient) *GRPC {
+	return &GRPC{
+		chainID:  chainID,
+		client:   client,
+		evidence: evidence,
+	}
+}
+
+// ChainID returns a chainID this provider was configured with.
+func (p *GRPC) ChainID() string {
+	return p.chainID
+}
+
+func (p *GRPC) String() string {
+	return fmt.Sprintf("grpc{%s}", p.remote)
+}
+
+// LightBlock fetches a LightBlock at the given height and checks the
+// chainID matches.
+func (p *GRPC) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("expected height >= 0, got height %d", height)}
+	}
+
+	for attempt := 1; ; attempt++ {
+		reqCtx, cancel := context.WithTimeout(ctx, timeout)
+		res, err := p.client.LightBlock(reqCtx, &coregrpc.RequestLightBlock{Height: height})
+		cancel()
+		if err == nil {
+			return p.lightBlockFromProto(res.LightBlock, height)
+		}
+		if err := retry(ctx, parseError(ctx, err), attempt); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// LightBlocks fetches the LightBlocks of the heights from to to (inclusive),
+// streaming up to coregrpc.MaxLightBlocks of them at once, and checks they are
+// in ascending order and the chainID matches. A stream which fails is resumed
+// at the first missing height.
+func (p *GRPC) LightBlocks(ctx context.Context, from, to int64) ([]*types.LightBlock, error) {
+	if from <= 0 || to < from {
+		return nil, fmt.Errorf("invalid range of heights [%d, %d]", from, to)
+	}
+
+	lbs := make([]*types.LightBlock, 0, to-from+1)
+	attempt := 1
+	for height := from; height <= to; {
+		batch, err := p.streamLightBlocks(ctx, height, cmtmath.MinInt64(to, height+coregrpc.MaxLightBlocks-1))
+		lbs = append(lbs, batch...)
+		height += int64(len(batch))
+		if err == nil {
+			attempt = 1
+			continue
+		}
+		if len(batch) > 0 {
+			attempt = 1
+		}
+		if err := retry(ctx, err, attempt); err != nil {
+			return nil, err
+		}
+		attempt++
+	}
+	return lbs, nil
+}
+
+// streamLightBlocks streams the LightBlocks of the heights from to to
+// (inclusive), which must not be more than coregrpc.MaxLightBlocks. It returns
+// the light blocks received before an error occurred, if any.
+func (p *GRPC) streamLightBlocks(ctx context.Context, from, to int64) ([]*types.LightBlock, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := p.client.LightBlocks(ctx, &coregrpc.RequestLightBlocks{FromHeight: from, ToHeight: to})
+	if err != nil {
+		return nil, parseError(ctx, err)
+	}
+
+	lbs := make([]*types.LightBlock, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil, provider.ErrBadLightBlock{
+				Reason: fmt.Errorf("stream ended at height %d, expected light blocks up to height %d", height, to),
+			}
+		}
+		if err != nil {
+			return lbs, parseError(ctx, err)
+		}
+		lb, err := p.lightBlockFromProto(res.LightBlock, height)
+		if err != nil {
+			return nil, err
+		}
+		lbs = append(lbs, lb)
+	}
+	return lbs, nil
+}
+
+// ReportEvidence reports the evidence through the evidence client, as the
+// LightBlockAPI has no endpoint to report evidence.
+func (p *GRPC) ReportEvidence(ctx context.Context, ev types.Evidence) error {
+	if p.evidence == nil {
+		return errors.New("reporting evidence requires an evidence client, which the gRPC provider doesn't have")
+	}
+	_, err := p.evidence.BroadcastEvidence(ctx, ev)
+	return err
+}
+
+// lightBlockFromProto converts and validates a light block responded for the
+// given height (0 - the latest).
+func (p *GRPC) lightBlockFromProto(pb *cmtproto.LightBlock, height int64) (*types.LightBlock, error) {
+	lb, err := types.LightBlockFromProto(pb)
+	if err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+
+	if height != 0 && lb.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded doesn't match height %d requested", lb.Height, height),
+		}
+	}
+	return lb, nil
+}
+
+// parseError maps the status of a failed request to the errors expected by the
+// light client.
+func parseError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	switch status.Code(err) {
+	case codes.OutOfRange:
+		return provider.ErrHeightTooHigh
+	case codes.NotFound:
+		return provider.ErrLightBlockNotFound
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	case codes.Unavailable:
+		return provider.ErrNoResponse
+	default:
+		return err
+	}
+}
+
+// retry returns nil after a backoff if the request failed with err because the
+// server is unavailable or the request timed out, and there are attempts left.
+// Otherwise, it returns the error to fail the request with.
+func retry(ctx context.Context, err error, attempt int) error {
+	if !errors.Is(err, provider.ErrNoResponse) && !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if attempt >= maxRetryAttempts {
+		return provider.ErrNoResponse
+	}
+	select {
+	case <-time.After(backoffTimeout(attempt)):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// backoffTimeout returns an exponentially increasing timeout with some jitter.
+func backoffTimeout(attempt int) time.Duration {
+	//nolint:gosec // G404: Use of weak random number generator
+	return time.Duration(500*attempt*attempt)*time.Millisecond + time.Duration(rand.Intn(1000))*time.Millisecond
+}
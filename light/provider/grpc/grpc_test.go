@@ -0,0 +1,148 @@
+package grpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KYVENetwork/celestia-core/abci/example/kvstore"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	lightgrpc "github.com/KYVENetwork/celestia-core/light/provider/grpc"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	rpchttp "github.com/KYVENetwork/celestia-core/rpc/client/http"
+	coregrpc "github.com/KYVENetwork/celestia-core/rpc/grpc"
+	rpctest "github.com/KYVENetwork/celestia-core/rpc/test"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+func TestProvider(t *testing.T) {
+	node := rpctest.StartTendermint(kvstore.NewApplication())
+	cfg := rpctest.GetConfig()
+	// stop the node before removing its data
+	defer os.RemoveAll(cfg.RootDir)
+	defer rpctest.StopTendermint(node)
+	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	chainID := genDoc.ChainID
+
+	c, err := rpchttp.New(cfg.RPC.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	p, err := lightgrpc.New(chainID, cfg.RPC.GRPCListenAddress, c)
+	require.NoError(t, err)
+	assert.Equal(t, "grpc{"+cfg.RPC.GRPCListenAddress+"}", p.String())
+
+	// let it produce some blocks
+	err = rpcclient.WaitForHeight(c, 10, nil)
+	require.NoError(t, err)
+
+	// let's get the highest block
+	lb, err := p.LightBlock(context.Background(), 0)
+	require.NoError(t, err)
+	require.NotNil(t, lb)
+	assert.Nil(t, lb.ValidateBasic(chainID))
+
+	lower := lb.Height - 3
+	lb, err = p.LightBlock(context.Background(), lower)
+	require.NoError(t, err)
+	assert.Equal(t, lower, lb.Height)
+
+	// the light blocks of a range are streamed
+	lbs, err := p.LightBlocks(context.Background(), lower, lower+2)
+	require.NoError(t, err)
+	require.Len(t, lbs, 3)
+	for i, lb := range lbs {
+		assert.Equal(t, lower+int64(i), lb.Height)
+	}
+
+	// fetching future heights should return the appropriate error
+	_, err = p.LightBlock(context.Background(), lb.Height+1_000_000)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+
+	_, err = p.LightBlocks(context.Background(), lower, lb.Height+1_000_000)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+
+	// ranges of more than MaxLightBlocks are streamed in several requests
+	err = rpcclient.WaitForHeight(c, coregrpc.MaxLightBlocks+1, nil)
+	require.NoError(t, err)
+	lbs, err = p.LightBlocks(context.Background(), 1, coregrpc.MaxLightBlocks+1)
+	require.NoError(t, err)
+	require.Len(t, lbs, int(coregrpc.MaxLightBlocks)+1)
+	for i, lb := range lbs {
+		assert.Equal(t, int64(i)+1, lb.Height)
+	}
+
+	// evidence is reported through the RPC client, which rejects the evidence
+	// of an unknown validator
+	ev := types.NewMockDuplicateVoteEvidence(lower, time.Now(), chainID)
+	err = p.ReportEvidence(context.Background(), ev)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "evidence")
+
+	noEvidence := lightgrpc.NewWithClient(chainID, nil, nil)
+	assert.Error(t, noEvidence.ReportEvidence(context.Background(), ev))
+
+	// fetching with the context cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = p.LightBlock(ctx, lower)
+	assert.Equal(t, context.Canceled, err)
+}
+
+// unavailableClient fails the first requests with codes.Unavailable.
+type unavailableClient struct {
+	coregrpc.LightBlockAPIClient
+	failures int
+	calls    int
+}
+
+func (c *unavailableClient) LightBlock(
+	ctx context.Context, req *coregrpc.RequestLightBlock, opts ...grpc.CallOption,
+) (*coregrpc.ResponseLightBlock, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return c.LightBlockAPIClient.LightBlock(ctx, req, opts...)
+}
+
+func TestProviderRetries(t *testing.T) {
+	ctx := context.Background()
+
+	// the request is retried until the server is available
+	client := &unavailableClient{failures: 1}
+	p := lightgrpc.NewWithClient("test-chain", client, nil)
+	_, err := p.LightBlock(ctx, -1)
+	assert.Error(t, err)
+	assert.Zero(t, client.calls)
+
+	client.LightBlockAPIClient = invalidClient{}
+	_, err = p.LightBlock(ctx, 1)
+	assert.IsType(t, provider.ErrBadLightBlock{}, err)
+	assert.Equal(t, 2, client.calls)
+
+	// the request fails if the server stays unavailable
+	client = &unavailableClient{failures: 100}
+	p = lightgrpc.NewWithClient("test-chain", client, nil)
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	_, err = p.LightBlock(ctx, 1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+// invalidClient responds with empty light blocks.
+type invalidClient struct {
+	coregrpc.LightBlockAPIClient
+}
+
+func (invalidClient) LightBlock(
+	context.Context, *coregrpc.RequestLightBlock, ...grpc.CallOption,
+) (*coregrpc.ResponseLightBlock, error) {
+	return &coregrpc.ResponseLightBlock{}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/KYVENetwork/celestia-core/libs/log"
 	cmtpubsub "github.com/KYVENetwork/celestia-core/libs/pubsub"
 	"github.com/KYVENetwork/celestia-core/light"
+	levidence "github.com/KYVENetwork/celestia-core/light/evidence"
 	lrpc "github.com/KYVENetwork/celestia-core/light/rpc"
 	rpchttp "github.com/KYVENetwork/celestia-core/rpc/client/http"
 	rpcserver "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/server"
@@ -21,6 +22,10 @@ type Proxy struct {
 	Client   *lrpc.Client
 	Logger   log.Logger
 	Listener net.Listener
+
+	// EvidenceSink, if set, exposes the evidence of light client attacks over
+	// the light_evidence RPC endpoint.
+	EvidenceSink *levidence.Sink
 }
 
 // NewProxy creates the struct used to run an HTTP server for serving light
@@ -89,6 +94,9 @@ func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 
 	// 1) Register regular routes.
 	r := RPCRoutes(p.Client)
+	if p.EvidenceSink != nil {
+		r["light_evidence"] = rpcserver.NewRPCFunc(makeLightEvidenceFunc(p.EvidenceSink), "")
+	}
 	rpcserver.RegisterRPCFuncs(mux, r, p.Logger)
 
 	// 2) Allow websocket connections.
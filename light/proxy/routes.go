@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/KYVENetwork/celestia-core/libs/bytes"
+	levidence "github.com/KYVENetwork/celestia-core/light/evidence"
 	lrpc "github.com/KYVENetwork/celestia-core/light/rpc"
 	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
 	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
@@ -21,6 +22,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"health":               rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
 		"status":               rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
 		"net_info":             rpcserver.NewRPCFunc(makeNetInfoFunc(c), ""),
+		"network_map":          rpcserver.NewRPCFunc(makeNetworkMapFunc(c), ""),
 		"blockchain":           rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight", rpcserver.Cacheable()),
 		"genesis":              rpcserver.NewRPCFunc(makeGenesisFunc(c), "", rpcserver.Cacheable()),
 		"genesis_chunked":      rpcserver.NewRPCFunc(makeGenesisChunkedFunc(c), "", rpcserver.Cacheable()),
@@ -41,6 +43,11 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
 		"tx_status":            rpcserver.NewRPCFunc(makeTxStatusFunc(c), "hash"),
 
+		// data availability API
+		"data_commitment":           rpcserver.NewRPCFunc(makeDataCommitmentFunc(c), "start,end"),
+		"data_root_inclusion_proof": rpcserver.NewRPCFunc(makeDataRootInclusionProofFunc(c), "height,start,end"),
+		"prove_shares":              rpcserver.NewRPCFunc(makeProveSharesFunc(c), "height,startShare,endShare"),
+
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
 		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx"),
@@ -80,6 +87,14 @@ func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
 	}
 }
 
+type rpcNetworkMapFunc func(ctx *rpctypes.Context) (*ctypes.ResultNetworkMap, error)
+
+func makeNetworkMapFunc(c *lrpc.Client) rpcNetworkMapFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultNetworkMap, error) {
+		return c.NetworkMap(ctx.Context())
+	}
+}
+
 type rpcBlockchainInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error)
 
 func makeBlockchainInfoFunc(c *lrpc.Client) rpcBlockchainInfoFunc {
@@ -168,6 +183,40 @@ func makeTxFunc(c *lrpc.Client) rpcTxFunc {
 	}
 }
 
+type rpcDataCommitmentFunc func(ctx *rpctypes.Context, start, end uint64) (*ctypes.ResultDataCommitment, error)
+
+func makeDataCommitmentFunc(c *lrpc.Client) rpcDataCommitmentFunc {
+	return func(ctx *rpctypes.Context, start, end uint64) (*ctypes.ResultDataCommitment, error) {
+		return c.DataCommitment(ctx.Context(), start, end)
+	}
+}
+
+type rpcDataRootInclusionProofFunc func(
+	ctx *rpctypes.Context,
+	height int64,
+	start,
+	end uint64,
+) (*ctypes.ResultDataRootInclusionProof, error)
+
+func makeDataRootInclusionProofFunc(c *lrpc.Client) rpcDataRootInclusionProofFunc {
+	return func(ctx *rpctypes.Context, height int64, start, end uint64) (*ctypes.ResultDataRootInclusionProof, error) {
+		return c.DataRootInclusionProof(ctx.Context(), uint64(height), start, end)
+	}
+}
+
+type rpcProveSharesFunc func(
+	ctx *rpctypes.Context,
+	height int64,
+	startShare,
+	endShare uint64,
+) (*ctypes.ResultShareProof, error)
+
+func makeProveSharesFunc(c *lrpc.Client) rpcProveSharesFunc {
+	return func(ctx *rpctypes.Context, height int64, startShare, endShare uint64) (*ctypes.ResultShareProof, error) {
+		return c.ProveShares(ctx.Context(), uint64(height), startShare, endShare)
+	}
+}
+
 type rpcTxSearchFuncMatchEvents func(
 	ctx *rpctypes.Context,
 	query string,
@@ -325,3 +374,15 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcLightEvidenceFunc func(ctx *rpctypes.Context) (*levidence.ResultEvidence, error)
+
+func makeLightEvidenceFunc(s *levidence.Sink) rpcLightEvidenceFunc {
+	return func(ctx *rpctypes.Context) (*levidence.ResultEvidence, error) {
+		recs, err := s.Evidence()
+		if err != nil {
+			return nil, err
+		}
+		return &levidence.ResultEvidence{Evidence: recs}, nil
+	}
+}
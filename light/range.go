@@ -0,0 +1,362 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	"github.com/KYVENetwork/celestia-core/light/store"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const (
+	// rangeFetchConcurrency is the maximum number of light blocks VerifyRange
+	// requests concurrently, or has received but not verified yet.
+	rangeFetchConcurrency = 8
+
+	// verifyRangeBatchSize is the number of light blocks VerifyRange verifies
+	// before cross-checking the last one with the witnesses and saving them.
+	verifyRangeBatchSize = 100
+)
+
+// VerifyRange verifies all the light blocks in the range [from, to], and
+// returns an iterator over them. An error is returned if the range is not
+// below the latest height of the primary, rather than verifying only a part
+// of it.
+//
+// The light blocks above the latest trusted light block are verified forwards:
+// the first one is verified like VerifyLightBlockAtHeight does (i.e. bisection
+// is run at most once), and every following light block is verified against
+// the previous one using adjacent verification. The light blocks below it may
+// be older than the trusting period, so they are verified backwards instead,
+// from the closest trusted light block at or above to, each one by its hash
+// being the LastBlockID of the following trusted light block. The light
+// blocks are requested from the primary concurrently, ahead of their
+// verification.
+//
+// The light blocks verified forwards are cross-checked with the witnesses and
+// saved to the trusted store in batches, and the ones verified backwards are
+// saved one by one, so if an error is returned, a part of the range may
+// already be trusted. The light blocks already in the trusted store are not
+// requested again.
+//
+// The range is not pruned from the trusted store by VerifyRange, but may be
+// by the following updates of the light client, so the iterator should be
+// consumed before.
+//
+// from must be > 0 && to must be >= from.
+func (c *Client) VerifyRange(ctx context.Context, from, to int64, now time.Time) (*LightBlockIterator, error) {
+	if from <= 0 {
+		return nil, errors.New("negative or zero height")
+	}
+	if to < from {
+		return nil, fmt.Errorf("to (%d) is below from (%d)", to, from)
+	}
+
+	latestBlock, err := c.lightBlockFromPrimary(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain the latest light block: %w", err)
+	}
+	if to > latestBlock.Height {
+		return nil, fmt.Errorf("to (%d) is above the latest height of the primary (%d)", to, latestBlock.Height)
+	}
+
+	var verifiedBlock *types.LightBlock
+	if from < c.latestTrustedBlock.Height {
+		// the closest trusted light block at or above to
+		verifiedBlock = c.latestTrustedBlock
+		err := c.trustedStore.IterateLightBlocks(cmtmath.MinInt64(to, verifiedBlock.Height), verifiedBlock.Height,
+			func(lb *types.LightBlock) bool {
+				verifiedBlock = lb
+				return false
+			})
+		if err != nil {
+			return nil, fmt.Errorf("can't get light block at or above height %d: %w", to, err)
+		}
+		if err := c.verifyRangeBackwards(ctx, verifiedBlock, from); err != nil {
+			return nil, err
+		}
+	} else {
+		verifiedBlock, err = c.VerifyLightBlockAtHeight(ctx, from, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify light block at height %d: %w", from, err)
+		}
+	}
+
+	if err := c.verifyRangeForwards(ctx, verifiedBlock, to, now); err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Verified range", "from", from, "to", to)
+	return NewLightBlockIterator(c.trustedStore, from, to), nil
+}
+
+// verifyRangeForwards verifies the light blocks above the verified light block
+// up to the height to using adjacent verification, and saves them to the
+// trusted store in batches (see saveTrustedRange).
+func (c *Client) verifyRangeForwards(
+	ctx context.Context,
+	verifiedBlock *types.LightBlock,
+	to int64,
+	now time.Time,
+) error {
+	if verifiedBlock.Height >= to {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fetched := c.fetchRange(ctx, verifiedBlock.Height+1, to)
+
+	trace := []*types.LightBlock{verifiedBlock}
+	for height := verifiedBlock.Height + 1; height <= to; height++ {
+		response, err := nextRangeResponse(ctx, fetched)
+		if err != nil {
+			return err
+		}
+		if response.err != nil {
+			return ErrVerificationFailed{From: verifiedBlock.Height, To: height, Reason: response.err}
+		}
+
+		if !response.trusted {
+			c.logger.Debug("Verify adjacent light block in range",
+				"trustedHeight", verifiedBlock.Height,
+				"trustedHash", verifiedBlock.Hash(),
+				"newHeight", response.lb.Height,
+				"newHash", response.lb.Hash())
+
+			err := VerifyAdjacent(verifiedBlock.SignedHeader, response.lb.SignedHeader, response.lb.ValidatorSet,
+				c.trustingPeriod, now, c.maxClockDrift)
+			if err != nil {
+				return ErrVerificationFailed{From: verifiedBlock.Height, To: height, Reason: err}
+			}
+		}
+		verifiedBlock = response.lb
+		trace = append(trace, verifiedBlock)
+
+		if len(trace) > verifyRangeBatchSize || height == to {
+			if err := c.saveTrustedRange(ctx, trace, response.source, now); err != nil {
+				return err
+			}
+			trace = []*types.LightBlock{verifiedBlock}
+		}
+	}
+	return nil
+}
+
+// verifyRangeBackwards verifies the light blocks below the trusted light block
+// down to the height from, each one against the following one using backwards
+// verification, and saves them to the trusted store. As every light block is
+// bound by its hash to a trusted light block, the trusting period doesn't
+// apply and the light blocks are not cross-checked with the witnesses.
+func (c *Client) verifyRangeBackwards(ctx context.Context, trustedBlock *types.LightBlock, from int64) error {
+	if trustedBlock.Height <= from {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fetched := c.fetchRange(ctx, trustedBlock.Height-1, from)
+
+	verifiedBlock := trustedBlock
+	for height := trustedBlock.Height - 1; height >= from; height-- {
+		response, err := nextRangeResponse(ctx, fetched)
+		if err != nil {
+			return err
+		}
+		if response.err != nil {
+			return ErrVerificationFailed{From: verifiedBlock.Height, To: height, Reason: response.err}
+		}
+
+		if !response.trusted {
+			c.logger.Debug("Verify light block in range backwards",
+				"trustedHeight", verifiedBlock.Height,
+				"trustedHash", verifiedBlock.Hash(),
+				"newHeight", response.lb.Height,
+				"newHash", response.lb.Hash())
+
+			err := response.lb.ValidateBasic(c.chainID)
+			if err == nil {
+				err = VerifyBackwards(response.lb.Header, verifiedBlock.Header)
+			}
+			if err != nil {
+				return ErrVerificationFailed{From: verifiedBlock.Height, To: height, Reason: err}
+			}
+			if err := c.trustedStore.SaveLightBlock(response.lb); err != nil {
+				return fmt.Errorf("failed to save trusted header: %w", err)
+			}
+		}
+		verifiedBlock = response.lb
+	}
+	return nil
+}
+
+// saveTrustedRange compares the last light block of the trace, which was
+// received from source, with the other providers, and then saves the light
+// blocks of the trace to the trusted store. Unlike updateTrustedLightBlock, the
+// trusted store is not pruned.
+func (c *Client) saveTrustedRange(
+	ctx context.Context,
+	trace []*types.LightBlock,
+	source provider.Provider,
+	now time.Time,
+) error {
+	if source == nil { // the last light block was already trusted
+		c.providerMutex.Lock()
+		source = c.primary
+		c.providerMutex.Unlock()
+	}
+	if err := c.detectDivergence(ctx, trace, source, now); err != nil {
+		return err
+	}
+
+	for _, l := range trace[1:] {
+		if err := c.trustedStore.SaveLightBlock(l); err != nil {
+			return fmt.Errorf("failed to save trusted header: %w", err)
+		}
+	}
+
+	if last := trace[len(trace)-1]; last.Height > c.latestTrustedBlock.Height {
+		c.latestTrustedBlock = last
+	}
+	return nil
+}
+
+type rangeResponse struct {
+	lb      *types.LightBlock
+	source  provider.Provider // nil if lb was already in the trusted store
+	trusted bool              // lb was already in the trusted store
+	err     error
+}
+
+// fetchRange requests the light blocks from the height from to the height to
+// in the background, and returns a channel of the response channels of the
+// heights, in the order from from to to, which is descending if to is below
+// from. Light blocks already in the trusted store are read from it instead.
+//
+// A request is only started once there are less than rangeFetchConcurrency
+// response channels pending, so that the requests don't run too far ahead of
+// the verification. The requests stop once ctx is done.
+func (c *Client) fetchRange(ctx context.Context, from, to int64) <-chan chan rangeResponse {
+	step := int64(1)
+	if to < from {
+		step = -1
+	}
+
+	pending := make(chan chan rangeResponse, rangeFetchConcurrency)
+	go func() {
+		for height := from; height != to+step; height += step {
+			responseC := make(chan rangeResponse, 1)
+			select {
+			case pending <- responseC:
+			case <-ctx.Done():
+				return
+			}
+			go func(height int64) {
+				if lb, err := c.trustedStore.LightBlock(height); err == nil {
+					responseC <- rangeResponse{lb: lb, trusted: true}
+					return
+				}
+				lb, source, err := c.lightBlockFromPrimaryConcurrently(ctx, height)
+				responseC <- rangeResponse{lb: lb, source: source, err: err}
+			}(height)
+		}
+	}()
+	return pending
+}
+
+// nextRangeResponse returns the next response of fetchRange.
+func nextRangeResponse(ctx context.Context, fetched <-chan chan rangeResponse) (rangeResponse, error) {
+	var responseC chan rangeResponse
+	select {
+	case responseC = <-fetched:
+	case <-ctx.Done():
+		return rangeResponse{}, ctx.Err()
+	}
+
+	select {
+	case response := <-responseC:
+		return response, nil
+	case <-ctx.Done():
+		return rangeResponse{}, ctx.Err()
+	}
+}
+
+// lightBlockFromPrimaryConcurrently is like lightBlockAndSourceFromPrimary,
+// but doesn't hold the provider mutex during the request to the primary, so
+// that it can be called concurrently. If the request fails, the primary is
+// replaced (see replacePrimary), unless another request has replaced it
+// already, in which case the light block is requested from the new primary.
+func (c *Client) lightBlockFromPrimaryConcurrently(
+	ctx context.Context,
+	height int64,
+) (*types.LightBlock, provider.Provider, error) {
+	if c.concurrentPrimaries > 1 {
+		return c.lightBlockAndSourceFromPrimary(ctx, height)
+	}
+
+	c.providerMutex.Lock()
+	primary := c.primary
+	c.providerMutex.Unlock()
+
+	l, err := primary.LightBlock(ctx, height)
+	return c.handlePrimaryResponse(ctx, primary, height, l, err)
+}
+
+// LightBlockIterator iterates over the trusted light blocks of a range in
+// ascending height order, reading them from the trusted store. See
+// Client.VerifyRange.
+//
+//	for it.Next() {
+//		lb := it.LightBlock()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type LightBlockIterator struct {
+	store      store.Store
+	height, to int64
+
+	lb  *types.LightBlock
+	err error
+}
+
+// NewLightBlockIterator returns an iterator over the light blocks of the store
+// in the range [from, to].
+func NewLightBlockIterator(s store.Store, from, to int64) *LightBlockIterator {
+	return &LightBlockIterator{store: s, height: from, to: to}
+}
+
+// Next advances the iterator to the next light block, which is then returned
+// by LightBlock. It returns false when the iteration stops, either at the end
+// of the range or on an error.
+func (it *LightBlockIterator) Next() bool {
+	it.lb = nil
+	if it.err != nil || it.height > it.to {
+		return false
+	}
+
+	lb, err := it.store.LightBlock(it.height)
+	if err != nil {
+		it.err = fmt.Errorf("can't get light block at height %d: %w", it.height, err)
+		return false
+	}
+	it.lb = lb
+	it.height++
+	return true
+}
+
+// LightBlock returns the current light block, or nil if Next hasn't been
+// called or returned false.
+func (it *LightBlockIterator) LightBlock() *types.LightBlock {
+	return it.lb
+}
+
+// Err returns the first error of the iteration, if any.
+func (it *LightBlockIterator) Err() error {
+	return it.err
+}
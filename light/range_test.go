@@ -0,0 +1,162 @@
+package light_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	"github.com/KYVENetwork/celestia-core/light"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	mockp "github.com/KYVENetwork/celestia-core/light/provider/mock"
+	dbs "github.com/KYVENetwork/celestia-core/light/store/db"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+func TestClient_VerifyRange(t *testing.T) {
+	_, headers, vals := genMockNode(chainID, 250, 3, 0, bTime)
+	primary := mockp.New(chainID, headers, vals)
+	witness := mockp.New(chainID, headers, vals)
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 10 * time.Hour,
+			Height: 1,
+			Hash:   headers[1].Hash(),
+		},
+		primary,
+		[]provider.Provider{witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	_, err = c.VerifyRange(ctx, 10, 9, bTime.Add(5*time.Hour))
+	assert.Error(t, err)
+
+	// the range spans several batches
+	it, err := c.VerifyRange(ctx, 10, 230, bTime.Add(5*time.Hour))
+	require.NoError(t, err)
+	height := int64(10)
+	for it.Next() {
+		assert.Equal(t, height, it.LightBlock().Height)
+		assert.Equal(t, headers[height].Hash(), it.LightBlock().Hash())
+		height++
+	}
+	require.NoError(t, it.Err())
+	assert.EqualValues(t, 231, height)
+	assert.Nil(t, it.LightBlock())
+
+	lastHeight, err := c.LastTrustedHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 230, lastHeight)
+
+	// the trusted light blocks are reused
+	it, err = c.VerifyRange(ctx, 200, 240, bTime.Add(5*time.Hour))
+	require.NoError(t, err)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, 41, count)
+
+	// the light blocks below the latest trusted one are verified backwards, even
+	// once they are older than the trusting period
+	it, err = c.VerifyRange(ctx, 2, 8, bTime.Add(12*time.Hour))
+	require.NoError(t, err)
+	height = 2
+	for it.Next() {
+		assert.Equal(t, headers[height].Hash(), it.LightBlock().Hash())
+		height++
+	}
+	require.NoError(t, it.Err())
+	assert.EqualValues(t, 9, height)
+
+	// the range must not exceed the latest height of the primary
+	_, err = c.VerifyRange(ctx, 5, 400, bTime.Add(5*time.Hour))
+	assert.Error(t, err)
+	_, err = c.VerifyRange(ctx, 300, 400, bTime.Add(5*time.Hour))
+	assert.Error(t, err)
+}
+
+func TestClient_VerifyRangeRejectsInvalidOldHeader(t *testing.T) {
+	_, headers, vals := genMockNode(chainID, 50, 3, 0, bTime)
+
+	// the light block at height 20 is valid on its own, but isn't the last
+	// block of height 21
+	_, otherHeaders, otherVals := genMockNode(chainID, 50, 3, 0, bTime)
+	headers[20] = otherHeaders[20]
+	vals[20] = otherVals[20]
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 10 * time.Hour,
+			Height: 40,
+			Hash:   headers[40].Hash(),
+		},
+		mockp.New(chainID, headers, vals),
+		[]provider.Provider{mockp.New(chainID, headers, vals)},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	_, err = c.VerifyRange(ctx, 10, 30, bTime.Add(3*time.Hour))
+	var verificationErr light.ErrVerificationFailed
+	require.True(t, errors.As(err, &verificationErr), err)
+	assert.EqualValues(t, 21, verificationErr.From)
+	assert.EqualValues(t, 20, verificationErr.To)
+
+	_, err = c.TrustedLightBlock(21)
+	assert.NoError(t, err)
+	_, err = c.TrustedLightBlock(20)
+	assert.Error(t, err)
+}
+
+func TestClient_VerifyRangeRejectsInvalidHeader(t *testing.T) {
+	_, headers, vals := genMockNode(chainID, 100, 3, 0, bTime)
+
+	// the light block at height 50 is valid on its own, but isn't signed by the
+	// next validators of height 49
+	otherKeys := genPrivKeys(3)
+	otherVals := otherKeys.ToValidators(2, 0)
+	headers[50] = otherKeys.GenSignedHeaderLastBlockID(chainID, 50, bTime.Add(50*time.Minute), nil,
+		otherVals, otherVals, hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(otherKeys),
+		types.BlockID{Hash: headers[49].Hash()})
+	vals[50] = otherVals
+	primary := mockp.New(chainID, headers, vals)
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 10 * time.Hour,
+			Height: 1,
+			Hash:   headers[1].Hash(),
+		},
+		primary,
+		[]provider.Provider{mockp.New(chainID, headers, vals)},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	_, err = c.VerifyRange(ctx, 10, 60, bTime.Add(3*time.Hour))
+	var verificationErr light.ErrVerificationFailed
+	require.True(t, errors.As(err, &verificationErr), err)
+	assert.EqualValues(t, 49, verificationErr.From)
+	assert.EqualValues(t, 50, verificationErr.To)
+
+	_, err = c.TrustedLightBlock(49)
+	assert.Error(t, err)
+}
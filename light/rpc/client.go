@@ -3,6 +3,7 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	cmtbytes "github.com/KYVENetwork/celestia-core/libs/bytes"
 	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
 	service "github.com/KYVENetwork/celestia-core/libs/service"
+	"github.com/KYVENetwork/celestia-core/light"
 	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
 	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
 	rpctypes "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/types"
@@ -23,6 +25,23 @@ import (
 
 var errNegOrZeroHeight = errors.New("negative or zero height")
 
+const (
+	// maxDataCommitmentBlocks is the maximum number of blocks of a data
+	// commitment that is verified, matching the limit of the full node.
+	maxDataCommitmentBlocks = 10_000
+	// eventVerificationTimeout is the timeout for verifying a subscribed event.
+	eventVerificationTimeout = 30 * time.Second
+	// maxConcurrentEventVerifications is the maximum number of events of a
+	// subscription which are verified concurrently.
+	maxConcurrentEventVerifications = 16
+	// txEventRetryInterval and maxTxEventRetryInterval are the initial and
+	// maximum intervals between the requests for the proof and the results of
+	// the tx of an event, which fail until the primary has indexed the tx and
+	// committed the following block.
+	txEventRetryInterval    = 100 * time.Millisecond
+	maxTxEventRetryInterval = 2 * time.Second
+)
+
 // KeyPathFunc builds a merkle path out of the given path and key.
 type KeyPathFunc func(path string, key []byte) (merkle.KeyPath, error)
 
@@ -34,13 +53,15 @@ type LightClient interface {
 	Update(ctx context.Context, now time.Time) (*types.LightBlock, error)
 	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*types.LightBlock, error)
 	TrustedLightBlock(height int64) (*types.LightBlock, error)
+	VerifyRange(ctx context.Context, from, to int64, now time.Time) (*light.LightBlockIterator, error)
 }
 
 var _ rpcclient.Client = (*Client)(nil)
 
 // Client is an RPC client, which uses light#Client to verify data (if it can
 // be proved). Note, merkle.DefaultProofRuntime is used to verify values
-// returned by ABCI#Query.
+// returned by ABCI#Query, so the value and ICS23 (IAVL and simple Merkle)
+// proofs of e.g. the Cosmos SDK stores are verified out of the box.
 type Client struct {
 	service.BaseService
 
@@ -78,12 +99,7 @@ func DefaultMerkleKeyPathFn() KeyPathFunc {
 		if len(matches) != 2 {
 			return nil, fmt.Errorf("can't find store name in %s using %s", path, storeNameRegexp)
 		}
-		storeName := matches[1]
-
-		kp := merkle.KeyPath{}
-		kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-		kp = kp.AppendKey(key, merkle.KeyEncodingURL)
-		return kp, nil
+		return merkle.StoreKeyPath(matches[1], key), nil
 	}
 }
 
@@ -164,25 +180,23 @@ func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data cmt
 		return nil, err
 	}
 
+	// Build a Merkle key path from path and resp.Key.
+	if c.keyPathFn == nil {
+		return nil, errors.New("please configure Client with KeyPathFn option")
+	}
+	kp, err := c.keyPathFn(path, resp.Key)
+	if err != nil {
+		return nil, fmt.Errorf("can't build merkle key path: %w", err)
+	}
+
 	// Validate the value proof against the trusted header.
 	if resp.Value != nil {
-		// 1) build a Merkle key path from path and resp.Key
-		if c.keyPathFn == nil {
-			return nil, errors.New("please configure Client with KeyPathFn option")
-		}
-
-		kp, err := c.keyPathFn(path, resp.Key)
-		if err != nil {
-			return nil, fmt.Errorf("can't build merkle key path: %w", err)
-		}
-
-		// 2) verify value
 		err = c.prt.VerifyValue(resp.ProofOps, l.AppHash, kp.String(), resp.Value)
 		if err != nil {
 			return nil, fmt.Errorf("verify value proof: %w", err)
 		}
 	} else { // OR validate the absence proof against the trusted header.
-		err = c.prt.VerifyAbsence(resp.ProofOps, l.AppHash, string(resp.Key))
+		err = c.prt.VerifyAbsence(resp.ProofOps, l.AppHash, kp.String())
 		if err != nil {
 			return nil, fmt.Errorf("verify absence proof: %w", err)
 		}
@@ -219,6 +233,10 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+func (c *Client) NetworkMap(ctx context.Context) (*ctypes.ResultNetworkMap, error) {
+	return c.next.NetworkMap(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
@@ -540,24 +558,105 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
+// DataCommitment calls rpcclient#DataCommitment method and then verifies the
+// data commitment by recomputing it from the data roots of the verified headers
+// in the end exclusive range of heights defined by `start` and `end`.
 func (c *Client) DataCommitment(
 	ctx context.Context,
 	start uint64,
 	end uint64,
 ) (*ctypes.ResultDataCommitment, error) {
-	return c.next.DataCommitment(ctx, start, end)
+	res, err := c.next.DataCommitment(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	tuples, err := c.verifiedDataRootTuples(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if root := merkle.HashFromByteSlices(tuples); !bytes.Equal(root, res.DataCommitment) {
+		return nil, fmt.Errorf("data commitment %X does not match the verified data commitment %X",
+			res.DataCommitment, root)
+	}
+	return res, nil
 }
 
 // DataRootInclusionProof calls rpcclient#DataRootInclusionProof method and returns
 // a merkle proof for the data root of block height `height` to the set of blocks
-// defined by `start` and `end`.
+// defined by `start` and `end`. The proof is verified against the data commitment
+// recomputed from the data roots of the verified headers in that range.
 func (c *Client) DataRootInclusionProof(
 	ctx context.Context,
 	height uint64,
 	start uint64,
 	end uint64,
 ) (*ctypes.ResultDataRootInclusionProof, error) {
-	return c.next.DataRootInclusionProof(ctx, height, start, end)
+	res, err := c.next.DataRootInclusionProof(ctx, height, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if height < start || height >= end {
+		return nil, fmt.Errorf("height %d is not in the end exclusive range %d-%d", height, start, end)
+	}
+	tuples, err := c.verifiedDataRootTuples(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	root := merkle.HashFromByteSlices(tuples)
+	if err := res.Proof.Verify(root, tuples[height-start]); err != nil {
+		return nil, fmt.Errorf("invalid data root inclusion proof: %w", err)
+	}
+	return res, nil
+}
+
+// verifiedDataRootTuples returns the encoded data root tuples of the end exclusive
+// range of heights defined by `start` and `end`, using the data roots of headers
+// verified by the light client.
+func (c *Client) verifiedDataRootTuples(ctx context.Context, start, end uint64) ([][]byte, error) {
+	if start == 0 || start >= end {
+		return nil, fmt.Errorf("invalid range of heights %d-%d", start, end)
+	}
+	if end-start > maxDataCommitmentBlocks {
+		return nil, fmt.Errorf("range of heights %d-%d exceeds the limit of %d blocks",
+			start, end, maxDataCommitmentBlocks)
+	}
+
+	// The headers are verified at once, rather than updating the light client
+	// to every height of the range.
+	it, err := c.lc.VerifyRange(ctx, int64(start), int64(end-1), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the range of heights %d-%d: %w", start, end, err)
+	}
+	tuples := make([][]byte, 0, end-start)
+	for height := start; it.Next(); height++ {
+		l := it.LightBlock()
+		if l.Height != int64(height) {
+			return nil, fmt.Errorf("expected verified header at height %d, got %d", height, l.Height)
+		}
+		if len(l.DataHash) != 32 {
+			return nil, fmt.Errorf("invalid data root %X at height %d", l.DataHash, height)
+		}
+		tuples = append(tuples, encodeDataRootTuple(height, l.DataHash))
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	if uint64(len(tuples)) != end-start {
+		return nil, fmt.Errorf("only verified the heights %d-%d of the range %d-%d",
+			start, start+uint64(len(tuples)), start, end)
+	}
+	return tuples, nil
+}
+
+// encodeDataRootTuple encodes a height and a data root like
+// rpc/core.EncodeDataRootTuple: the height padded to 32 bytes, followed by the
+// data root.
+func encodeDataRootTuple(height uint64, dataRoot []byte) []byte {
+	tuple := make([]byte, 32, 64)
+	binary.BigEndian.PutUint64(tuple[24:], height)
+	return append(tuple, dataRoot...)
 }
 
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
@@ -601,7 +700,83 @@ func (c *Client) ProveShares(
 	endShare uint64,
 ) (*ctypes.ResultShareProof, error) {
 	res, err := c.next.ProveShares(ctx, height, startShare, endShare)
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if height == 0 {
+		return nil, errNegOrZeroHeight
+	}
+	if endShare <= startShare || uint64(len(res.Proof.Data)) != endShare-startShare {
+		return nil, fmt.Errorf("expected %d shares, got %d", endShare-startShare, len(res.Proof.Data))
+	}
+
+	// Update the light client if we're behind.
+	h := int64(height)
+	l, err := c.updateLightClientIfNeededTo(ctx, &h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the proof is correctly constructed, and proves the requested
+	// shares.
+	if err := res.Proof.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateShareProofRange(res.Proof, startShare, endShare); err != nil {
+		return nil, err
+	}
+
+	// Verify the proof
+	if !res.Proof.VerifyProof(l.DataHash) {
+		return nil, fmt.Errorf("invalid shares proof")
+	}
+	return res, nil
+}
+
+// validateShareProofRange checks that the proof is for the shares in the range
+// [startShare, endShare) of the original data square, and not for other shares
+// of the block. The width of the square is derived from the number of leaves
+// of the data root, which commits to the row and column roots of the extended
+// square, i.e. to 4 * width roots.
+func validateShareProofRange(proof types.ShareProof, startShare, endShare uint64) error {
+	rowProof := proof.RowProof
+	if len(rowProof.Proofs) == 0 || len(proof.ShareProofs) != len(rowProof.Proofs) {
+		return fmt.Errorf("expected one row proof per share proof, got %d row proofs and %d share proofs",
+			len(rowProof.Proofs), len(proof.ShareProofs))
+	}
+	total := rowProof.Proofs[0].Total
+	if total <= 0 || total%4 != 0 {
+		return fmt.Errorf("invalid number of data root leaves %d", total)
+	}
+	width := uint64(total / 4)
+
+	startRow, endRow := startShare/width, (endShare-1)/width
+	if uint64(rowProof.StartRow) != startRow || uint64(rowProof.EndRow) != endRow {
+		return fmt.Errorf("expected rows %d to %d, got %d to %d", startRow, endRow, rowProof.StartRow, rowProof.EndRow)
+	}
+
+	for i, p := range rowProof.Proofs {
+		row := startRow + uint64(i)
+		if p.Total != total || p.Index < 0 || uint64(p.Index) != row {
+			return fmt.Errorf("expected row proof %d to be for row %d of %d, got row %d of %d",
+				i, row, total, p.Index, p.Total)
+		}
+
+		start, end := uint64(0), width
+		if row == startRow {
+			start = startShare % width
+		}
+		if row == endRow {
+			end = (endShare-1)%width + 1
+		}
+		sp := proof.ShareProofs[i]
+		if sp == nil || sp.Start < 0 || uint64(sp.Start) != start || uint64(sp.End) != end {
+			return fmt.Errorf("expected share proof %d to be for shares %d to %d of row %d", i, start, end, row)
+		}
+	}
+	return nil
 }
 
 func (c *Client) TxSearch(
@@ -658,9 +833,37 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+// Subscribe calls rpcclient#Subscribe method and then verifies the events
+// before passing them on, in the order they were received. Events failing
+// verification are dropped and logged. See verifyEvent for the events that are
+// verified. The events are passed on until ctx is done, after which out is
+// closed.
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
-	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
+	in, err := c.next.Subscribe(ctx, subscriber, query, outCapacity...)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := 1
+	if len(outCapacity) > 0 {
+		capacity = outCapacity[0]
+	}
+	verified := make(chan ctypes.ResultEvent, capacity)
+	go func() {
+		defer close(verified)
+		c.verifyEvents(ctx, in, func(resultEvent ctypes.ResultEvent) bool {
+			select {
+			case verified <- resultEvent:
+				return true
+			case <-ctx.Done():
+				return false
+			case <-c.Quit():
+				return false
+			}
+		})
+	}()
+	return verified, nil
 }
 
 func (c *Client) Unsubscribe(ctx context.Context, subscriber, query string) error {
@@ -687,35 +890,33 @@ func (c *Client) updateLightClientIfNeededTo(ctx context.Context, height *int64)
 	return l, nil
 }
 
+// RegisterOpDecoder registers the decoder of the proof ops of the type,
+// replacing the built-in decoder of the type if any (see
+// merkle.DefaultProofRuntime).
 func (c *Client) RegisterOpDecoder(typ string, dec merkle.OpDecoder) {
 	c.prt.RegisterOpDecoder(typ, dec)
 }
 
 // SubscribeWS subscribes for events using the given query and remote address as
-// a subscriber, but does not verify responses (UNSAFE)!
-// TODO: verify data
+// a subscriber. Events are verified before being written to the websocket, in
+// the order they were received, and dropped and logged if they fail
+// verification. See verifyEvent for the events that are verified, the others
+// are passed on without verification (UNSAFE)! The events are written until the
+// websocket connection is closed.
 func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
 	out, err := c.next.Subscribe(context.Background(), ctx.RemoteAddr(), query)
 	if err != nil {
 		return nil, err
 	}
 
-	go func() {
-		for {
-			select {
-			case resultEvent := <-out:
-				// We should have a switch here that performs a validation
-				// depending on the event's type.
-				ctx.WSConn.TryWriteRPCResponse(
-					rpctypes.NewRPCSuccessResponse(
-						rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
-						resultEvent,
-					))
-			case <-c.Quit():
-				return
-			}
-		}
-	}()
+	go c.verifyEvents(ctx.Context(), out, func(resultEvent ctypes.ResultEvent) bool {
+		ctx.WSConn.TryWriteRPCResponse(
+			rpctypes.NewRPCSuccessResponse(
+				rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
+				resultEvent,
+			))
+		return true
+	})
 
 	return &ctypes.ResultSubscribe{}, nil
 }
@@ -740,6 +941,208 @@ func (c *Client) UnsubscribeAllWS(ctx *rpctypes.Context) (*ctypes.ResultUnsubscr
 	return &ctypes.ResultUnsubscribe{}, nil
 }
 
+// verifyEvents verifies the events received on in, and calls forward with the
+// ones passing verification in the order they were received. Up to
+// maxConcurrentEventVerifications events are verified concurrently, so that an
+// event which is slow to verify, e.g. a tx event waiting for the tx to be
+// indexed, doesn't hold up the verification of the following events. It
+// returns once in is closed, ctx is done, the client is stopped or forward
+// returns false.
+func (c *Client) verifyEvents(
+	ctx context.Context,
+	in <-chan ctypes.ResultEvent,
+	forward func(ctypes.ResultEvent) bool,
+) {
+	type pendingEvent struct {
+		resultEvent ctypes.ResultEvent
+		verified    chan bool
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The events being verified, in the order they were received. The event
+	// waited for by the loop below is no longer buffered, hence the -1.
+	pending := make(chan pendingEvent, maxConcurrentEventVerifications-1)
+	go func() {
+		defer close(pending)
+		for {
+			select {
+			case resultEvent, ok := <-in:
+				if !ok {
+					return
+				}
+				p := pendingEvent{resultEvent: resultEvent, verified: make(chan bool, 1)}
+				select {
+				case pending <- p:
+				case <-ctx.Done():
+					return
+				case <-c.Quit():
+					return
+				}
+				go func() {
+					p.verified <- c.verifyEventOrLog(ctx, p.resultEvent)
+				}()
+			case <-ctx.Done():
+				return
+			case <-c.Quit():
+				return
+			}
+		}
+	}()
+
+	for p := range pending {
+		select {
+		case ok := <-p.verified:
+			if ok && !forward(p.resultEvent) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// verifyEventOrLog verifies an event, logging it if it fails verification.
+func (c *Client) verifyEventOrLog(ctx context.Context, resultEvent ctypes.ResultEvent) bool {
+	ctx, cancel := context.WithTimeout(ctx, eventVerificationTimeout)
+	defer cancel()
+	if err := c.verifyEvent(ctx, resultEvent); err != nil {
+		c.Logger.Error("Dropping event that failed verification", "query", resultEvent.Query,
+			"type", fmt.Sprintf("%T", resultEvent.Data), "err", err)
+		return false
+	}
+	return true
+}
+
+// verifyEvent verifies the data of an event against the light client:
+//   - NewBlock and NewBlockHeader events must match the verified header at
+//     their height, and the block of a NewBlock event must be valid (e.g.
+//     match the last commit and evidence hashes of its header).
+//   - Tx events must be included in the block at their height, as proven by a
+//     share proof against the verified data hash. This requires the primary to
+//     index transactions. As the event may be received before the tx is
+//     indexed, the proof is requested again with backoff until ctx is done.
+//   - The result of the tx of a Tx event must match the block results at its
+//     height, as verified against the LastResultsHash of the following header,
+//     which is waited for likewise. Only the code, data, gas wanted and gas used
+//     of the result are covered by the LastResultsHash, so its log, info and
+//     events remain unverified.
+//
+// Other events are not verified.
+func (c *Client) verifyEvent(ctx context.Context, resultEvent ctypes.ResultEvent) error {
+	switch data := resultEvent.Data.(type) {
+	case types.EventDataNewBlock:
+		if data.Block == nil {
+			return errors.New("missing block")
+		}
+		if err := data.Block.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid block: %w", err)
+		}
+		return c.verifyHeader(ctx, &data.Block.Header)
+
+	case types.EventDataNewBlockHeader:
+		return c.verifyHeader(ctx, &data.Header)
+
+	case types.EventDataTx:
+		tx := types.Tx(data.Tx)
+		res, err := c.proveEventTx(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to prove tx: %w", err)
+		}
+		if res.Height != data.Height || res.Index != data.Index || !bytes.Equal(res.Tx, tx) {
+			return fmt.Errorf("tx %X at height %d index %d does not match the proven tx at height %d index %d",
+				tx.Hash(), data.Height, data.Index, res.Height, res.Index)
+		}
+		return c.verifyEventTxResult(ctx, data)
+
+	default:
+		return nil
+	}
+}
+
+// proveEventTx requests and verifies the proof of the tx of an event, retrying
+// until ctx is done. It returns the last error if the tx couldn't be proven.
+func (c *Client) proveEventTx(ctx context.Context, tx types.Tx) (*ctypes.ResultTx, error) {
+	var res *ctypes.ResultTx
+	err := retryEventRequest(ctx, func() (err error) {
+		res, err = c.Tx(ctx, tx.Hash(), true)
+		return err
+	})
+	return res, err
+}
+
+// verifyEventTxResult verifies the deterministic fields of the result of the tx
+// of an event against the verified block results at its height, retrying until
+// ctx is done, as the results can only be verified once the following block is
+// committed.
+func (c *Client) verifyEventTxResult(ctx context.Context, data types.EventDataTx) error {
+	var res *ctypes.ResultBlockResults
+	err := retryEventRequest(ctx, func() (err error) {
+		res, err = c.BlockResults(ctx, &data.Height)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify the tx result: %w", err)
+	}
+	if int(data.Index) >= len(res.TxsResults) {
+		return fmt.Errorf("tx index %d is out of range of the %d tx results at height %d",
+			data.Index, len(res.TxsResults), data.Height)
+	}
+
+	verified, err := types.NewResults(res.TxsResults[data.Index : data.Index+1])[0].Marshal()
+	if err != nil {
+		return err
+	}
+	result, err := types.NewResults([]*abci.ResponseDeliverTx{&data.Result})[0].Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(verified, result) {
+		return fmt.Errorf("tx result at height %d index %d does not match the verified result",
+			data.Height, data.Index)
+	}
+	return nil
+}
+
+// retryEventRequest calls fn until it succeeds, with exponential backoff, or
+// ctx is done, in which case it returns the last error of fn.
+func retryEventRequest(ctx context.Context, fn func() error) error {
+	interval := txEventRetryInterval
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+		interval = time.Duration(cmtmath.MinInt64(int64(2*interval), int64(maxTxEventRetryInterval)))
+	}
+}
+
+// verifyHeader verifies that a header matches the verified header at its
+// height.
+func (c *Client) verifyHeader(ctx context.Context, header *types.Header) error {
+	if header.Height <= 0 {
+		return errNegOrZeroHeight
+	}
+	l, err := c.updateLightClientIfNeededTo(ctx, &header.Height)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(header.Hash(), l.Hash()) {
+		return fmt.Errorf("header %X does not match the verified header %X at height %d",
+			header.Hash(), l.Hash(), header.Height)
+	}
+	return nil
+}
+
 // XXX: Copied from rpc/core/env.go
 const (
 	// see README
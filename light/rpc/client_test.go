@@ -0,0 +1,241 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/crypto/merkle"
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
+	"github.com/KYVENetwork/celestia-core/light"
+	lcmock "github.com/KYVENetwork/celestia-core/light/rpc/mocks"
+	dbs "github.com/KYVENetwork/celestia-core/light/store/db"
+	cmtproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
+	cmtversion "github.com/KYVENetwork/celestia-core/proto/celestiacore/version"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	rpccore "github.com/KYVENetwork/celestia-core/rpc/core"
+	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
+	"github.com/KYVENetwork/celestia-core/types"
+	"github.com/KYVENetwork/celestia-core/version"
+)
+
+// dataRootClient is an RPC client returning fixed data commitments and data root inclusion proofs.
+type dataRootClient struct {
+	rpcclient.Client
+
+	dataCommitment []byte
+	proofs         []*merkle.Proof
+}
+
+func (c *dataRootClient) DataCommitment(_ context.Context, _, _ uint64) (*ctypes.ResultDataCommitment, error) {
+	return &ctypes.ResultDataCommitment{DataCommitment: c.dataCommitment}, nil
+}
+
+func (c *dataRootClient) DataRootInclusionProof(
+	_ context.Context,
+	height, start, _ uint64,
+) (*ctypes.ResultDataRootInclusionProof, error) {
+	if height-start >= uint64(len(c.proofs)) {
+		return nil, errors.New("height out of range")
+	}
+	return &ctypes.ResultDataRootInclusionProof{Proof: *c.proofs[height-start]}, nil
+}
+
+// setupDataRootClient sets up a light client with verified headers at heights 1 to 4, and an RPC
+// client returning the data commitment and proofs over the given data roots of heights 1 to 4.
+func setupDataRootClient(t *testing.T, dataRoots [][]byte) *Client {
+	trustedStore := dbs.New(dbm.NewMemDB(), "test-chain")
+	tuples := make([][]byte, 0, len(dataRoots))
+	for i, dataRoot := range dataRoots {
+		height := int64(i + 1)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: &types.Header{
+				Version:         cmtversion.Consensus{Block: version.BlockProtocol},
+				ChainID:         "test-chain",
+				Height:          height,
+				DataHash:        tmhash.Sum([]byte{byte(height)}),
+				ProposerAddress: tmhash.SumTruncated([]byte{1}),
+			}},
+		})
+		require.NoError(t, err)
+
+		tuple, err := rpccore.EncodeDataRootTuple(uint64(height), *(*[32]byte)(dataRoot))
+		require.NoError(t, err)
+		tuples = append(tuples, tuple)
+	}
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, from, to int64, _ time.Time) *light.LightBlockIterator {
+			return light.NewLightBlockIterator(trustedStore, from, to)
+		}, nil)
+
+	root, proofs := merkle.ProofsFromByteSlices(tuples)
+	return NewClient(&dataRootClient{dataCommitment: root, proofs: proofs}, lc)
+}
+
+func TestClient_DataCommitment(t *testing.T) {
+	dataRoots := [][]byte{
+		tmhash.Sum([]byte{1}), tmhash.Sum([]byte{2}), tmhash.Sum([]byte{3}), tmhash.Sum([]byte{4}),
+	}
+	c := setupDataRootClient(t, dataRoots)
+	_, err := c.DataCommitment(context.Background(), 1, 5)
+	require.NoError(t, err)
+	_, err = c.DataCommitment(context.Background(), 0, 5)
+	assert.Error(t, err)
+	// heights which couldn't be verified are rejected
+	_, err = c.DataCommitment(context.Background(), 1, 6)
+	assert.Error(t, err)
+
+	// a data commitment over a data root that doesn't match the verified header is rejected
+	dataRoots[2] = tmhash.Sum([]byte{5})
+	c = setupDataRootClient(t, dataRoots)
+	_, err = c.DataCommitment(context.Background(), 1, 5)
+	assert.Error(t, err)
+}
+
+func TestClient_DataRootInclusionProof(t *testing.T) {
+	dataRoots := [][]byte{
+		tmhash.Sum([]byte{1}), tmhash.Sum([]byte{2}), tmhash.Sum([]byte{3}), tmhash.Sum([]byte{4}),
+	}
+	c := setupDataRootClient(t, dataRoots)
+	for height := uint64(1); height < 5; height++ {
+		_, err := c.DataRootInclusionProof(context.Background(), height, 1, 5)
+		require.NoError(t, err)
+	}
+	_, err := c.DataRootInclusionProof(context.Background(), 5, 1, 5)
+	assert.Error(t, err)
+
+	// proofs of data roots that don't match the verified headers are rejected
+	dataRoots[2] = tmhash.Sum([]byte{5})
+	c = setupDataRootClient(t, dataRoots)
+	_, err = c.DataRootInclusionProof(context.Background(), 3, 1, 5)
+	assert.Error(t, err)
+	_, err = c.DataRootInclusionProof(context.Background(), 1, 1, 5)
+	assert.Error(t, err)
+}
+
+// eventClient is an RPC client sending the given events to subscribers, and
+// closing the subscription afterwards unless keepOpen is set.
+type eventClient struct {
+	rpcclient.Client
+
+	events   []ctypes.ResultEvent
+	keepOpen bool
+}
+
+func (c *eventClient) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	out := make(chan ctypes.ResultEvent, len(c.events))
+	for _, event := range c.events {
+		out <- event
+	}
+	if !c.keepOpen {
+		close(out)
+	}
+	return out, nil
+}
+
+func TestValidateShareProofRange(t *testing.T) {
+	// shares 2 to 10 of a square of width 4 span the rows 0 to 2
+	newProof := func() types.ShareProof {
+		return types.ShareProof{
+			ShareProofs: []*cmtproto.NMTProof{{Start: 2, End: 4}, {Start: 0, End: 4}, {Start: 0, End: 2}},
+			RowProof: types.RowProof{
+				Proofs:   []*merkle.Proof{{Total: 16, Index: 0}, {Total: 16, Index: 1}, {Total: 16, Index: 2}},
+				StartRow: 0,
+				EndRow:   2,
+			},
+		}
+	}
+	require.NoError(t, validateShareProofRange(newProof(), 2, 10))
+
+	testCases := []struct {
+		name   string
+		modify func(*types.ShareProof)
+	}{
+		{"other rows", func(p *types.ShareProof) { p.RowProof.StartRow, p.RowProof.EndRow = 1, 3 }},
+		{"row proof for another row", func(p *types.ShareProof) { p.RowProof.Proofs[1].Index = 3 }},
+		{"row proof of another tree", func(p *types.ShareProof) { p.RowProof.Proofs[1].Total = 32 }},
+		{"other shares of the first row", func(p *types.ShareProof) { p.ShareProofs[0].Start = 0 }},
+		{"other shares of the last row", func(p *types.ShareProof) { p.ShareProofs[2].End = 3 }},
+		{"missing share proof", func(p *types.ShareProof) { p.ShareProofs = p.ShareProofs[:2] }},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proof := newProof()
+			tc.modify(&proof)
+			assert.Error(t, validateShareProofRange(proof, 2, 10))
+		})
+	}
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	newBlock := func() *types.Block {
+		block := types.MakeBlock(1, nil, &types.Commit{}, nil)
+		block.ChainID = "test-chain"
+		block.DataHash = tmhash.Sum([]byte{1})
+		block.ValidatorsHash = tmhash.Sum([]byte{1})
+		block.ProposerAddress = tmhash.SumTruncated([]byte{1})
+		return block
+	}
+	header := newBlock().Header
+	forged := header
+	forged.DataHash = tmhash.Sum([]byte{2})
+	forgedBlock := newBlock()
+	forgedBlock.DataHash = forged.DataHash
+	// the header matches, but not the last commit
+	invalidBlock := newBlock()
+	invalidBlock.LastCommit = &types.Commit{Signatures: []types.CommitSig{types.NewCommitSigAbsent()}}
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &header},
+	}, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).Return(nil, errors.New("no block"))
+
+	events := []ctypes.ResultEvent{
+		{Query: "valid header", Data: types.EventDataNewBlockHeader{Header: header}},
+		{Query: "forged header", Data: types.EventDataNewBlockHeader{Header: forged}},
+		{Query: "valid block", Data: types.EventDataNewBlock{Block: newBlock()}},
+		{Query: "forged block", Data: types.EventDataNewBlock{Block: forgedBlock}},
+		{Query: "invalid block", Data: types.EventDataNewBlock{Block: invalidBlock}},
+		{Query: "missing block", Data: types.EventDataNewBlock{}},
+		{Query: "unverifiable header", Data: types.EventDataNewBlockHeader{Header: types.Header{Height: 2}}},
+		{Query: "unverified event", Data: types.EventDataRoundState{Height: 1}},
+	}
+	c := NewClient(&eventClient{events: events}, lc)
+
+	out, err := c.Subscribe(context.Background(), "subscriber", "query", len(events))
+	require.NoError(t, err)
+	received := []string{}
+	for event := range out {
+		received = append(received, event.Query)
+	}
+	assert.Equal(t, []string{"valid header", "valid block", "unverified event"}, received)
+}
+
+func TestClient_SubscribeContextDone(t *testing.T) {
+	events := []ctypes.ResultEvent{{Query: "unverified event", Data: types.EventDataRoundState{Height: 1}}}
+	c := NewClient(&eventClient{events: events, keepOpen: true}, &lcmock.LightClient{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out, err := c.Subscribe(ctx, "subscriber", "query")
+	require.NoError(t, err)
+	event := <-out
+	assert.Equal(t, "unverified event", event.Query)
+
+	// out is closed once ctx is done, although the subscription is still open
+	cancel()
+	select {
+	case _, ok := <-out:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("out was not closed after the context was cancelled")
+	}
+}
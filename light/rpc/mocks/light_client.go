@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	light "github.com/KYVENetwork/celestia-core/light"
+
 	time "time"
 
 	types "github.com/KYVENetwork/celestia-core/types"
@@ -100,6 +102,29 @@ func (_m *LightClient) VerifyLightBlockAtHeight(ctx context.Context, height int6
 	return r0, r1
 }
 
+// VerifyRange provides a mock function with given fields: ctx, from, to, now
+func (_m *LightClient) VerifyRange(ctx context.Context, from int64, to int64, now time.Time) (*light.LightBlockIterator, error) {
+	ret := _m.Called(ctx, from, to, now)
+
+	var r0 *light.LightBlockIterator
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) *light.LightBlockIterator); ok {
+		r0 = rf(ctx, from, to, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*light.LightBlockIterator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Time) error); ok {
+		r1 = rf(ctx, from, to, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewLightClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -67,6 +67,39 @@ func NewHTTPClientFromTrustedStore(
 		options...)
 }
 
+// NewHTTPClientFromCheckpointBundle initiates an instance of a light client
+// using HTTP addresses for both the primary provider and witnesses, and
+// bootstraps it from a checkpoint bundle anchored at the trusted header and
+// hash.
+//
+// See all Option(s) for the additional configuration.
+// See NewClientFromCheckpointBundle.
+func NewHTTPClientFromCheckpointBundle(
+	ctx context.Context,
+	chainID string,
+	trustOptions TrustOptions,
+	bundle *CheckpointBundle,
+	primaryAddress string,
+	witnessesAddresses []string,
+	trustedStore store.Store,
+	options ...Option) (*Client, error) {
+
+	providers, err := providersFromAddresses(append(witnessesAddresses, primaryAddress), chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientFromCheckpointBundle(
+		ctx,
+		chainID,
+		trustOptions,
+		bundle,
+		providers[len(providers)-1],
+		providers[:len(providers)-1],
+		trustedStore,
+		options...)
+}
+
 func providersFromAddresses(addrs []string, chainID string) ([]provider.Provider, error) {
 	providers := make([]provider.Provider, len(addrs))
 	for idx, address := range addrs {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -23,20 +24,70 @@ type dbs struct {
 	prefix string
 
 	mtx  cmtsync.RWMutex
-	size uint16
+	size uint32
 }
 
 // New returns a Store that wraps any DB (with an optional prefix in case you
 // want to use one DB with many light clients).
+//
+// Light blocks are keyed by height, and indexed by the hash of their header.
+// Light blocks saved by versions without the hash index are indexed the first
+// time the store is opened.
 func New(db dbm.DB, prefix string) store.Store {
 
-	size := uint16(0)
+	size := uint32(0)
 	bz, err := db.Get(sizeKey)
 	if err == nil && len(bz) > 0 {
 		size = unmarshalSize(bz)
 	}
 
-	return &dbs{db: db, prefix: prefix, size: size}
+	s := &dbs{db: db, prefix: prefix, size: size}
+	if err := s.migrateHashIndex(); err != nil {
+		panic(fmt.Errorf("indexing light blocks by hash: %w", err))
+	}
+	return s
+}
+
+// migrateHashIndex indexes the light blocks saved before the hash index was
+// introduced. It is a no-op once the index is complete.
+func (s *dbs) migrateHashIndex() error {
+	indexed, err := s.db.Has(s.hashIndexedKey())
+	if err != nil || indexed {
+		return err
+	}
+
+	itr, err := s.db.Iterator(
+		s.lbKey(1),
+		append(s.lbKey(1<<63-1), byte(0x00)),
+	)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		_, height, ok := parseLbKey(itr.Key())
+		if !ok {
+			continue
+		}
+		lb, err := unmarshalLightBlock(itr.Value())
+		if err != nil {
+			return err
+		}
+		if err = b.Set(s.lbHashKey(lb.Hash()), marshalHeight(height)); err != nil {
+			return err
+		}
+	}
+	if err = itr.Error(); err != nil {
+		return err
+	}
+	if err = b.Set(s.hashIndexedKey(), []byte{1}); err != nil {
+		return err
+	}
+	return b.WriteSync()
 }
 
 // SaveLightBlock persists LightBlock to the db.
@@ -60,18 +111,35 @@ func (s *dbs) SaveLightBlock(lb *types.LightBlock) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
+	existing, err := s.LightBlock(lb.Height)
+	if err != nil && err != store.ErrLightBlockNotFound {
+		return err
+	}
+
 	b := s.db.NewBatch()
 	defer b.Close()
+	if existing != nil {
+		if err = b.Delete(s.lbHashKey(existing.Hash())); err != nil {
+			return err
+		}
+	}
 	if err = b.Set(s.lbKey(lb.Height), lbBz); err != nil {
 		return err
 	}
-	if err = b.Set(sizeKey, marshalSize(s.size+1)); err != nil {
+	if err = b.Set(s.lbHashKey(lb.Hash()), marshalHeight(lb.Height)); err != nil {
+		return err
+	}
+	size := s.size
+	if existing == nil {
+		size++
+	}
+	if err = b.Set(sizeKey, marshalSize(size)); err != nil {
 		return err
 	}
 	if err = b.WriteSync(); err != nil {
 		return err
 	}
-	s.size++
+	s.size = size
 
 	return nil
 }
@@ -88,11 +156,21 @@ func (s *dbs) DeleteLightBlock(height int64) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
+	lb, err := s.LightBlock(height)
+	if err == store.ErrLightBlockNotFound { // nothing to delete
+		return nil
+	} else if err != nil {
+		return err
+	}
+
 	b := s.db.NewBatch()
 	defer b.Close()
 	if err := b.Delete(s.lbKey(height)); err != nil {
 		return err
 	}
+	if err := b.Delete(s.lbHashKey(lb.Hash())); err != nil {
+		return err
+	}
 	if err := b.Set(sizeKey, marshalSize(s.size-1)); err != nil {
 		return err
 	}
@@ -120,18 +198,59 @@ func (s *dbs) LightBlock(height int64) (*types.LightBlock, error) {
 		return nil, store.ErrLightBlockNotFound
 	}
 
-	var lbpb cmtproto.LightBlock
-	err = lbpb.Unmarshal(bz)
+	return unmarshalLightBlock(bz)
+}
+
+// LightBlockByHash retrieves the LightBlock whose header has the given hash.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) LightBlockByHash(hash []byte) (*types.LightBlock, error) {
+	bz, err := s.db.Get(s.lbHashKey(hash))
 	if err != nil {
-		return nil, fmt.Errorf("unmarshal error: %w", err)
+		panic(err)
+	}
+	if len(bz) != 8 {
+		return nil, store.ErrLightBlockNotFound
 	}
 
-	lightBlock, err := types.LightBlockFromProto(&lbpb)
+	return s.LightBlock(unmarshalHeight(bz))
+}
+
+// IterateLightBlocks calls fn for every LightBlock with a height in the range
+// [from, to], in ascending order, until fn returns false.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) IterateLightBlocks(from, to int64, fn func(lb *types.LightBlock) bool) error {
+	if from <= 0 {
+		panic("negative or zero height")
+	}
+	if to < from {
+		panic(fmt.Sprintf("to height %d is below from height %d", to, from))
+	}
+
+	itr, err := s.db.Iterator(
+		s.lbKey(from),
+		append(s.lbKey(to), byte(0x00)),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("proto conversion error: %w", err)
+		panic(err)
 	}
+	defer itr.Close()
 
-	return lightBlock, err
+	for ; itr.Valid(); itr.Next() {
+		if _, _, ok := parseLbKey(itr.Key()); !ok {
+			continue
+		}
+		lb, err := unmarshalLightBlock(itr.Value())
+		if err != nil {
+			return err
+		}
+		if !fn(lb) {
+			return nil
+		}
+	}
+
+	return itr.Error()
 }
 
 // LastLightBlockHeight returns the last LightBlock height stored.
@@ -221,18 +340,52 @@ func (s *dbs) LightBlockBefore(height int64) (*types.LightBlock, error) {
 // left.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) Prune(size uint16) error {
-	// 1) Check how many we need to prune.
-	s.mtx.RLock()
-	sSize := s.size
-	s.mtx.RUnlock()
+func (s *dbs) Prune(size uint32) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.size <= size { // nothing to prune
+		return nil
+	}
+	numToPrune := s.size - size
+
+	return s.prune(func(_ *types.LightBlock) bool {
+		if numToPrune == 0 {
+			return false
+		}
+		numToPrune--
+		return true
+	})
+}
+
+// PruneBefore prunes header & validator set pairs with a header time before t,
+// keeping at least the last pair.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) PruneBefore(t time.Time) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
 
-	if sSize <= size { // nothing to prune
+	if s.size <= 1 { // nothing to prune
 		return nil
 	}
-	numToPrune := sSize - size
+	numToPrune := s.size - 1
+
+	return s.prune(func(lb *types.LightBlock) bool {
+		if numToPrune == 0 || !lb.Time.Before(t) {
+			return false
+		}
+		numToPrune--
+		return true
+	})
+}
 
-	// 2) Iterate over headers and perform a batch operation.
+// prune iterates over header & validator set pairs, starting from the first
+// (oldest) one, and removes them in a batch operation until fn returns false.
+//
+// s.mtx must be locked by the caller.
+func (s *dbs) prune(fn func(lb *types.LightBlock) bool) error {
+	// 1) Iterate over headers and perform a batch operation.
 	itr, err := s.db.Iterator(
 		s.lbKey(1),
 		append(s.lbKey(1<<63-1), byte(0x00)),
@@ -245,37 +398,43 @@ func (s *dbs) Prune(size uint16) error {
 	b := s.db.NewBatch()
 	defer b.Close()
 
-	pruned := 0
-	for itr.Valid() && numToPrune > 0 {
+	pruned := uint32(0)
+	for ; itr.Valid(); itr.Next() {
 		key := itr.Key()
 		_, height, ok := parseLbKey(key)
-		if ok {
-			if err = b.Delete(s.lbKey(height)); err != nil {
-				return err
-			}
+		if !ok {
+			continue
+		}
+		lb, err := unmarshalLightBlock(itr.Value())
+		if err != nil {
+			return err
+		}
+		if !fn(lb) {
+			break
+		}
+		if err = b.Delete(s.lbKey(height)); err != nil {
+			return err
+		}
+		if err = b.Delete(s.lbHashKey(lb.Hash())); err != nil {
+			return err
 		}
-		itr.Next()
-		numToPrune--
 		pruned++
 	}
 	if err = itr.Error(); err != nil {
 		return err
 	}
+	if pruned == 0 {
+		return nil
+	}
 
-	err = b.WriteSync()
-	if err != nil {
+	// 2) Update size.
+	if err = b.Set(sizeKey, marshalSize(s.size-pruned)); err != nil {
 		return err
 	}
-
-	// 3) Update size.
-	s.mtx.Lock()
-	defer s.mtx.Unlock()
-
-	s.size -= uint16(pruned)
-
-	if wErr := s.db.SetSync(sizeKey, marshalSize(s.size)); wErr != nil {
-		return fmt.Errorf("failed to persist size: %w", wErr)
+	if err = b.WriteSync(); err != nil {
+		return err
 	}
+	s.size -= pruned
 
 	return nil
 }
@@ -283,7 +442,7 @@ func (s *dbs) Prune(size uint16) error {
 // Size returns the number of header & validator set pairs.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) Size() uint16 {
+func (s *dbs) Size() uint32 {
 	s.mtx.RLock()
 	defer s.mtx.RUnlock()
 	return s.size
@@ -293,6 +452,14 @@ func (s *dbs) lbKey(height int64) []byte {
 	return []byte(fmt.Sprintf("lb/%s/%020d", s.prefix, height))
 }
 
+func (s *dbs) lbHashKey(hash []byte) []byte {
+	return []byte(fmt.Sprintf("lbh/%s/%X", s.prefix, hash))
+}
+
+func (s *dbs) hashIndexedKey() []byte {
+	return []byte(fmt.Sprintf("lbhindexed/%s", s.prefix))
+}
+
 var keyPattern = regexp.MustCompile(`^(lb)/([^/]*)/([0-9]+)$`)
 
 func parseKey(key []byte) (part string, prefix string, height int64, ok bool) {
@@ -319,12 +486,41 @@ func parseLbKey(key []byte) (prefix string, height int64, ok bool) {
 	return
 }
 
-func marshalSize(size uint16) []byte {
-	bs := make([]byte, 2)
-	binary.LittleEndian.PutUint16(bs, size)
+func unmarshalLightBlock(bz []byte) (*types.LightBlock, error) {
+	var lbpb cmtproto.LightBlock
+	err := lbpb.Unmarshal(bz)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal error: %w", err)
+	}
+
+	lightBlock, err := types.LightBlockFromProto(&lbpb)
+	if err != nil {
+		return nil, fmt.Errorf("proto conversion error: %w", err)
+	}
+
+	return lightBlock, nil
+}
+
+func marshalHeight(height int64) []byte {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, uint64(height))
+	return bs
+}
+
+func unmarshalHeight(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func marshalSize(size uint32) []byte {
+	bs := make([]byte, 4)
+	binary.LittleEndian.PutUint32(bs, size)
 	return bs
 }
 
-func unmarshalSize(bz []byte) uint16 {
-	return binary.LittleEndian.Uint16(bz)
+// unmarshalSize also accepts the 2-byte size persisted by earlier versions.
+func unmarshalSize(bz []byte) uint32 {
+	if len(bz) == 2 {
+		return uint32(binary.LittleEndian.Uint16(bz))
+	}
+	return binary.LittleEndian.Uint32(bz)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/KYVENetwork/celestia-core/crypto"
 	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
 	cmtrand "github.com/KYVENetwork/celestia-core/libs/rand"
+	"github.com/KYVENetwork/celestia-core/light/store"
 	cmtversion "github.com/KYVENetwork/celestia-core/proto/celestiacore/version"
 	"github.com/KYVENetwork/celestia-core/types"
 	"github.com/KYVENetwork/celestia-core/version"
@@ -56,7 +57,7 @@ func Test_SaveLightBlock(t *testing.T) {
 	require.NoError(t, err)
 
 	size := dbStore.Size()
-	assert.Equal(t, uint16(1), size)
+	assert.Equal(t, uint32(1), size)
 	t.Log(size)
 
 	h, err = dbStore.LightBlock(1)
@@ -91,6 +92,91 @@ func Test_LightBlockBefore(t *testing.T) {
 	}
 }
 
+func Test_LightBlockByHash(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_LightBlockByHash")
+
+	lb := randLightBlock(1)
+	_, err := dbStore.LightBlockByHash(lb.Hash())
+	require.Equal(t, store.ErrLightBlockNotFound, err)
+
+	err = dbStore.SaveLightBlock(lb)
+	require.NoError(t, err)
+
+	h, err := dbStore.LightBlockByHash(lb.Hash())
+	require.NoError(t, err)
+	assert.EqualValues(t, lb.Hash(), h.Hash())
+
+	// Overwriting the light block at the same height replaces the index entry.
+	lb2 := randLightBlock(1)
+	err = dbStore.SaveLightBlock(lb2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, dbStore.Size())
+
+	_, err = dbStore.LightBlockByHash(lb.Hash())
+	require.Equal(t, store.ErrLightBlockNotFound, err)
+	h, err = dbStore.LightBlockByHash(lb2.Hash())
+	require.NoError(t, err)
+	assert.EqualValues(t, lb2.Hash(), h.Hash())
+
+	err = dbStore.DeleteLightBlock(1)
+	require.NoError(t, err)
+	_, err = dbStore.LightBlockByHash(lb2.Hash())
+	require.Equal(t, store.ErrLightBlockNotFound, err)
+}
+
+func Test_MigrateHashIndex(t *testing.T) {
+	db := dbm.NewMemDB()
+	dbStore := New(db, "Test_MigrateHashIndex")
+	lb := randLightBlock(1)
+	require.NoError(t, dbStore.SaveLightBlock(lb))
+
+	// Light block saved by a version without the hash index.
+	require.NoError(t, db.Delete(dbStore.(*dbs).lbHashKey(lb.Hash())))
+	require.NoError(t, db.Delete(dbStore.(*dbs).hashIndexedKey()))
+
+	h, err := New(db, "Test_MigrateHashIndex").LightBlockByHash(lb.Hash())
+	require.NoError(t, err)
+	assert.EqualValues(t, lb.Hash(), h.Hash())
+}
+
+func Test_IterateLightBlocks(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_IterateLightBlocks")
+
+	assert.Panics(t, func() {
+		_ = dbStore.IterateLightBlocks(0, 1, func(*types.LightBlock) bool { return true })
+	})
+	assert.Panics(t, func() {
+		_ = dbStore.IterateLightBlocks(2, 1, func(*types.LightBlock) bool { return true })
+	})
+
+	for _, height := range []int64{1, 2, 5, 9, 10, 100} {
+		err := dbStore.SaveLightBlock(randLightBlock(height))
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		from, to int64
+		limit    int
+		heights  []int64
+	}{
+		{1, 100, 0, []int64{1, 2, 5, 9, 10, 100}},
+		{2, 9, 0, []int64{2, 5, 9}},
+		{3, 4, 0, nil},
+		{11, 1000, 0, []int64{100}},
+		{1, 100, 2, []int64{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		var heights []int64
+		err := dbStore.IterateLightBlocks(tc.from, tc.to, func(lb *types.LightBlock) bool {
+			heights = append(heights, lb.Height)
+			return tc.limit == 0 || len(heights) < tc.limit
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tc.heights, heights, "range [%d, %d]", tc.from, tc.to)
+	}
+}
+
 func Test_Prune(t *testing.T) {
 	dbStore := New(dbm.NewMemDB(), "Test_Prune")
 
@@ -128,6 +214,52 @@ func Test_Prune(t *testing.T) {
 	assert.EqualValues(t, 7, dbStore.Size())
 }
 
+func Test_PruneBefore(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_PruneBefore")
+	now := time.Now()
+
+	// Empty store
+	err := dbStore.PruneBefore(now)
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		lb := randLightBlock(int64(i))
+		lb.Time = now.Add(time.Duration(i-10) * time.Hour)
+		err = dbStore.SaveLightBlock(lb)
+		require.NoError(t, err)
+	}
+
+	err = dbStore.PruneBefore(now.Add(-5 * time.Hour))
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, dbStore.Size())
+	height, err := dbStore.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, height)
+
+	// The last light block is kept.
+	err = dbStore.PruneBefore(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, dbStore.Size())
+	height, err = dbStore.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, height)
+}
+
+func Test_Size(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	// Size persisted by earlier versions.
+	require.NoError(t, db.Set(sizeKey, []byte{0xff, 0xff}))
+	assert.EqualValues(t, 1<<16-1, New(db, "Test_Size").Size())
+
+	require.NoError(t, db.Set(sizeKey, marshalSize(1<<16-1)))
+	dbStore := New(db, "Test_Size")
+	err := dbStore.SaveLightBlock(randLightBlock(1))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1<<16, dbStore.Size())
+	assert.EqualValues(t, 1<<16, New(db, "Test_Size").Size())
+}
+
 func Test_Concurrency(t *testing.T) {
 	dbStore := New(dbm.NewMemDB(), "Test_Prune")
 
@@ -1,6 +1,10 @@
 package store
 
-import "github.com/KYVENetwork/celestia-core/types"
+import (
+	"time"
+
+	"github.com/KYVENetwork/celestia-core/types"
+)
 
 // Store is anything that can persistently store headers.
 type Store interface {
@@ -24,6 +28,17 @@ type Store interface {
 	// If LightBlock is not found, ErrLightBlockNotFound is returned.
 	LightBlock(height int64) (*types.LightBlock, error)
 
+	// LightBlockByHash returns the LightBlock whose header has the given hash.
+	//
+	// If LightBlock is not found, ErrLightBlockNotFound is returned.
+	LightBlockByHash(hash []byte) (*types.LightBlock, error)
+
+	// IterateLightBlocks calls fn for every LightBlock with a height in the
+	// range [from, to], in ascending order, until fn returns false.
+	//
+	// from must be > 0 && to must be >= from.
+	IterateLightBlocks(from, to int64, fn func(lb *types.LightBlock) bool) error
+
 	// LastLightBlockHeight returns the last (newest) LightBlock height.
 	//
 	// If the store is empty, -1 and nil error are returned.
@@ -41,8 +56,12 @@ type Store interface {
 
 	// Prune removes headers & the associated validator sets when Store reaches a
 	// defined size (number of header & validator set pairs).
-	Prune(size uint16) error
+	Prune(size uint32) error
+
+	// PruneBefore removes headers & the associated validator sets with a header
+	// time before t. The last (newest) pair is never removed.
+	PruneBefore(t time.Time) error
 
 	// Size returns a number of currently existing header & validator set pairs.
-	Size() uint16
+	Size() uint32
 }
@@ -255,6 +255,9 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			_, err = memR.mempool.TryAddNewTx(ntx, key, txInfo)
 			if err != nil && err != ErrTxInMempool {
 				memR.Logger.Debug("Could not add tx", "txKey", key, "err", err)
+				if mempool.IsPeerFault(err) {
+					memR.Switch.MarkPeerAsBad(e.Src, err)
+				}
 				return
 			}
 			if !memR.opts.ListenOnly {
@@ -274,7 +277,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		txKey, err := types.TxKeyFromBytes(msg.TxKey)
 		if err != nil {
 			memR.Logger.Error("peer sent SeenTx with incorrect tx key", "err", err)
-			memR.Switch.StopPeerForError(e.Src, err)
+			memR.Switch.StopPeerForFault(e.Src, err)
 			return
 		}
 		schema.WriteMempoolPeerState(
@@ -308,7 +311,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		txKey, err := types.TxKeyFromBytes(msg.TxKey)
 		if err != nil {
 			memR.Logger.Error("peer sent WantTx with incorrect tx key", "err", err)
-			memR.Switch.StopPeerForError(e.Src, err)
+			memR.Switch.StopPeerForFault(e.Src, err)
 			return
 		}
 		schema.WriteMempoolPeerState(
@@ -338,7 +341,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", fmt.Sprintf("%T", msg))
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", msg))
+		memR.Switch.StopPeerForFault(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", msg))
 		return
 	}
 }
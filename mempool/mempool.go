@@ -191,3 +191,12 @@ func (e ErrPreCheck) Error() string {
 func IsPreCheckError(err error) bool {
 	return errors.As(err, &ErrPreCheck{})
 }
+
+// IsPeerFault returns true if err shows that the peer which sent us the tx
+// should not have gossiped it, because it fails the pre check. Unlike
+// CheckTx, which can be non-deterministic, the pre check does not depend on
+// the state of the application. The max tx size is not a fault, since it is
+// configured locally.
+func IsPeerFault(err error) bool {
+	return IsPreCheckError(err)
+}
@@ -179,11 +179,14 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 				memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
 			} else if err != nil {
 				memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
+				if e.Src != nil && mempool.IsPeerFault(err) {
+					memR.Switch.MarkPeerAsBad(e.Src, err)
+				}
 			}
 		}
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
+		memR.Switch.StopPeerForFault(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
 		return
 	}
 
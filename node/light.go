@@ -0,0 +1,220 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cfg "github.com/KYVENetwork/celestia-core/config"
+	cs "github.com/KYVENetwork/celestia-core/consensus"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	"github.com/KYVENetwork/celestia-core/libs/service"
+	"github.com/KYVENetwork/celestia-core/light"
+	"github.com/KYVENetwork/celestia-core/light/headersync"
+	"github.com/KYVENetwork/celestia-core/light/provider"
+	httpp "github.com/KYVENetwork/celestia-core/light/provider/http"
+	dbs "github.com/KYVENetwork/celestia-core/light/store/db"
+	"github.com/KYVENetwork/celestia-core/p2p"
+	"github.com/KYVENetwork/celestia-core/p2p/pex"
+	"github.com/KYVENetwork/celestia-core/pkg/trace"
+	"github.com/KYVENetwork/celestia-core/types"
+	"github.com/KYVENetwork/celestia-core/version"
+)
+
+// newLightNode returns a new node in the light mode (see cfg.ModeLight), which
+// only runs the p2p switch with the header sync and PEX reactors, and serves
+// the verified headers over RPC. No ABCI application is needed.
+func newLightNode(config *cfg.Config,
+	nodeKey *p2p.NodeKey,
+	genesisDocProvider GenesisDocProvider,
+	dbProvider DBProvider,
+	metricsProvider MetricsProvider,
+	logger log.Logger,
+	options ...Option,
+) (*Node, error) {
+	if config.FilterPeers {
+		return nil, errors.New("filter_peers needs an ABCI application, which is not available in the light mode")
+	}
+
+	genDoc, err := genesisDocProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	lightDB, err := dbProvider(&DBContext{"light", config})
+	if err != nil {
+		return nil, err
+	}
+	lightStore := dbs.New(lightDB, genDoc.ChainID)
+
+	providers := make([]provider.Provider, 0, len(config.Light.RPCServers))
+	for _, server := range config.Light.RPCServers {
+		p, err := httpp.New(genDoc.ChainID, server)
+		if err != nil {
+			return nil, fmt.Errorf("can't create provider for %s: %w", server, err)
+		}
+		providers = append(providers, p)
+	}
+
+	lastHeight, err := lightStore.LastLightBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	// The store is bootstrapped again, if it has expired and a more recent
+	// trusted header is configured.
+	if config.Light.TrustHeight > 0 && lastHeight < config.Light.TrustHeight {
+		err := headersync.Bootstrap(context.Background(), genDoc.ChainID, lightStore, providers, light.TrustOptions{
+			Period: config.Light.TrustPeriod,
+			Height: config.Light.TrustHeight,
+			Hash:   config.Light.TrustHashBytes(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to bootstrap light store: %w", err)
+		}
+	}
+
+	headerSyncReactor, err := headersync.NewReactor(genDoc, lightStore, providers, config.Light.TrustPeriod)
+	if err != nil {
+		return nil, err
+	}
+	headerSyncReactor.SetLogger(logger.With("module", "headersync"))
+
+	_, p2pMetrics, _, _ := metricsProvider(genDoc.ChainID, version.TMCoreSemVer)
+
+	// create an optional tracer client to collect trace data.
+	tracer, err := trace.NewTracer(
+		config,
+		logger,
+		genDoc.ChainID,
+		string(nodeKey.ID()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := makeLightNodeInfo(config, nodeKey, genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup Transport.
+	peerAdmission, err := createPeerAdmission(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create peer admission: %w", err)
+	}
+	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, nil, peerAdmission, tracer)
+
+	// Setup Switch.
+	p2pLogger := logger.With("module", "p2p")
+	peerManager, trustHistoryDB, err := createPeerManager(config, dbProvider, p2pLogger)
+	if err != nil {
+		return nil, fmt.Errorf("could not create peer manager: %w", err)
+	}
+	sw := p2p.NewSwitch(
+		config.P2P,
+		transport,
+		p2p.WithMetrics(p2pMetrics),
+		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.WithTracer(tracer),
+		p2p.WithPeerManager(peerManager),
+	)
+	sw.SetLogger(p2pLogger)
+	sw.AddReactor("HEADERSYNC", headerSyncReactor)
+	sw.SetNodeInfo(nodeInfo)
+	sw.SetNodeKey(nodeKey)
+	p2pLogger.Info("P2P Node ID", "ID", nodeKey.ID(), "file", config.NodeKeyFile())
+
+	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
+	}
+
+	err = sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
+	}
+
+	addrBook, addrBookDB, err := createAddrBookAndSetOnSwitch(config, dbProvider, sw, p2pLogger, nodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not create addrbook: %w", err)
+	}
+
+	var pexReactor *pex.Reactor
+	if config.P2P.PexReactor {
+		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+	}
+
+	node := &Node{
+		config:     config,
+		genesisDoc: genDoc,
+
+		transport: transport,
+		sw:        sw,
+		addrBook:  addrBook,
+		nodeInfo:  nodeInfo,
+		nodeKey:   nodeKey,
+
+		headerSyncReactor: headerSyncReactor,
+		lightDB:           lightDB,
+		trustHistoryDB:    trustHistoryDB,
+		addrBookDB:        addrBookDB,
+		pexReactor:        pexReactor,
+		peerAdmission:     peerAdmission,
+		tracer:            tracer,
+	}
+	node.BaseService = *service.NewBaseService(logger, "Node", node)
+
+	for _, option := range options {
+		option(node)
+	}
+
+	return node, nil
+}
+
+// makeLightNodeInfo returns the node info of a light node, which only has the
+// consensus channels used by the header sync reactor.
+func makeLightNodeInfo(
+	config *cfg.Config,
+	nodeKey *p2p.NodeKey,
+	genDoc *types.GenesisDoc,
+) (p2p.DefaultNodeInfo, error) {
+	nodeInfo := p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(
+			version.P2PProtocol, // global
+			version.BlockProtocol,
+			genDoc.ConsensusParams.Version.App,
+		),
+		DefaultNodeID: nodeKey.ID(),
+		Network:       genDoc.ChainID,
+		Version:       version.TMCoreSemVer,
+		Channels: []byte{
+			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
+		},
+		Moniker: config.Moniker,
+		Other: p2p.DefaultNodeInfoOther{
+			TxIndex:    "off",
+			RPCAddress: config.RPC.ListenAddress,
+		},
+	}
+
+	if config.P2P.PexReactor {
+		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+	}
+
+	lAddr := config.P2P.ExternalAddress
+
+	if lAddr == "" {
+		lAddr = config.P2P.ListenAddress
+	}
+
+	nodeInfo.ListenAddr = lAddr
+
+	err := nodeInfo.Validate()
+	return nodeInfo, err
+}
+
+// HeaderSyncReactor returns the header sync reactor of a light node, or nil
+// in the full mode.
+func (n *Node) HeaderSyncReactor() *headersync.Reactor {
+	return n.headerSyncReactor
+}
@@ -32,6 +32,7 @@ import (
 	cmtpubsub "github.com/KYVENetwork/celestia-core/libs/pubsub"
 	"github.com/KYVENetwork/celestia-core/libs/service"
 	"github.com/KYVENetwork/celestia-core/light"
+	"github.com/KYVENetwork/celestia-core/light/headersync"
 	mempl "github.com/KYVENetwork/celestia-core/mempool"
 	mempoolv2 "github.com/KYVENetwork/celestia-core/mempool/cat"
 	mempoolv0 "github.com/KYVENetwork/celestia-core/mempool/v0"
@@ -195,6 +196,15 @@ func StateProvider(stateProvider statesync.StateProvider) Option {
 
 //------------------------------------------------------------------------------
 
+// p2pTransport is the transport of a node, either a p2p.MultiplexTransport or
+// a p2p.QUICTransport.
+type p2pTransport interface {
+	p2p.Transport
+	Listen(p2p.NetAddress) error
+	Close() error
+	AddChannel(chID byte)
+}
+
 // Node is the highest level interface to a full CometBFT node.
 // It includes all configuration information and running services.
 type Node struct {
@@ -206,7 +216,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -227,6 +237,7 @@ type Node struct {
 	consensusState    *cs.State               // latest consensus state
 	consensusReactor  *cs.Reactor             // for participating in the consensus
 	pexReactor        *pex.Reactor            // for exchanging peer addresses
+	peerAdmission     *p2p.PeerAdmission      // for admitting peers
 	evidencePool      *evidence.Pool          // tracking evidence
 	proxyApp          proxy.AppConns          // connection to the application
 	rpcListeners      []net.Listener          // rpc servers
@@ -235,6 +246,10 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 	tracer            trace.Tracer
+	headerSyncReactor *headersync.Reactor // for following the headers in the light mode
+	lightDB           dbm.DB              // verified headers in the light mode
+	trustHistoryDB    dbm.DB              // trust history of peers
+	addrBookDB        dbm.DB              // known peers, if the address book is kept in a db
 	pyroscopeProfiler *pyroscope.Profiler
 	pyroscopeTracer   *sdktrace.TracerProvider
 }
@@ -548,9 +563,10 @@ func createTransport(
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	peerAdmission *p2p.PeerAdmission,
 	tracer trace.Tracer,
 ) (
-	*p2p.MultiplexTransport,
+	p2pTransport,
 	[]p2p.PeerFilterFunc,
 ) {
 	var (
@@ -604,15 +620,31 @@ func createTransport(
 		)
 	}
 
+	// Admit peers by the allow and deny lists.
+	connFilters = append(connFilters, peerAdmission.ConnFilter())
+	peerFilters = append(peerFilters, peerAdmission.PeerFilter())
+
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
 
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
 	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
+	if config.P2P.Transport == cfg.P2PTransportQUIC {
+		return p2p.NewQUICTransport(transport), peerFilters
+	}
 	return transport, peerFilters
 }
 
+func createPeerAdmission(config *cfg.Config) (*p2p.PeerAdmission, error) {
+	return p2p.NewPeerAdmission(p2p.AdmissionLists{
+		AllowIDs:   splitAndTrimEmpty(config.P2P.AdmissionAllowIDs, ",", " "),
+		DenyIDs:    splitAndTrimEmpty(config.P2P.AdmissionDenyIDs, ",", " "),
+		AllowCIDRs: splitAndTrimEmpty(config.P2P.AdmissionAllowCIDRs, ",", " "),
+		DenyCIDRs:  splitAndTrimEmpty(config.P2P.AdmissionDenyCIDRs, ",", " "),
+	})
+}
+
 func createSwitch(config *cfg.Config,
 	transport p2p.Transport,
 	p2pMetrics *p2p.Metrics,
@@ -624,6 +656,7 @@ func createSwitch(config *cfg.Config,
 	evidenceReactor *evidence.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
+	peerManager *p2p.PeerManager,
 	p2pLogger log.Logger,
 	tracer trace.Tracer,
 ) *p2p.Switch {
@@ -633,6 +666,7 @@ func createSwitch(config *cfg.Config,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
 		p2p.WithTracer(tracer),
+		p2p.WithPeerManager(peerManager),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
@@ -648,31 +682,59 @@ func createSwitch(config *cfg.Config,
 	return sw
 }
 
-func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
+func createPeerManager(
+	config *cfg.Config,
+	dbProvider DBProvider,
+	p2pLogger log.Logger,
+) (*p2p.PeerManager, dbm.DB, error) {
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, nil, err
+	}
+	peerManager := p2p.NewPeerManager(trustHistoryDB, config.P2P.PeerBanDuration)
+	peerManager.SetLogger(p2pLogger)
+	return peerManager, trustHistoryDB, nil
+}
+
+func createAddrBookAndSetOnSwitch(config *cfg.Config, dbProvider DBProvider, sw *p2p.Switch,
 	p2pLogger log.Logger, nodeKey *p2p.NodeKey,
-) (pex.AddrBook, error) {
-	addrBook := pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
-	addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+) (pex.AddrBook, dbm.DB, error) {
+	var (
+		addrBook   pex.AddrBook
+		addrBookDB dbm.DB
+		err        error
+	)
+	if config.P2P.AddrBookBackend == cfg.AddrBookBackendDB {
+		addrBookDB, err = dbProvider(&DBContext{"addrbook", config})
+		if err != nil {
+			return nil, nil, err
+		}
+		addrBook = pex.NewDBAddrBook(addrBookDB, config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+		addrBook.SetLogger(p2pLogger.With("book", "addrbook.db"))
+	} else {
+		addrBook = pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+		addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+	}
 
 	// Add ourselves to addrbook to prevent dialing ourselves
 	if config.P2P.ExternalAddress != "" {
 		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ExternalAddress))
 		if err != nil {
-			return nil, fmt.Errorf("p2p.external_address is incorrect: %w", err)
+			return nil, nil, fmt.Errorf("p2p.external_address is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
 	if config.P2P.ListenAddress != "" {
 		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ListenAddress))
 		if err != nil {
-			return nil, fmt.Errorf("p2p.laddr is incorrect: %w", err)
+			return nil, nil, fmt.Errorf("p2p.laddr is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
 
 	sw.SetAddrBook(addrBook)
 
-	return addrBook, nil
+	return addrBook, addrBookDB, nil
 }
 
 func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
@@ -690,6 +752,7 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 			// https://github.com/KYVENetwork/celestia-core/issues/3523
 			SeedDisconnectWaitPeriod:     28 * time.Hour,
 			PersistentPeersMaxDialPeriod: config.P2P.PersistentPeersMaxDialPeriod,
+			NetworkMapRetention:          config.P2P.NetworkMapRetention,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
@@ -710,7 +773,7 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 		stateProvider, err = statesync.NewLightClientStateProvider(
 			ctx,
 			state.ChainID, state.Version, state.InitialHeight,
-			config.RPCServers, light.TrustOptions{
+			config.RPCServers, config.GRPCServers, light.TrustOptions{
 				Period: config.TrustPeriod,
 				Height: config.TrustHeight,
 				Hash:   config.TrustHashBytes(),
@@ -721,7 +784,7 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 	}
 
 	go func() {
-		state, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
+		state, commit, nodeSnapshot, err := ssR.Sync(stateProvider, config.DiscoveryTime)
 		if err != nil {
 			ssR.Logger.Error("State sync failed", "err", err)
 			return
@@ -736,6 +799,20 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 			ssR.Logger.Error("Failed to store last seen commit", "err", err)
 			return
 		}
+		if nodeSnapshot != nil {
+			err = blockStore.Bootstrap(nodeSnapshot.BlockMetas, nodeSnapshot.Commits)
+			if err != nil {
+				ssR.Logger.Error("Failed to bootstrap block store with node snapshot", "err", err)
+				return
+			}
+			for i, vals := range nodeSnapshot.ValidatorSets {
+				height := nodeSnapshot.BlockMetas[i].Header.Height
+				if err = stateStore.SaveValidatorSets(height, height, vals); err != nil {
+					ssR.Logger.Error("Failed to store validator sets of node snapshot", "err", err)
+					return
+				}
+			}
+		}
 
 		if fastSync {
 			// FIXME Very ugly to have these metrics bleed through here.
@@ -768,6 +845,10 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
+	if config.Mode == cfg.ModeLight {
+		return newLightNode(config, nodeKey, genesisDocProvider, dbProvider, metricsProvider, logger, options...)
+	}
+
 	blockStore, stateDB, err := initDBs(config, dbProvider)
 	if err != nil {
 		return nil, err
@@ -916,6 +997,7 @@ func NewNode(config *cfg.Config,
 		proxyApp.Query(),
 		config.StateSync.TempDir,
 	)
+	stateSyncReactor.SetStores(stateStore, blockStore)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state, softwareVersion)
@@ -924,13 +1006,21 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp, tracer)
+	peerAdmission, err := createPeerAdmission(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create peer admission: %w", err)
+	}
+	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp, peerAdmission, tracer)
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
+	peerManager, trustHistoryDB, err := createPeerManager(config, dbProvider, p2pLogger)
+	if err != nil {
+		return nil, fmt.Errorf("could not create peer manager: %w", err)
+	}
 	sw := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger, tracer,
+		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, peerManager, p2pLogger, tracer,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -943,7 +1033,7 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
 
-	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	addrBook, addrBookDB, err := createAddrBookAndSetOnSwitch(config, dbProvider, sw, p2pLogger, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
 	}
@@ -995,6 +1085,7 @@ func NewNode(config *cfg.Config,
 		stateSync:        stateSync,
 		stateSyncGenesis: state, // Shouldn't be necessary, but need a way to pass the genesis state
 		pexReactor:       pexReactor,
+		peerAdmission:    peerAdmission,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
@@ -1002,6 +1093,8 @@ func NewNode(config *cfg.Config,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
 		tracer:           tracer,
+		trustHistoryDB:   trustHistoryDB,
+		addrBookDB:       addrBookDB,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -1097,11 +1190,15 @@ func (n *Node) OnStop() {
 	n.Logger.Info("Stopping Node")
 
 	// first stop the non-reactor services
-	if err := n.eventBus.Stop(); err != nil {
-		n.Logger.Error("Error closing eventBus", "err", err)
+	if n.eventBus != nil {
+		if err := n.eventBus.Stop(); err != nil {
+			n.Logger.Error("Error closing eventBus", "err", err)
+		}
 	}
-	if err := n.indexerService.Stop(); err != nil {
-		n.Logger.Error("Error closing indexerService", "err", err)
+	if n.indexerService != nil {
+		if err := n.indexerService.Stop(); err != nil {
+			n.Logger.Error("Error closing indexerService", "err", err)
+		}
 	}
 
 	// now stop the reactors
@@ -1148,6 +1245,26 @@ func (n *Node) OnStop() {
 		}
 	}
 
+	if n.lightDB != nil {
+		if err := n.lightDB.Close(); err != nil {
+			n.Logger.Error("problem closing light store", "err", err)
+		}
+	}
+
+	if n.trustHistoryDB != nil {
+		if err := n.trustHistoryDB.Close(); err != nil {
+			n.Logger.Error("problem closing trust history", "err", err)
+		}
+	}
+
+	// the address book is saved when the pex reactor is stopped by the switch
+	if n.addrBookDB != nil {
+		n.addrBook.Wait()
+		if err := n.addrBookDB.Close(); err != nil {
+			n.Logger.Error("problem closing address book", "err", err)
+		}
+	}
+
 	if n.tracer != nil {
 		n.tracer.Stop()
 	}
@@ -1172,7 +1289,7 @@ func (n *Node) ConfigureRPC() error {
 	if err != nil {
 		return fmt.Errorf("can't get pubkey: %w", err)
 	}
-	rpccore.SetEnvironment(&rpccore.Environment{
+	env := &rpccore.Environment{
 		ProxyAppQuery:   n.proxyApp.Query(),
 		ProxyAppMempool: n.proxyApp.Mempool(),
 
@@ -1182,6 +1299,7 @@ func (n *Node) ConfigureRPC() error {
 		ConsensusState: n.consensusState,
 		P2PPeers:       n.sw,
 		P2PTransport:   n,
+		P2PAdmission:   n.peerAdmission,
 
 		PubKey:           pubKey,
 		GenDoc:           n.genesisDoc,
@@ -1194,23 +1312,33 @@ func (n *Node) ConfigureRPC() error {
 		Logger: n.Logger.With("module", "rpc"),
 
 		Config: *n.config.RPC,
-	})
+	}
+	if n.config.P2P.SeedMode && n.pexReactor != nil {
+		env.P2PCrawler = n.pexReactor
+	}
+	rpccore.SetEnvironment(env)
 
 	return rpccore.InitGenesisChunks()
 }
 
 func (n *Node) startRPC() ([]net.Listener, error) {
-	err := n.ConfigureRPC()
-	if err != nil {
-		return nil, err
+	routes := rpccore.Routes
+	if n.headerSyncReactor != nil {
+		// In the light mode, only the verified headers are served.
+		routes = headersync.RPCRoutes(n.headerSyncReactor, n.nodeInfo)
+	} else {
+		err := n.ConfigureRPC()
+		if err != nil {
+			return nil, err
+		}
+
+		if n.config.RPC.Unsafe {
+			rpccore.AddUnsafeRoutes()
+		}
 	}
 
 	listenAddrs := splitAndTrimEmpty(n.config.RPC.ListenAddress, ",", " ")
 
-	if n.config.RPC.Unsafe {
-		rpccore.AddUnsafeRoutes()
-	}
-
 	config := rpcserver.DefaultConfig()
 	config.MaxBodyBytes = n.config.RPC.MaxBodyBytes
 	config.MaxHeaderBytes = n.config.RPC.MaxHeaderBytes
@@ -1228,8 +1356,11 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		mux := http.NewServeMux()
 		rpcLogger := n.Logger.With("module", "rpc-server")
 		wmLogger := rpcLogger.With("protocol", "websocket")
-		wm := rpcserver.NewWebsocketManager(rpccore.Routes,
+		wm := rpcserver.NewWebsocketManager(routes,
 			rpcserver.OnDisconnect(func(remoteAddr string) {
+				if n.eventBus == nil {
+					return
+				}
 				err := n.eventBus.UnsubscribeAll(context.Background(), remoteAddr)
 				if err != nil && err != cmtpubsub.ErrSubscriptionNotFound {
 					wmLogger.Error("Failed to unsubscribe addr from events", "addr", remoteAddr, "err", err)
@@ -1240,7 +1371,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		)
 		wm.SetLogger(wmLogger)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
-		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, rpcLogger)
+		rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config,
@@ -1289,7 +1420,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 
 	// we expose a simplified api over grpc for convenience to app devs
 	grpcListenAddr := n.config.RPC.GRPCListenAddress
-	if grpcListenAddr != "" {
+	if grpcListenAddr != "" && n.headerSyncReactor == nil {
 		config := rpcserver.DefaultConfig()
 		config.MaxBodyBytes = n.config.RPC.MaxBodyBytes
 		config.MaxHeaderBytes = n.config.RPC.MaxHeaderBytes
@@ -78,6 +78,63 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeClosesAddrBookDB(t *testing.T) {
+	config := cfg.ResetTestRoot("node_addrbook_db_test")
+	defer os.RemoveAll(config.RootDir)
+	config.DBBackend = "goleveldb"
+	config.P2P.AddrBookBackend = cfg.AddrBookBackendDB
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	require.NoError(t, n.Stop())
+
+	// the db can only be opened again once the node has closed it
+	db, err := DefaultDBProvider(&DBContext{"addrbook", config})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
+
+func TestNodeLightMode(t *testing.T) {
+	config := cfg.ResetTestRootWithChainID("node_light_mode_test", "light-chain")
+	defer os.RemoveAll(config.RootDir)
+
+	fullNode, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, fullNode.Start())
+	defer fullNode.Stop() //nolint:errcheck // ignore for tests
+
+	// The light node has the same genesis and follows the headers of the full
+	// node, without an ABCI application.
+	lightConfig := cfg.ResetTestRootWithChainID("node_light_mode_test", "light-chain")
+	defer os.RemoveAll(lightConfig.RootDir)
+	lightConfig.Mode = cfg.ModeLight
+	// the test genesis time is far in the past
+	lightConfig.Light.TrustPeriod = 100 * 365 * 24 * time.Hour
+	lightConfig.Light.RPCServers = []string{config.RPC.ListenAddress}
+	lightConfig.P2P.ListenAddress = "tcp://127.0.0.1:36666"
+	lightConfig.P2P.PersistentPeers = p2p.IDAddressString(fullNode.NodeInfo().ID(), "127.0.0.1:36656")
+	lightConfig.RPC.ListenAddress = "tcp://127.0.0.1:36667"
+	lightConfig.RPC.GRPCListenAddress = ""
+
+	lightNode, err := DefaultNewNode(lightConfig, log.TestingLogger())
+	require.NoError(t, err)
+	require.NotNil(t, lightNode.HeaderSyncReactor())
+	require.NoError(t, lightNode.Start())
+	defer lightNode.Stop() //nolint:errcheck // ignore for tests
+
+	require.Eventually(t, func() bool {
+		latest := lightNode.HeaderSyncReactor().LatestLightBlock()
+		return latest != nil && latest.Height >= 3
+	}, 20*time.Second, 100*time.Millisecond)
+
+	lb, err := lightNode.HeaderSyncReactor().Store().LightBlock(2)
+	require.NoError(t, err)
+	meta := fullNode.BlockStore().LoadBlockMeta(2)
+	require.NotNil(t, meta)
+	assert.Equal(t, meta.BlockID.Hash, lb.Hash())
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -317,11 +374,12 @@ func TestCreateProposalBlock(t *testing.T) {
 		evidencePool,
 	)
 
-	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
+	extCommit := &types.ExtendedCommit{Height: height - 1}
 	block, _ := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, extCommit,
 		proposerAddr,
+		time.Now(),
 	)
 
 	// check that the part set does not exceed the maximum block size
@@ -407,11 +465,12 @@ func TestMaxProposalBlockSize(t *testing.T) {
 		sm.EmptyEvidencePool{},
 	)
 
-	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
+	extCommit := &types.ExtendedCommit{Height: height - 1}
 	block, _ := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, extCommit,
 		proposerAddr,
+		time.Now(),
 	)
 
 	pb, err := block.ToProto()
@@ -0,0 +1,168 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+)
+
+// AdmissionLists are the node IDs and CIDRs peers are admitted or rejected by.
+type AdmissionLists struct {
+	AllowIDs   []string `json:"allow_ids"`
+	DenyIDs    []string `json:"deny_ids"`
+	AllowCIDRs []string `json:"allow_cidrs"`
+	DenyCIDRs  []string `json:"deny_cidrs"`
+}
+
+// PeerAdmission decides which peers are allowed to connect to the node,
+// based on allow and deny lists of node IDs and CIDRs:
+//
+//   - a peer whose ID or IP is denied is rejected;
+//   - if any allow list is set, a peer is only admitted if its ID or IP is
+//     allowed;
+//   - otherwise, the peer is admitted.
+//
+// The lists apply to all peers, including persistent and unconditional
+// ones, and can be updated at runtime. It is safe for concurrent use.
+type PeerAdmission struct {
+	mtx        cmtsync.RWMutex
+	allowIDs   map[ID]struct{}
+	denyIDs    map[ID]struct{}
+	allowNets  []*net.IPNet
+	denyNets   []*net.IPNet
+	hasAllowed bool
+}
+
+// NewPeerAdmission returns a PeerAdmission with the given lists.
+func NewPeerAdmission(lists AdmissionLists) (*PeerAdmission, error) {
+	pa := &PeerAdmission{}
+	if err := pa.Update(lists); err != nil {
+		return nil, err
+	}
+	return pa, nil
+}
+
+// Update replaces the lists. The previous lists are kept if any of the new
+// ones is invalid.
+func (pa *PeerAdmission) Update(lists AdmissionLists) error {
+	allowIDs, err := parseAdmissionIDs(lists.AllowIDs)
+	if err != nil {
+		return fmt.Errorf("allowed IDs: %w", err)
+	}
+	denyIDs, err := parseAdmissionIDs(lists.DenyIDs)
+	if err != nil {
+		return fmt.Errorf("denied IDs: %w", err)
+	}
+	allowNets, err := parseAdmissionCIDRs(lists.AllowCIDRs)
+	if err != nil {
+		return fmt.Errorf("allowed CIDRs: %w", err)
+	}
+	denyNets, err := parseAdmissionCIDRs(lists.DenyCIDRs)
+	if err != nil {
+		return fmt.Errorf("denied CIDRs: %w", err)
+	}
+
+	pa.mtx.Lock()
+	defer pa.mtx.Unlock()
+
+	pa.allowIDs = allowIDs
+	pa.denyIDs = denyIDs
+	pa.allowNets = allowNets
+	pa.denyNets = denyNets
+	pa.hasAllowed = len(allowIDs) > 0 || len(allowNets) > 0
+	return nil
+}
+
+// Admit returns an error if the peer with the given ID, connecting from ip,
+// is not admitted.
+func (pa *PeerAdmission) Admit(id ID, ip net.IP) error {
+	pa.mtx.RLock()
+	defer pa.mtx.RUnlock()
+
+	if _, ok := pa.denyIDs[id]; ok {
+		return fmt.Errorf("peer ID %v is denied", id)
+	}
+	if containsIP(pa.denyNets, ip) {
+		return fmt.Errorf("peer IP %v is denied", ip)
+	}
+	if !pa.hasAllowed {
+		return nil
+	}
+	if _, ok := pa.allowIDs[id]; ok {
+		return nil
+	}
+	if containsIP(pa.allowNets, ip) {
+		return nil
+	}
+	return fmt.Errorf("peer %v with IP %v is not allowed", id, ip)
+}
+
+// ConnFilter returns a ConnFilterFunc rejecting connections from denied IPs
+// before the peers authenticate. The allow lists can only be checked once
+// the ID of the peer is known, see PeerFilter.
+func (pa *PeerAdmission) ConnFilter() ConnFilterFunc {
+	return func(_ ConnSet, _ net.Conn, ips []net.IP) error {
+		pa.mtx.RLock()
+		defer pa.mtx.RUnlock()
+
+		for _, ip := range ips {
+			if containsIP(pa.denyNets, ip) {
+				return fmt.Errorf("peer IP %v is denied", ip)
+			}
+		}
+		return nil
+	}
+}
+
+// PeerFilter returns a PeerFilterFunc rejecting the peers which are not
+// admitted.
+func (pa *PeerAdmission) PeerFilter() PeerFilterFunc {
+	return func(_ IPeerSet, p Peer) error {
+		return pa.Admit(p.ID(), p.RemoteIP())
+	}
+}
+
+func parseAdmissionIDs(ids []string) (map[ID]struct{}, error) {
+	parsed := make(map[ID]struct{}, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if err := validateID(ID(id)); err != nil {
+			return nil, fmt.Errorf("%q: %w", id, err)
+		}
+		parsed[ID(id)] = struct{}{}
+	}
+	return parsed, nil
+}
+
+func parseAdmissionCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	parsed := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, ipNet)
+	}
+	return parsed, nil
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
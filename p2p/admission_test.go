@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/crypto/ed25519"
+)
+
+func TestPeerAdmissionAdmit(t *testing.T) {
+	var (
+		id1 = PubKeyToID(ed25519.GenPrivKey().PubKey())
+		id2 = PubKeyToID(ed25519.GenPrivKey().PubKey())
+		ip1 = net.ParseIP("10.0.0.1")
+		ip2 = net.ParseIP("192.168.0.1")
+	)
+
+	testCases := []struct {
+		name    string
+		lists   AdmissionLists
+		id      ID
+		ip      net.IP
+		allowed bool
+	}{
+		{"no lists", AdmissionLists{}, id1, ip1, true},
+		{"denied ID", AdmissionLists{DenyIDs: []string{string(id1)}}, id1, ip1, false},
+		{"other ID denied", AdmissionLists{DenyIDs: []string{string(id2)}}, id1, ip1, true},
+		{"denied CIDR", AdmissionLists{DenyCIDRs: []string{"10.0.0.0/8"}}, id1, ip1, false},
+		{"allowed ID", AdmissionLists{AllowIDs: []string{string(id1)}}, id1, ip1, true},
+		{"ID not allowed", AdmissionLists{AllowIDs: []string{string(id2)}}, id1, ip1, false},
+		{"allowed CIDR", AdmissionLists{AllowCIDRs: []string{"10.0.0.0/8"}}, id1, ip1, true},
+		{"CIDR not allowed", AdmissionLists{AllowCIDRs: []string{"10.0.0.0/8"}}, id1, ip2, false},
+		{"allowed ID in other CIDR", AdmissionLists{
+			AllowIDs:   []string{string(id1)},
+			AllowCIDRs: []string{"10.0.0.0/8"},
+		}, id1, ip2, true},
+		{"deny wins over allow", AdmissionLists{
+			AllowIDs:  []string{string(id1)},
+			DenyCIDRs: []string{"10.0.0.1/32"},
+		}, id1, ip1, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pa, err := NewPeerAdmission(tc.lists)
+			require.NoError(t, err)
+			err = pa.Admit(tc.id, tc.ip)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPeerAdmissionUpdate(t *testing.T) {
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	ip := net.ParseIP("10.0.0.1")
+
+	pa, err := NewPeerAdmission(AdmissionLists{})
+	require.NoError(t, err)
+	require.NoError(t, pa.Admit(id, ip))
+
+	require.NoError(t, pa.Update(AdmissionLists{DenyIDs: []string{string(id)}}))
+	assert.Error(t, pa.Admit(id, ip))
+
+	// invalid lists are rejected and the previous ones kept
+	assert.Error(t, pa.Update(AdmissionLists{DenyIDs: []string{"foo"}}))
+	assert.Error(t, pa.Update(AdmissionLists{AllowCIDRs: []string{"10.0.0.1"}}))
+	assert.Error(t, pa.Admit(id, ip))
+
+	_, err = NewPeerAdmission(AdmissionLists{DenyCIDRs: []string{"10.0.0.0/33"}})
+	assert.Error(t, err)
+}
+
+func TestPeerAdmissionConnFilter(t *testing.T) {
+	pa, err := NewPeerAdmission(AdmissionLists{
+		AllowIDs:  []string{string(PubKeyToID(ed25519.GenPrivKey().PubKey()))},
+		DenyCIDRs: []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+
+	c, _ := net.Pipe()
+	filter := pa.ConnFilter()
+
+	// the allow lists need the ID of the peer
+	assert.NoError(t, filter(NewConnSet(), c, []net.IP{net.ParseIP("192.168.0.1")}))
+
+	// the transport wraps the error in ErrRejected itself
+	err = filter(NewConnSet(), c, []net.IP{net.ParseIP("10.0.0.1")})
+	if assert.Error(t, err) {
+		_, ok := err.(ErrRejected)
+		assert.False(t, ok)
+	}
+}
@@ -32,6 +32,10 @@ const (
 	minWriteBufferSize = 65536
 	updateStats        = 2 * time.Second
 
+	// Interval over which the send rate of a channel is limited. A throttled
+	// channel is rechecked once the interval has passed.
+	channelRateLimitInterval = 100 * time.Millisecond
+
 	// some of these defaults are written in the user config
 	// flushThrottle, sendRate, recvRate
 	// TODO: remove values present in config
@@ -104,8 +108,9 @@ type MConnection struct {
 	// are safe to call concurrently.
 	stopMtx cmtsync.Mutex
 
-	flushTimer *timer.ThrottleTimer // flush writes as necessary but throttled.
-	pingTimer  *time.Ticker         // send pings periodically
+	flushTimer    *timer.ThrottleTimer // flush writes as necessary but throttled.
+	throttleTimer *timer.ThrottleTimer // wake up sendRoutine when channels are rate limited.
+	pingTimer     *time.Ticker         // send pings periodically
 
 	// close conn if pong is not received in pongTimeout
 	pongTimer     *time.Timer
@@ -134,6 +139,14 @@ type MConnConfig struct {
 
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
+
+	// Rate at which packets can be sent on a given channel, in bytes/second.
+	// Channels without an entry are only limited by SendRate.
+	ChannelSendRates map[byte]int64 `mapstructure:"channel_send_rates"`
+
+	// Rate at which packets can be received on a given channel, in
+	// bytes/second. Channels without an entry are only limited by RecvRate.
+	ChannelRecvRates map[byte]int64 `mapstructure:"channel_recv_rates"`
 }
 
 // DefaultMConnConfig returns the default config.
@@ -194,7 +207,7 @@ func NewMConnectionWithConfig(
 	var channels = []*Channel{}
 
 	for _, desc := range chDescs {
-		channel := newChannel(mconn, *desc)
+		channel := newChannel(mconn, *desc, config)
 		channelsIdx[channel.desc.ID] = channel
 		channels = append(channels, channel)
 	}
@@ -204,7 +217,7 @@ func NewMConnectionWithConfig(
 	mconn.BaseService = *service.NewBaseService(nil, "MConnection", mconn)
 
 	// maxPacketMsgSize() is a bit heavy, so call just once
-	mconn._maxPacketMsgSize = mconn.maxPacketMsgSize()
+	mconn._maxPacketMsgSize = maxPacketMsgSize(config.MaxPacketMsgPayloadSize)
 
 	return mconn
 }
@@ -222,6 +235,7 @@ func (c *MConnection) OnStart() error {
 		return err
 	}
 	c.flushTimer = timer.NewThrottleTimer("flush", c.config.FlushThrottle)
+	c.throttleTimer = timer.NewThrottleTimer("throttle", channelRateLimitInterval)
 	c.pingTimer = time.NewTicker(c.config.PingInterval)
 	c.pongTimeoutCh = make(chan bool, 1)
 	c.chStatsTimer = time.NewTicker(updateStats)
@@ -256,6 +270,7 @@ func (c *MConnection) stopServices() (alreadyStopped bool) {
 
 	c.BaseService.OnStop()
 	c.flushTimer.Stop()
+	c.throttleTimer.Stop()
 	c.pingTimer.Stop()
 	c.chStatsTimer.Stop()
 
@@ -434,6 +449,12 @@ FOR_LOOP:
 			// NOTE: flushTimer.Set() must be called every time
 			// something is written to .bufConnWriter.
 			c.flush()
+		case <-c.throttleTimer.Ch:
+			// Some channels were rate limited, try to send their PacketMsgs again.
+			select {
+			case c.send <- struct{}{}:
+			default:
+			}
 		case <-c.chStatsTimer.C:
 			for _, channel := range c.channels {
 				channel.updateStats()
@@ -522,11 +543,17 @@ func (c *MConnection) sendPacketMsg() bool {
 	// The chosen channel will be the one whose recentlySent/priority is the least.
 	var leastRatio float32 = math.MaxFloat32
 	var leastChannel *Channel
+	var throttled bool
 	for _, channel := range c.channels {
 		// If nothing to send, skip this channel
 		if !channel.isSendPending() {
 			continue
 		}
+		// If the channel exceeded its send rate, skip it for now
+		if channel.isSendThrottled() {
+			throttled = true
+			continue
+		}
 		// Get ratio, and keep track of the lowest ratio.
 		ratio := float32(channel.recentlySent) / float32(channel.desc.Priority)
 		if ratio < leastRatio {
@@ -537,6 +564,9 @@ func (c *MConnection) sendPacketMsg() bool {
 
 	// Nothing to send?
 	if leastChannel == nil {
+		if throttled {
+			c.throttleTimer.Set()
+		}
 		return true
 	}
 	// c.Logger.Info("Found a msgPacket to send")
@@ -633,6 +663,7 @@ FOR_LOOP:
 				c.stopForError(err)
 				break FOR_LOOP
 			}
+			channel.throttleRecv(_n)
 
 			msgBytes, err := channel.recvPacketMsg(*pkt.PacketMsg)
 			if err != nil {
@@ -671,11 +702,11 @@ func (c *MConnection) stopPongTimer() {
 }
 
 // maxPacketMsgSize returns a maximum size of PacketMsg
-func (c *MConnection) maxPacketMsgSize() int {
+func maxPacketMsgSize(maxPayloadSize int) int {
 	bz, err := proto.Marshal(mustWrapPacket(&tmp2p.PacketMsg{
 		ChannelID: 0x01,
 		EOF:       true,
-		Data:      make([]byte, c.config.MaxPacketMsgPayloadSize),
+		Data:      make([]byte, maxPayloadSize),
 	}))
 	if err != nil {
 		panic(err)
@@ -696,6 +727,10 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+	SendRate          int64 // 0 if the channel is only limited by the connection
+	RecvRate          int64 // 0 if the channel is only limited by the connection
+	SendMonitor       flow.Status
+	RecvMonitor       flow.Status
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -711,6 +746,10 @@ func (c *MConnection) Status() ConnectionStatus {
 			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			SendRate:          channel.sendRate,
+			RecvRate:          channel.recvRate,
+			SendMonitor:       channel.sendMonitor.Status(),
+			RecvMonitor:       channel.recvMonitor.Status(),
 		}
 	}
 	return status
@@ -744,20 +783,24 @@ func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
 // TODO: lowercase.
 // NOTE: not goroutine-safe.
 type Channel struct {
-	conn          *MConnection
+	conn          fmt.Stringer // the connection the channel belongs to
 	desc          ChannelDescriptor
 	sendQueue     chan []byte
 	sendQueueSize int32 // atomic.
 	recving       []byte
 	sending       []byte
 	recentlySent  int64 // exponential moving average
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+	sendRate      int64 // 0 means unlimited
+	recvRate      int64 // 0 means unlimited
 
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
 }
 
-func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
+func newChannel(conn fmt.Stringer, desc ChannelDescriptor, config MConnConfig) *Channel {
 	desc = desc.FillDefaults()
 	if desc.Priority <= 0 {
 		panic("Channel default priority must be a positive integer")
@@ -767,7 +810,11 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
-		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
+		sendMonitor:             flow.New(channelRateLimitInterval, 0),
+		recvMonitor:             flow.New(channelRateLimitInterval, 0),
+		sendRate:                config.ChannelSendRates[desc.ID],
+		recvRate:                config.ChannelRecvRates[desc.ID],
+		maxPacketMsgPayloadSize: config.MaxPacketMsgPayloadSize,
 	}
 }
 
@@ -825,6 +872,23 @@ func (ch *Channel) isSendPending() bool {
 	return true
 }
 
+// Returns true if the channel has sent more than its send rate allows in the
+// current interval.
+// Goroutine-safe
+func (ch *Channel) isSendThrottled() bool {
+	return ch.sendRate > 0 && ch.sendMonitor.Limit(1, ch.sendRate, false) == 0
+}
+
+// Records n received bytes, blocking first if receiving them would exceed the
+// receive rate of the channel, so that the peer is throttled.
+// Not goroutine-safe
+func (ch *Channel) throttleRecv(n int) {
+	if ch.recvRate > 0 {
+		ch.recvMonitor.Limit(n, ch.recvRate, true)
+	}
+	ch.recvMonitor.Update(n)
+}
+
 // Creates a new PacketMsg to send.
 // Not goroutine-safe
 func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
@@ -848,6 +912,7 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 	packet := ch.nextPacketMsg()
 	n, err = protoio.NewDelimitedWriter(w).WriteMsg(mustWrapPacket(&packet))
 	atomic.AddInt64(&ch.recentlySent, int64(n))
+	ch.sendMonitor.Update(n)
 	return
 }
 
@@ -250,6 +250,97 @@ func (c *MConnection) maxRecvRate() int64 {
 	return effectiveRecvRate
 }
 
+func TestMConnectionChannelSendRate(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	receivedCh := make(chan []byte)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	clientConn := createMConnectionWithCallbacks(client, onReceive, func(r interface{}) {})
+	err := clientConn.Start()
+	require.Nil(t, err)
+	defer clientConn.Stop() //nolint:errcheck // ignore for tests
+
+	cnfg := DefaultMConnConfig()
+	cnfg.ChannelSendRates = map[byte]int64{0x01: 100_000} // 100 KB/s
+	serverConn := createMConnectionWithCallbacksConfigs(server, func(chID byte, msgBytes []byte) {}, func(r interface{}) {}, cnfg)
+	err = serverConn.Start()
+	require.Nil(t, err)
+	defer serverConn.Stop() //nolint:errcheck // ignore for tests
+
+	msgSize := 200_000
+	msg := bytes.Repeat([]byte{1}, msgSize)
+	start := time.Now()
+	assert.True(t, serverConn.Send(0x01, msg))
+
+	select {
+	case receivedBytes := <-receivedCh:
+		assert.Equal(t, msg, receivedBytes)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Did not receive the message in 10s")
+	}
+	// the channel is limited to half of the message size per second
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	// wait for the monitors to complete their current sample
+	time.Sleep(2 * channelRateLimitInterval)
+	sendStatus := serverConn.Status().Channels[0]
+	assert.EqualValues(t, 100_000, sendStatus.SendRate)
+	assert.GreaterOrEqual(t, sendStatus.SendMonitor.Bytes, int64(msgSize))
+
+	recvStatus := clientConn.Status().Channels[0]
+	assert.Zero(t, recvStatus.SendRate)
+	assert.GreaterOrEqual(t, recvStatus.RecvMonitor.Bytes, int64(msgSize))
+}
+
+func TestMConnectionChannelRecvRate(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	receivedCh := make(chan []byte)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	cnfg := DefaultMConnConfig()
+	cnfg.ChannelRecvRates = map[byte]int64{0x01: 100_000} // 100 KB/s
+	clientConn := createMConnectionWithCallbacksConfigs(client, onReceive, func(r interface{}) {}, cnfg)
+	err := clientConn.Start()
+	require.Nil(t, err)
+	defer clientConn.Stop() //nolint:errcheck // ignore for tests
+
+	// the client doesn't read pongs while it is throttled, so the server uses
+	// the default pong timeout
+	serverConn := createMConnectionWithCallbacksConfigs(server, func(chID byte, msgBytes []byte) {},
+		func(r interface{}) {}, DefaultMConnConfig())
+	err = serverConn.Start()
+	require.Nil(t, err)
+	defer serverConn.Stop() //nolint:errcheck // ignore for tests
+
+	msgSize := 200_000
+	msg := bytes.Repeat([]byte{1}, msgSize)
+	start := time.Now()
+	assert.True(t, serverConn.Send(0x01, msg))
+
+	select {
+	case receivedBytes := <-receivedCh:
+		assert.Equal(t, msg, receivedBytes)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Did not receive the message in 10s")
+	}
+	// the channel is limited to half of the message size per second
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	// wait for the monitor to complete its current sample
+	time.Sleep(2 * channelRateLimitInterval)
+	recvStatus := clientConn.Status().Channels[0]
+	assert.EqualValues(t, 100_000, recvStatus.RecvRate)
+	assert.GreaterOrEqual(t, recvStatus.RecvMonitor.Bytes, int64(msgSize))
+}
+
 func TestMConnectionReceive(t *testing.T) {
 	server, client := NetPipe()
 	defer server.Close()
@@ -0,0 +1,525 @@
+package conn
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/KYVENetwork/celestia-core/crypto"
+	"github.com/KYVENetwork/celestia-core/crypto/ed25519"
+	flow "github.com/KYVENetwork/celestia-core/libs/flowrate"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	"github.com/KYVENetwork/celestia-core/libs/protoio"
+	"github.com/KYVENetwork/celestia-core/libs/service"
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	tmp2p "github.com/KYVENetwork/celestia-core/proto/celestiacore/p2p"
+)
+
+const (
+	// quicAuthLabel is the label of the TLS keying material signed by both
+	// sides of a QUIC connection to authenticate their node keys.
+	quicAuthLabel = "CELESTIA_CORE_QUIC_AUTH"
+
+	// quicRecvQueueSize is the number of received messages buffered until
+	// they are pushed to onReceive.
+	quicRecvQueueSize = 100
+)
+
+// AuthenticateQUICConnection authenticates the node keys of both sides of a
+// QUIC connection, equivalently to the handshake of a SecretConnection. Each
+// side signs keying material exported from the TLS session of the connection
+// and sends it over the stream, so that the signatures can not be replayed on
+// another connection. It returns the public key of the remote side.
+func AuthenticateQUICConnection(
+	qc quic.Connection,
+	stream io.ReadWriter,
+	locPrivKey crypto.PrivKey,
+) (crypto.PubKey, error) {
+	tlsState := qc.ConnectionState().TLS
+	challenge, err := tlsState.ExportKeyingMaterial(quicAuthLabel, nil, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	locSignature, err := locPrivKey.Sign(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	authSigMsg, err := shareAuthSignature(stream, locPrivKey.PubKey(), locSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	remPubKey, remSignature := authSigMsg.Key, authSigMsg.Sig
+	if _, ok := remPubKey.(ed25519.PubKey); !ok {
+		return nil, fmt.Errorf("expected ed25519 pubkey, got %T", remPubKey)
+	}
+	if !remPubKey.VerifySignature(challenge, remSignature) {
+		return nil, errors.New("challenge verification failed")
+	}
+	return remPubKey, nil
+}
+
+/*
+QUICConnection multiplexes the channels of a peer over a QUIC connection. As
+opposed to MConnection, which interleaves the PacketMsgs of all channels on a
+single stream, every channel sends its PacketMsgs on its own unidirectional
+stream. A large message on one channel (e.g. a block part) therefore does not
+delay the messages of other channels (e.g. votes).
+
+Send and TrySend have the same semantics as for MConnection. Received messages
+are pushed to onReceive by a single goroutine, in the order they have been
+received, as with MConnection. Keep-alives are handled by QUIC itself.
+*/
+type QUICConnection struct {
+	service.BaseService
+
+	conn        quic.Connection
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	channels    []*quicChannel
+	channelsIdx map[byte]*quicChannel
+	onReceive   receiveCbFunc
+	onError     errorCbFunc
+	errored     uint32
+	config      MConnConfig
+
+	// Closing flush makes the send routines send their pending PacketMsgs
+	// and quit.
+	flush     chan struct{}
+	flushOnce sync.Once
+	sendWg    sync.WaitGroup
+
+	// Channels we receive a stream for, as the receive buffer of a channel
+	// can only be used by a single stream.
+	recvMtx      cmtsync.Mutex
+	recvChannels map[byte]struct{}
+
+	// Messages received on any stream, pushed to onReceive by the
+	// dispatchRoutine.
+	recvQueue chan quicRecvMsg
+
+	created time.Time // time of creation
+
+	_maxPacketMsgSize int
+}
+
+// quicRecvMsg is a message received on a channel.
+type quicRecvMsg struct {
+	chID     byte
+	msgBytes []byte
+}
+
+// quicChannel is a Channel with the signal waking up its send routine.
+type quicChannel struct {
+	*Channel
+	send chan struct{}
+}
+
+var _ service.Service = (*QUICConnection)(nil)
+
+// NewQUICConnectionWithConfig wraps a QUIC connection and creates a
+// connection multiplexing the channels on streams with a config.
+func NewQUICConnectionWithConfig(
+	conn quic.Connection,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) *QUICConnection {
+	qconn := &QUICConnection{
+		conn:         conn,
+		sendMonitor:  flow.New(0, 0),
+		recvMonitor:  flow.New(0, 0),
+		onReceive:    onReceive,
+		onError:      onError,
+		config:       config,
+		flush:        make(chan struct{}),
+		recvChannels: make(map[byte]struct{}),
+		recvQueue:    make(chan quicRecvMsg, quicRecvQueueSize),
+		created:      time.Now(),
+	}
+
+	qconn.channelsIdx = make(map[byte]*quicChannel, len(chDescs))
+	for _, desc := range chDescs {
+		channel := &quicChannel{
+			Channel: newChannel(qconn, *desc, config),
+			send:    make(chan struct{}, 1),
+		}
+		qconn.channelsIdx[channel.desc.ID] = channel
+		qconn.channels = append(qconn.channels, channel)
+	}
+
+	qconn.BaseService = *service.NewBaseService(nil, "QUICConnection", qconn)
+
+	qconn._maxPacketMsgSize = maxPacketMsgSize(config.MaxPacketMsgPayloadSize)
+
+	return qconn
+}
+
+func (c *QUICConnection) SetLogger(l log.Logger) {
+	c.BaseService.SetLogger(l)
+	for _, ch := range c.channels {
+		ch.SetLogger(l)
+	}
+}
+
+// OnStart implements BaseService
+func (c *QUICConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	for _, ch := range c.channels {
+		c.sendWg.Add(1)
+		go c.sendRoutine(ch)
+	}
+	go c.acceptRoutine()
+	go c.dispatchRoutine()
+	go c.statsRoutine()
+	return nil
+}
+
+// OnStop implements BaseService
+func (c *QUICConnection) OnStop() {
+	c.BaseService.OnStop()
+	_ = c.conn.CloseWithError(0, "")
+}
+
+// FlushStop sends the pending PacketMsgs of all channels before closing the
+// connection. It waits at most defaultSendTimeout for them to be written.
+func (c *QUICConnection) FlushStop() {
+	c.flushOnce.Do(func() { close(c.flush) })
+
+	done := make(chan struct{})
+	go func() {
+		c.sendWg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(defaultSendTimeout):
+		c.Logger.Debug("Timed out flushing QUICConnection", "conn", c)
+	}
+
+	if err := c.Stop(); err != nil {
+		c.Logger.Debug("Error stopping QUICConnection", "err", err)
+	}
+}
+
+func (c *QUICConnection) String() string {
+	return fmt.Sprintf("QUICConn{%v}", c.conn.RemoteAddr())
+}
+
+// Catch panics, usually caused by remote disconnects.
+func (c *QUICConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("QUICConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *QUICConnection) stopForError(r interface{}) {
+	if err := c.Stop(); err != nil {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if atomic.CompareAndSwapUint32(&c.errored, 0, 1) {
+		if c.onError != nil {
+			c.onError(r)
+		}
+	}
+}
+
+// Send queues a message to be sent to channel.
+func (c *QUICConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	c.Logger.Debug("Send", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	success := channel.sendBytes(msgBytes)
+	if success {
+		channel.wakeup()
+	} else {
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+	}
+	return success
+}
+
+// Queues a message to be sent to channel.
+// Nonblocking, returns true if successful.
+func (c *QUICConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	c.Logger.Debug("TrySend", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	ok = channel.trySendBytes(msgBytes)
+	if ok {
+		channel.wakeup()
+	}
+	return ok
+}
+
+// CanSend returns true if you can send more data onto the chID, false
+// otherwise. Use only as a heuristic.
+func (c *QUICConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return channel.canSend()
+}
+
+// sendRoutine writes the PacketMsgs of a channel to its stream, which is
+// opened when the first message is sent.
+func (c *QUICConnection) sendRoutine(ch *quicChannel) {
+	defer c.sendWg.Done()
+	defer c._recover()
+
+	var stream quic.SendStream
+	for {
+		select {
+		case <-ch.send:
+		case <-c.flush:
+			if err := c.sendPacketMsgs(ch, &stream); err != nil {
+				c.Logger.Debug("Failed to flush channel", "channel", ch.desc.ID, "conn", c, "err", err)
+			}
+			if stream != nil {
+				_ = stream.Close()
+			}
+			return
+		case <-c.Quit():
+			return
+		}
+
+		if err := c.sendPacketMsgs(ch, &stream); err != nil {
+			if c.IsRunning() {
+				c.Logger.Error("Connection failed @ sendRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+	}
+}
+
+// sendPacketMsgs writes all pending PacketMsgs of the channel to its stream.
+// Blocks in accordance to the send rates of the connection and the channel.
+func (c *QUICConnection) sendPacketMsgs(ch *quicChannel, stream *quic.SendStream) error {
+	for ch.isSendPending() {
+		c.sendMonitor.Limit(c._maxPacketMsgSize, atomic.LoadInt64(&c.config.SendRate), true)
+		if ch.sendRate > 0 {
+			ch.sendMonitor.Limit(c._maxPacketMsgSize, ch.sendRate, true)
+		}
+
+		if *stream == nil {
+			s, err := c.conn.OpenUniStreamSync(c.conn.Context())
+			if err != nil {
+				return err
+			}
+			*stream = s
+		}
+
+		n, err := ch.writePacketMsgTo(*stream)
+		if err != nil {
+			return err
+		}
+		c.sendMonitor.Update(n)
+	}
+	return nil
+}
+
+// dispatchRoutine pushes the received messages to onReceive, one at a time.
+func (c *QUICConnection) dispatchRoutine() {
+	defer c._recover()
+
+	for {
+		select {
+		case msg := <-c.recvQueue:
+			c.onReceive(msg.chID, msg.msgBytes)
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// acceptRoutine accepts the streams opened by the remote side and starts a
+// recvRoutine for each of them.
+func (c *QUICConnection) acceptRoutine() {
+	defer c._recover()
+
+	for {
+		stream, err := c.conn.AcceptUniStream(c.conn.Context())
+		if err != nil {
+			if c.IsRunning() {
+				c.Logger.Debug("Connection failed @ acceptRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		go c.recvRoutine(stream)
+	}
+}
+
+// recvRoutine reads the PacketMsgs of a stream and reconstructs the messages
+// using the channel's "recving" buffer, which are queued for the
+// dispatchRoutine. The channel of the stream is determined by its first
+// PacketMsg.
+func (c *QUICConnection) recvRoutine(stream quic.ReceiveStream) {
+	defer c._recover()
+
+	protoReader := protoio.NewDelimitedReader(stream, c._maxPacketMsgSize)
+
+	var channel *quicChannel
+	for {
+		// Block until .recvMonitor says we can read.
+		c.recvMonitor.Limit(c._maxPacketMsgSize, atomic.LoadInt64(&c.config.RecvRate), true)
+
+		var packet tmp2p.Packet
+		n, err := protoReader.ReadMsg(&packet)
+		c.recvMonitor.Update(n)
+		if err != nil {
+			if err == io.EOF {
+				// The remote side closed the stream, e.g. after FlushStop.
+				return
+			}
+			if c.IsRunning() {
+				c.Logger.Debug("Connection failed @ recvRoutine (reading byte)", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+
+		pkt, ok := packet.Sum.(*tmp2p.Packet_PacketMsg)
+		if !ok {
+			err := fmt.Errorf("unexpected message type %T", packet.Sum)
+			c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+			c.stopForError(err)
+			return
+		}
+
+		channelID := pkt.PacketMsg.ChannelID
+		if channel == nil {
+			channel, err = c.recvChannel(channelID)
+		} else if channelID != int32(channel.desc.ID) {
+			err = fmt.Errorf("channel %X received on the stream of channel %X", channelID, channel.desc.ID)
+		}
+		if err != nil {
+			c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+			c.stopForError(err)
+			return
+		}
+		channel.throttleRecv(n)
+
+		msgBytes, err := channel.recvPacketMsg(*pkt.PacketMsg)
+		if err != nil {
+			if c.IsRunning() {
+				c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		if msgBytes != nil {
+			c.Logger.Debug("Received bytes", "chID", channel.desc.ID, "msgBytes", msgBytes)
+			// The receive buffer of the channel is reused for the next message,
+			// before the queued message is pushed to onReceive.
+			msgBytes = append([]byte(nil), msgBytes...)
+			select {
+			case c.recvQueue <- quicRecvMsg{chID: channel.desc.ID, msgBytes: msgBytes}:
+			case <-c.Quit():
+				return
+			}
+		}
+	}
+}
+
+// recvChannel returns the channel a new stream is received for. Only a single
+// stream can be received per channel.
+func (c *QUICConnection) recvChannel(channelID int32) (*quicChannel, error) {
+	channel, ok := c.channelsIdx[byte(channelID)]
+	if channelID < 0 || channelID > math.MaxUint8 || !ok || channel == nil {
+		return nil, fmt.Errorf("unknown channel %X", channelID)
+	}
+
+	c.recvMtx.Lock()
+	defer c.recvMtx.Unlock()
+	if _, ok := c.recvChannels[channel.desc.ID]; ok {
+		return nil, fmt.Errorf("duplicate stream for channel %X", channelID)
+	}
+	c.recvChannels[channel.desc.ID] = struct{}{}
+	return channel, nil
+}
+
+// statsRoutine periodically updates the stats of the channels.
+func (c *QUICConnection) statsRoutine() {
+	ticker := time.NewTicker(updateStats)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, channel := range c.channels {
+				channel.updateStats()
+			}
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// Status returns the status of the connection and its channels.
+func (c *QUICConnection) Status() ConnectionStatus {
+	var status ConnectionStatus
+	status.Duration = time.Since(c.created)
+	status.SendMonitor = c.sendMonitor.Status()
+	status.RecvMonitor = c.recvMonitor.Status()
+	status.Channels = make([]ChannelStatus, len(c.channels))
+	for i, channel := range c.channels {
+		status.Channels[i] = ChannelStatus{
+			ID:                channel.desc.ID,
+			SendQueueCapacity: cap(channel.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
+			Priority:          channel.desc.Priority,
+			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			SendRate:          channel.sendRate,
+			RecvRate:          channel.recvRate,
+			SendMonitor:       channel.sendMonitor.Status(),
+			RecvMonitor:       channel.recvMonitor.Status(),
+		}
+	}
+	return status
+}
+
+// wakeup wakes up the send routine of the channel if necessary.
+func (ch *quicChannel) wakeup() {
+	select {
+	case ch.send <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,200 @@
+package conn
+
+import (
+	"bytes"
+	"context"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/crypto"
+	"github.com/KYVENetwork/celestia-core/crypto/ed25519"
+	"github.com/KYVENetwork/celestia-core/libs/log"
+)
+
+func makeQUICConnPair(t *testing.T) (client, server quic.Connection) {
+	pub, priv, err := stded25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	require.NoError(t, err)
+	tlsConfig := &tls.Config{
+		Certificates:       []tls.Certificate{{Certificate: [][]byte{certDER}, PrivateKey: priv}},
+		InsecureSkipVerify: true, //nolint:gosec
+		NextProtos:         []string{"test"},
+	}
+
+	ln, err := quic.ListenAddr("127.0.0.1:0", tlsConfig, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	serverc := make(chan quic.Connection, 1)
+	go func() {
+		qc, err := ln.Accept(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		serverc <- qc
+	}()
+
+	client, err = quic.DialAddr(ctx, ln.Addr().String(), tlsConfig, nil)
+	require.NoError(t, err)
+	server = <-serverc
+	require.NotNil(t, server)
+
+	t.Cleanup(func() {
+		_ = client.CloseWithError(0, "")
+		_ = server.CloseWithError(0, "")
+	})
+	return client, server
+}
+
+func TestAuthenticateQUICConnection(t *testing.T) {
+	client, server := makeQUICConnPair(t)
+	clientKey, serverKey := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+
+	type result struct {
+		pubKey crypto.PubKey
+		err    error
+	}
+	serverc := make(chan result, 1)
+	go func() {
+		stream, err := server.AcceptStream(context.Background())
+		if err != nil {
+			serverc <- result{err: err}
+			return
+		}
+		pubKey, err := AuthenticateQUICConnection(server, stream, serverKey)
+		serverc <- result{pubKey, err}
+	}()
+
+	stream, err := client.OpenStreamSync(context.Background())
+	require.NoError(t, err)
+	pubKey, err := AuthenticateQUICConnection(client, stream, clientKey)
+	require.NoError(t, err)
+	assert.Equal(t, serverKey.PubKey(), pubKey)
+
+	res := <-serverc
+	require.NoError(t, res.err)
+	assert.Equal(t, clientKey.PubKey(), res.pubKey)
+}
+
+func TestQUICConnectionChannelsDoNotBlockEachOther(t *testing.T) {
+	client, server := makeQUICConnPair(t)
+
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 1},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 1},
+	}
+
+	cfg := DefaultMConnConfig()
+	cfg.ChannelSendRates = map[byte]int64{0x01: 100_000} // 100 KB/s
+	clientConn := NewQUICConnectionWithConfig(client, chDescs, func(byte, []byte) {}, func(interface{}) {}, cfg)
+	clientConn.SetLogger(log.TestingLogger())
+	require.NoError(t, clientConn.Start())
+	defer clientConn.Stop() //nolint:errcheck // ignore for tests
+
+	type received struct {
+		chID     byte
+		msgBytes []byte
+	}
+	receivedCh := make(chan received, 2)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- received{chID, append([]byte(nil), msgBytes...)}
+	}
+	serverConn := NewQUICConnectionWithConfig(server, chDescs, onReceive, func(interface{}) {}, DefaultMConnConfig())
+	serverConn.SetLogger(log.TestingLogger())
+	require.NoError(t, serverConn.Start())
+	defer serverConn.Stop() //nolint:errcheck // ignore for tests
+
+	// a large message on the rate limited channel takes ~2s to be sent
+	largeMsg := bytes.Repeat([]byte{1}, 200_000)
+	smallMsg := []byte("vote")
+	assert.True(t, clientConn.Send(0x01, largeMsg))
+	time.Sleep(100 * time.Millisecond)
+	assert.True(t, clientConn.Send(0x02, smallMsg))
+
+	for _, expected := range []received{{0x02, smallMsg}, {0x01, largeMsg}} {
+		select {
+		case r := <-receivedCh:
+			assert.Equal(t, expected.chID, r.chID)
+			assert.Equal(t, expected.msgBytes, r.msgBytes)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Did not receive the message of channel %X", expected.chID)
+		}
+	}
+
+	status := serverConn.Status()
+	require.Len(t, status.Channels, 2)
+	assert.Equal(t, byte(0x01), status.Channels[0].ID)
+}
+
+func TestQUICConnectionReceivesSerially(t *testing.T) {
+	client, server := makeQUICConnPair(t)
+
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 100},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 100},
+	}
+
+	clientConn := NewQUICConnectionWithConfig(client, chDescs, func(byte, []byte) {}, func(interface{}) {},
+		DefaultMConnConfig())
+	clientConn.SetLogger(log.TestingLogger())
+	require.NoError(t, clientConn.Start())
+	defer clientConn.Stop() //nolint:errcheck // ignore for tests
+
+	const numMsgs = 100
+	var (
+		receiving int32
+		received  = make(map[byte][]byte)
+		done      = make(chan struct{})
+	)
+	onReceive := func(chID byte, msgBytes []byte) {
+		if atomic.AddInt32(&receiving, 1) != 1 {
+			t.Error("onReceive called concurrently")
+		}
+		time.Sleep(time.Millisecond)
+		received[chID] = append(received[chID], msgBytes[0])
+		if len(received[0x01]) == numMsgs && len(received[0x02]) == numMsgs {
+			close(done)
+		}
+		atomic.AddInt32(&receiving, -1)
+	}
+	serverConn := NewQUICConnectionWithConfig(server, chDescs, onReceive, func(interface{}) {}, DefaultMConnConfig())
+	serverConn.SetLogger(log.TestingLogger())
+	require.NoError(t, serverConn.Start())
+	defer serverConn.Stop() //nolint:errcheck // ignore for tests
+
+	for i := 0; i < numMsgs; i++ {
+		assert.True(t, clientConn.Send(0x01, []byte{byte(i)}))
+		assert.True(t, clientConn.Send(0x02, []byte{byte(i)}))
+	}
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Did not receive all the messages")
+	}
+	// the messages of each channel are received in order
+	for _, chID := range []byte{0x01, 0x02} {
+		for i, b := range received[chID] {
+			assert.Equal(t, byte(i), b)
+		}
+	}
+}
@@ -138,6 +138,17 @@ func (e ErrSwitchAuthenticationFailure) Error() string {
 	)
 }
 
+// ErrSwitchUntrustedPeer to be raised when a peer's trust score fell below
+// the configured minimum, or the peer is still banned for it.
+type ErrSwitchUntrustedPeer struct {
+	ID    ID
+	Score int
+}
+
+func (e ErrSwitchUntrustedPeer) Error() string {
+	return fmt.Sprintf("peer %v is untrusted (trust score %d)", e.ID, e.Score)
+}
+
 // ErrTransportClosed is raised when the Transport has been closed.
 type ErrTransportClosed struct{}
 
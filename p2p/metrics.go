@@ -35,12 +35,24 @@ type Metrics struct {
 	PeerSendBytesTotal metrics.Counter
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge
+	// Current rate at which bytes are sent to a given peer on a channel.
+	PeerChannelSendRate metrics.Gauge
+	// Current rate at which bytes are received from a given peer on a channel.
+	PeerChannelRecvRate metrics.Gauge
+	// Number of bytes sent to a given peer on a channel, including the packet
+	// overhead.
+	PeerChannelSendBytesTotal metrics.Counter
+	// Number of bytes received from a given peer on a channel, including the
+	// packet overhead.
+	PeerChannelRecvBytesTotal metrics.Counter
 	// Number of transactions submitted by each peer.
 	NumTxs metrics.Gauge
 	// Number of bytes of each message type received.
 	MessageReceiveBytesTotal metrics.Counter
 	// Number of bytes of each message type sent.
 	MessageSendBytesTotal metrics.Counter
+	// Number of peers evicted, by reason.
+	EvictedPeers metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -76,6 +88,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "peer_pending_send_bytes",
 			Help:      "Pending bytes to be sent to a given peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerChannelSendRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_rate",
+			Help:      "Current rate at which bytes are sent to a given peer on a channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelRecvRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_recv_rate",
+			Help:      "Current rate at which bytes are received from a given peer on a channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelSendBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_bytes_total",
+			Help:      "Number of bytes sent to a given peer on a channel, including the packet overhead.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelRecvBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_recv_bytes_total",
+			Help:      "Number of bytes received from a given peer on a channel, including the packet overhead.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		NumTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -94,18 +130,29 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_send_bytes_total",
 			Help:      "Number of bytes of each message type sent.",
 		}, append(labels, "message_type")).With(labelsAndValues...),
+		EvictedPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_peers_total",
+			Help:      "Number of peers evicted, by reason.",
+		}, append(labels, "reason")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Peers:                    discard.NewGauge(),
-		PeerReceiveBytesTotal:    discard.NewCounter(),
-		PeerSendBytesTotal:       discard.NewCounter(),
-		PeerPendingSendBytes:     discard.NewGauge(),
-		NumTxs:                   discard.NewGauge(),
-		MessageReceiveBytesTotal: discard.NewCounter(),
-		MessageSendBytesTotal:    discard.NewCounter(),
+		Peers:                     discard.NewGauge(),
+		PeerReceiveBytesTotal:     discard.NewCounter(),
+		PeerSendBytesTotal:        discard.NewCounter(),
+		PeerPendingSendBytes:      discard.NewGauge(),
+		PeerChannelSendRate:       discard.NewGauge(),
+		PeerChannelRecvRate:       discard.NewGauge(),
+		PeerChannelSendBytesTotal: discard.NewCounter(),
+		PeerChannelRecvBytesTotal: discard.NewCounter(),
+		NumTxs:                    discard.NewGauge(),
+		MessageReceiveBytesTotal:  discard.NewCounter(),
+		MessageSendBytesTotal:     discard.NewCounter(),
+		EvictedPeers:              discard.NewCounter(),
 	}
 }
 
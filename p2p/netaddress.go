@@ -35,12 +35,15 @@ func IDAddressString(id ID, protocolHostPort string) string {
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
-// panic. Panics if ID is invalid.
+// NewNetAddress returns a new NetAddress using the provided TCP (or, for
+// QUIC connections, UDP) address. When testing, other net.Addr will result in
+// using 0.0.0.0:0. When normal run, other net.Addr will panic. Panics if ID is
+// invalid.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
+	if udpAddr, ok := addr.(*net.UDPAddr); ok {
+		addr = &net.TCPAddr{IP: udpAddr.IP, Port: udpAddr.Port, Zone: udpAddr.Zone}
+	}
 	tcpAddr, ok := addr.(*net.TCPAddr)
 	if !ok {
 		if flag.Lookup("test.v") == nil { // normal run
@@ -157,6 +157,25 @@ func (pc peerConn) RemoteIP() net.IP {
 	return pc.ip
 }
 
+// multiplexConn multiplexes the channels of a peer over its connection. It is
+// implemented by MConnection for TCP peers and by QUICConnection for QUIC
+// peers.
+type multiplexConn interface {
+	service.Service
+	FlushStop()
+
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+
+	Status() cmtconn.ConnectionStatus
+}
+
+var (
+	_ multiplexConn = (*cmtconn.MConnection)(nil)
+	_ multiplexConn = (*cmtconn.QUICConnection)(nil)
+)
+
 // peer implements Peer.
 //
 // Before using a peer, you will need to perform a handshake on connection.
@@ -165,7 +184,7 @@ type peer struct {
 
 	// raw peerConn and the multiplex connection
 	peerConn
-	mconn *cmtconn.MConnection
+	mconn multiplexConn
 
 	// peer's node info and the channel it knows about
 	// channels = nodeInfo.Channels
@@ -215,15 +234,27 @@ func newPeer(
 		traceClient:   trace.NoOpTracer(),
 	}
 
-	p.mconn = createMConnection(
-		pc.conn,
-		p,
-		reactorsByCh,
-		msgTypeByChID,
-		chDescs,
-		onPeerError,
-		mConfig,
-	)
+	if qc, ok := pc.conn.(*quicConn); ok {
+		p.mconn = createQUICConnection(
+			qc,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+	} else {
+		p.mconn = createMConnection(
+			pc.conn,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+	}
 	p.BaseService = *service.NewBaseService(nil, "Peer", p)
 	for _, option := range options {
 		option(p)
@@ -489,6 +520,10 @@ func PeerMetrics(metrics *Metrics) PeerOption {
 }
 
 func (p *peer) metricsReporter() {
+	// bytes sent and received per channel as of the last report, to add the
+	// difference to the byte counters
+	sent := make(map[byte]int64)
+	recvd := make(map[byte]int64)
 	for {
 		select {
 		case <-p.metricsTicker.C:
@@ -498,6 +533,17 @@ func (p *peer) metricsReporter() {
 			for _, chStatus := range status.Channels {
 				sendQueueSize += float64(chStatus.SendQueueSize)
 				queues[chStatus.ID] = chStatus.SendQueueSize
+
+				labels := []string{
+					"peer_id", string(p.ID()),
+					"chID", fmt.Sprintf("%#x", chStatus.ID),
+				}
+				p.metrics.PeerChannelSendRate.With(labels...).Set(float64(chStatus.SendMonitor.CurRate))
+				p.metrics.PeerChannelRecvRate.With(labels...).Set(float64(chStatus.RecvMonitor.CurRate))
+				p.metrics.PeerChannelSendBytesTotal.With(labels...).Add(float64(chStatus.SendMonitor.Bytes - sent[chStatus.ID]))
+				p.metrics.PeerChannelRecvBytesTotal.With(labels...).Add(float64(chStatus.RecvMonitor.Bytes - recvd[chStatus.ID]))
+				sent[chStatus.ID] = chStatus.SendMonitor.Bytes
+				recvd[chStatus.ID] = chStatus.RecvMonitor.Bytes
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
@@ -520,8 +566,46 @@ func createMConnection(
 	onPeerError func(Peer, interface{}),
 	config cmtconn.MConnConfig,
 ) *cmtconn.MConnection {
+	onReceive, onError := peerCallbacks(p, reactorsByCh, msgTypeByChID, onPeerError)
+
+	return cmtconn.NewMConnectionWithConfig(
+		conn,
+		chDescs,
+		onReceive,
+		onError,
+		config,
+	)
+}
 
-	onReceive := func(chID byte, msgBytes []byte) {
+func createQUICConnection(
+	conn *quicConn,
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	config cmtconn.MConnConfig,
+) *cmtconn.QUICConnection {
+	onReceive, onError := peerCallbacks(p, reactorsByCh, msgTypeByChID, onPeerError)
+
+	return cmtconn.NewQUICConnectionWithConfig(
+		conn.conn,
+		chDescs,
+		onReceive,
+		onError,
+		config,
+	)
+}
+
+// peerCallbacks returns the callbacks of the multiplex connection of the peer,
+// dispatching received messages to the reactors and reporting errors.
+func peerCallbacks(
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	onPeerError func(Peer, interface{}),
+) (onReceive func(byte, []byte), onError func(interface{})) {
+	onReceive = func(chID byte, msgBytes []byte) {
 		reactor := reactorsByCh[chID]
 		if reactor == nil {
 			// Note that its ok to panic here as it's caught in the conn._recover,
@@ -558,15 +642,9 @@ func createMConnection(
 		}
 	}
 
-	onError := func(r interface{}) {
+	onError = func(r interface{}) {
 		onPeerError(p, r)
 	}
 
-	return cmtconn.NewMConnectionWithConfig(
-		conn,
-		chDescs,
-		onReceive,
-		onError,
-		config,
-	)
+	return onReceive, onError
 }
@@ -0,0 +1,190 @@
+package p2p
+
+import (
+	"net"
+	"sort"
+	"time"
+)
+
+const (
+	// Number of inbound peers from distinct netgroups which are protected
+	// from eviction, so that an attacker controlling a few IP ranges can not
+	// take over all our inbound slots.
+	evictionProtectedNetGroups = 4
+
+	// Number of longest connected inbound peers with at least a neutral score
+	// which are protected from eviction, as they are costly for an attacker
+	// to replace.
+	evictionProtectedLongLived = 4
+
+	// Score of peers without any recorded behaviour, e.g. new peers. Unknown
+	// peers can't evict peers which haven't misbehaved, so that an attacker
+	// can't churn our inbound peers by connecting with fresh node IDs.
+	evictionNeutralScore = MaxTrustScore / 2
+)
+
+// evictionCandidate is an inbound peer which may be evicted to make room for
+// a new inbound peer.
+type evictionCandidate struct {
+	peer     Peer
+	score    int
+	netGroup string
+	duration time.Duration
+}
+
+// selectPeerToEvict selects the candidate to evict to make room for a new
+// inbound peer with the given score, or returns nil if no candidate should be
+// evicted.
+//
+// The best scoring peer of a few distinct netgroups and the longest connected
+// peers with at least a neutral score are protected first. The evictee is
+// then taken from the netgroup with the most remaining candidates, so that
+// evictions preserve the diversity of our inbound peers. Within that netgroup,
+// the lowest scoring candidate is evicted, preferring the most recent
+// connection on ties, but only if it scores lower than the new peer.
+func selectPeerToEvict(candidates []evictionCandidate, newScore int) *evictionCandidate {
+	candidates = protectNetGroups(candidates, evictionProtectedNetGroups)
+	candidates = protectLongLived(candidates, evictionProtectedLongLived)
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	groups := make(map[string][]evictionCandidate)
+	for _, c := range candidates {
+		groups[c.netGroup] = append(groups[c.netGroup], c)
+	}
+	var group []evictionCandidate
+	for _, g := range groups {
+		if len(g) > len(group) || (len(g) == len(group) && g[0].netGroup < group[0].netGroup) {
+			group = g
+		}
+	}
+
+	evictee := group[0]
+	for _, c := range group[1:] {
+		if c.score < evictee.score || (c.score == evictee.score && c.duration < evictee.duration) {
+			evictee = c
+		}
+	}
+	if evictee.score >= newScore {
+		return nil
+	}
+	return &evictee
+}
+
+// protectLongLived removes the n longest connected candidates with at least a
+// neutral score from the candidates.
+func protectLongLived(candidates []evictionCandidate, n int) []evictionCandidate {
+	sorted := make([]evictionCandidate, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].duration > sorted[j].duration
+	})
+
+	remaining := make([]evictionCandidate, 0, len(sorted))
+	for _, c := range sorted {
+		if n > 0 && c.score >= evictionNeutralScore {
+			n--
+			continue
+		}
+		remaining = append(remaining, c)
+	}
+	return remaining
+}
+
+// protectNetGroups removes the best scoring candidate of up to n distinct
+// netgroups from the candidates, starting with the netgroups whose best peer
+// has the highest score.
+func protectNetGroups(candidates []evictionCandidate, n int) []evictionCandidate {
+	best := make(map[string]int)
+	for i, c := range candidates {
+		j, ok := best[c.netGroup]
+		if !ok || c.score > candidates[j].score ||
+			(c.score == candidates[j].score && c.duration > candidates[j].duration) {
+			best[c.netGroup] = i
+		}
+	}
+
+	groupBest := make([]int, 0, len(best))
+	for _, i := range best {
+		groupBest = append(groupBest, i)
+	}
+	sort.Slice(groupBest, func(a, b int) bool {
+		ca, cb := candidates[groupBest[a]], candidates[groupBest[b]]
+		if ca.score != cb.score {
+			return ca.score > cb.score
+		}
+		return ca.netGroup < cb.netGroup
+	})
+
+	protected := make(map[int]struct{}, n)
+	for _, i := range groupBest {
+		if len(protected) == n {
+			break
+		}
+		protected[i] = struct{}{}
+	}
+
+	remaining := make([]evictionCandidate, 0, len(candidates))
+	for i, c := range candidates {
+		if _, ok := protected[i]; !ok {
+			remaining = append(remaining, c)
+		}
+	}
+	return remaining
+}
+
+// netGroup returns the netgroup of the IP, which is its /16 network for IPv4
+// and its /32 network for IPv6.
+func netGroup(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(16, 32)).String()
+	}
+	if ip == nil {
+		return ""
+	}
+	return ip.Mask(net.CIDRMask(32, 128)).String()
+}
+
+// evictionScore returns the trust score of the peer, or evictionNeutralScore
+// if no behaviour of the peer has been recorded yet.
+func (sw *Switch) evictionScore(id ID) int {
+	if !sw.peerManager.HasHistory(id) {
+		return evictionNeutralScore
+	}
+	return sw.peerManager.Score(id)
+}
+
+// evictInboundPeer disconnects the evictable inbound peer selected by
+// selectPeerToEvict to make room for the new inbound peer. Persistent and
+// unconditional peers are never evicted. It returns false if no peer was
+// evicted.
+func (sw *Switch) evictInboundPeer(newPeer Peer) bool {
+	if !sw.config.EvictInboundPeers {
+		return false
+	}
+
+	var candidates []evictionCandidate
+	for _, p := range sw.peers.List() {
+		if p.IsOutbound() || p.IsPersistent() || sw.IsPeerUnconditional(p.ID()) {
+			continue
+		}
+		candidates = append(candidates, evictionCandidate{
+			peer:     p,
+			score:    sw.evictionScore(p.ID()),
+			netGroup: netGroup(p.RemoteIP()),
+			duration: p.Status().Duration,
+		})
+	}
+
+	evictee := selectPeerToEvict(candidates, sw.evictionScore(newPeer.ID()))
+	if evictee == nil {
+		return false
+	}
+
+	sw.Logger.Info("Evicting inbound peer to make room for a new one",
+		"peer", evictee.peer, "score", evictee.score, "newPeer", newPeer.ID())
+	sw.metrics.EvictedPeers.With("reason", "inbound_full").Add(1)
+	sw.stopAndRemovePeer(evictee.peer, "evicted to make room for a new inbound peer")
+	return true
+}
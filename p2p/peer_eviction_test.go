@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetGroup(t *testing.T) {
+	assert.Equal(t, "10.1.0.0", netGroup(net.ParseIP("10.1.2.3")))
+	assert.Equal(t, netGroup(net.ParseIP("10.1.2.3")), netGroup(net.ParseIP("10.1.200.1")))
+	assert.NotEqual(t, netGroup(net.ParseIP("10.1.2.3")), netGroup(net.ParseIP("10.2.2.3")))
+	assert.Equal(t, "2001:db8::", netGroup(net.ParseIP("2001:db8:1234::1")))
+	assert.Equal(t, "", netGroup(nil))
+}
+
+func TestSelectPeerToEvict(t *testing.T) {
+	// 10 peers in the same netgroup, connected for i minutes, all fully trusted
+	// except for peer 2.
+	makeCandidates := func() []evictionCandidate {
+		candidates := make([]evictionCandidate, 0, 10)
+		for i := 0; i < 10; i++ {
+			candidates = append(candidates, evictionCandidate{
+				score:    MaxTrustScore,
+				netGroup: "10.0.0.0",
+				duration: time.Duration(i) * time.Minute,
+			})
+		}
+		candidates[2].score = 10
+		return candidates
+	}
+
+	t.Run("evicts the lowest scoring peer", func(t *testing.T) {
+		evictee := selectPeerToEvict(makeCandidates(), MaxTrustScore)
+		require.NotNil(t, evictee)
+		assert.Equal(t, 10, evictee.score)
+	})
+
+	t.Run("does not evict for a lower scoring peer", func(t *testing.T) {
+		assert.Nil(t, selectPeerToEvict(makeCandidates(), 10))
+	})
+
+	t.Run("does not evict for a peer with a neutral score", func(t *testing.T) {
+		candidates := makeCandidates()
+		candidates[2].score = evictionNeutralScore
+		assert.Nil(t, selectPeerToEvict(candidates, evictionNeutralScore))
+	})
+
+	t.Run("protects long lived peers", func(t *testing.T) {
+		candidates := makeCandidates()
+		candidates[2].score = MaxTrustScore
+		candidates[8].score = evictionNeutralScore // the second longest connected peer
+		evictee := selectPeerToEvict(candidates, MaxTrustScore)
+		assert.Nil(t, evictee)
+	})
+
+	t.Run("does not protect long lived bad peers", func(t *testing.T) {
+		candidates := makeCandidates()
+		candidates[2].score = MaxTrustScore
+		candidates[8].score = 10 // the second longest connected peer
+		evictee := selectPeerToEvict(candidates, MaxTrustScore)
+		require.NotNil(t, evictee)
+		assert.Equal(t, 8*time.Minute, evictee.duration)
+	})
+
+	t.Run("evicts from the largest netgroup", func(t *testing.T) {
+		candidates := makeCandidates()
+		// the lowest scoring peer is in a netgroup of 2 peers, while the
+		// other 8 peers share a netgroup
+		candidates[2].netGroup = "10.1.0.0"
+		candidates[3].netGroup = "10.1.0.0"
+		candidates[3].score = 20
+		candidates[0].score = 50
+		evictee := selectPeerToEvict(candidates, MaxTrustScore)
+		require.NotNil(t, evictee)
+		assert.Equal(t, time.Duration(0), evictee.duration)
+	})
+
+	t.Run("protects distinct netgroups", func(t *testing.T) {
+		candidates := makeCandidates()
+		candidates[2].netGroup = "10.1.0.0" // the only peer of its netgroup
+		evictee := selectPeerToEvict(candidates, MaxTrustScore)
+		assert.Nil(t, evictee)
+	})
+
+	t.Run("prefers the most recent peer on ties", func(t *testing.T) {
+		candidates := makeCandidates()
+		for i := range candidates {
+			candidates[i].score = 50
+			candidates[i].netGroup = fmt.Sprintf("10.%d.0.0", i%2)
+		}
+		evictee := selectPeerToEvict(candidates, MaxTrustScore)
+		require.NotNil(t, evictee)
+		assert.Equal(t, time.Duration(0), evictee.duration)
+	})
+
+	t.Run("not enough candidates", func(t *testing.T) {
+		candidates := makeCandidates()[:evictionProtectedLongLived+1]
+		candidates[2].score = evictionNeutralScore
+		assert.Nil(t, selectPeerToEvict(candidates, MaxTrustScore))
+	})
+}
@@ -0,0 +1,165 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/KYVENetwork/celestia-core/libs/log"
+	"github.com/KYVENetwork/celestia-core/libs/service"
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	"github.com/KYVENetwork/celestia-core/p2p/trust"
+)
+
+// MaxTrustScore is the trust score of a peer we have no history for.
+const MaxTrustScore = 100
+
+var peerBansKey = []byte("peerBans")
+
+// PeerManager keeps track of the quality of our peers. The reactors report
+// the good behaviour and the faults of peers through the Switch (see
+// Switch.MarkPeerAsGood, Switch.MarkPeerAsBad and Switch.StopPeerForFault),
+// which is recorded in a trust metric per peer. The trust metrics keep
+// running while the peers are disconnected, so that the scores recover over
+// time, and are periodically persisted, so that they survive restarts. At most
+// trust.DefaultStoreMaxSize peers are tracked; the least recently used ones
+// are forgotten first.
+//
+// Peers evicted for their score are banned for a limited time. The bans are
+// persisted along with the trust history.
+type PeerManager struct {
+	service.BaseService
+
+	db          dbm.DB
+	store       *trust.MetricStore
+	banDuration time.Duration
+
+	mtx  cmtsync.Mutex
+	bans map[ID]time.Time // peer ID -> end of the ban
+}
+
+// NewPeerManager returns a PeerManager persisting the trust history of peers
+// to the given db, and banning peers for banDuration.
+func NewPeerManager(db dbm.DB, banDuration time.Duration) *PeerManager {
+	pm := &PeerManager{
+		db:          db,
+		store:       trust.NewTrustMetricStore(db, trust.DefaultConfig()),
+		banDuration: banDuration,
+		bans:        make(map[ID]time.Time),
+	}
+	pm.BaseService = *service.NewBaseService(nil, "PeerManager", pm)
+	return pm
+}
+
+// SetLogger implements service.Service.
+func (pm *PeerManager) SetLogger(l log.Logger) {
+	pm.BaseService.SetLogger(l)
+	pm.store.SetLogger(l)
+}
+
+// OnStart implements service.Service by loading the trust history and the
+// bans of peers.
+func (pm *PeerManager) OnStart() error {
+	if err := pm.loadBans(); err != nil {
+		return err
+	}
+	return pm.store.Start()
+}
+
+// OnStop implements service.Service by saving the trust history of peers.
+func (pm *PeerManager) OnStop() {
+	if err := pm.store.Stop(); err != nil {
+		pm.Logger.Error("Error stopping trust metric store", "err", err)
+	}
+}
+
+// MarkGood records that the peer did something useful.
+func (pm *PeerManager) MarkGood(id ID) {
+	pm.store.GetPeerTrustMetric(string(id)).GoodEvents(1)
+}
+
+// MarkBad records that the peer misbehaved.
+func (pm *PeerManager) MarkBad(id ID) {
+	pm.store.GetPeerTrustMetric(string(id)).BadEvents(1)
+}
+
+// Ban bans the peer for the ban duration.
+func (pm *PeerManager) Ban(id ID) {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+
+	pm.bans[id] = time.Now().Add(pm.banDuration)
+	pm.saveBans()
+}
+
+// IsBanned returns whether the peer is currently banned.
+func (pm *PeerManager) IsBanned(id ID) bool {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+
+	until, ok := pm.bans[id]
+	if !ok {
+		return false
+	}
+	if !time.Now().Before(until) {
+		delete(pm.bans, id)
+		return false
+	}
+	return true
+}
+
+// Score returns the trust score of the peer, from 0 to MaxTrustScore. Peers
+// without any recorded behaviour get MaxTrustScore.
+func (pm *PeerManager) Score(id ID) int {
+	tm, ok := pm.store.LookupPeerTrustMetric(string(id))
+	if !ok {
+		return MaxTrustScore
+	}
+	return tm.TrustScore()
+}
+
+// HasHistory returns whether any behaviour of the peer has been recorded.
+func (pm *PeerManager) HasHistory(id ID) bool {
+	_, ok := pm.store.LookupPeerTrustMetric(string(id))
+	return ok
+}
+
+// loadBans loads the bans, which haven't ended yet, from the db.
+func (pm *PeerManager) loadBans() error {
+	bz, err := pm.db.Get(peerBansKey)
+	if err != nil {
+		return err
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	bans := make(map[ID]time.Time)
+	if err := json.Unmarshal(bz, &bans); err != nil {
+		return fmt.Errorf("unmarshal peer bans: %w", err)
+	}
+
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+	now := time.Now()
+	for id, until := range bans {
+		if now.Before(until) {
+			pm.bans[id] = until
+		}
+	}
+	return nil
+}
+
+// saveBans persists the bans to the db.
+// CONTRACT: pm.mtx is locked.
+func (pm *PeerManager) saveBans() {
+	bz, err := json.Marshal(pm.bans)
+	if err != nil {
+		pm.Logger.Error("Failed to encode peer bans", "err", err)
+		return
+	}
+	if err := pm.db.SetSync(peerBansKey, bz); err != nil {
+		pm.Logger.Error("Failed to save peer bans", "err", err)
+	}
+}
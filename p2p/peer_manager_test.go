@@ -0,0 +1,74 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/libs/log"
+)
+
+func TestPeerManagerScores(t *testing.T) {
+	pm := NewPeerManager(dbm.NewMemDB(), 100*time.Millisecond)
+	pm.SetLogger(log.TestingLogger())
+	require.NoError(t, pm.Start())
+	t.Cleanup(func() {
+		if err := pm.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	var good, bad ID = "good", "bad"
+	assert.Equal(t, MaxTrustScore, pm.Score(good))
+	assert.Equal(t, MaxTrustScore, pm.Score(bad))
+	assert.False(t, pm.HasHistory(good))
+
+	pm.MarkGood(good)
+	pm.MarkBad(bad)
+	assert.True(t, pm.HasHistory(good))
+	assert.True(t, pm.HasHistory(bad))
+	assert.Equal(t, MaxTrustScore, pm.Score(good))
+	assert.Less(t, pm.Score(bad), MaxTrustScore)
+
+	// a good peer which misbehaves once is still trusted more than a peer
+	// which only misbehaved
+	for i := 0; i < 9; i++ {
+		pm.MarkGood(good)
+	}
+	pm.MarkBad(good)
+	assert.Greater(t, pm.Score(good), pm.Score(bad))
+
+	// bans are time-bounded
+	assert.False(t, pm.IsBanned(bad))
+	pm.Ban(bad)
+	assert.True(t, pm.IsBanned(bad))
+	assert.False(t, pm.IsBanned(good))
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, pm.IsBanned(bad))
+}
+
+func TestPeerManagerPersistsBans(t *testing.T) {
+	db := dbm.NewMemDB()
+	pm := NewPeerManager(db, time.Hour)
+	pm.SetLogger(log.TestingLogger())
+	require.NoError(t, pm.Start())
+
+	var banned ID = "banned"
+	pm.Ban(banned)
+	require.NoError(t, pm.Stop())
+
+	// the ban survives a restart
+	pm = NewPeerManager(db, time.Hour)
+	pm.SetLogger(log.TestingLogger())
+	require.NoError(t, pm.Start())
+	t.Cleanup(func() {
+		if err := pm.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	assert.True(t, pm.IsBanned(banned))
+	assert.False(t, pm.IsBanned("other"))
+}
@@ -11,9 +11,11 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/minio/highwayhash"
 
 	"github.com/KYVENetwork/celestia-core/crypto"
@@ -63,6 +65,8 @@ type AddrBook interface {
 	// Mark address
 	MarkGood(p2p.ID)
 	MarkAttempt(*p2p.NetAddress)
+	MarkFailure(*p2p.NetAddress, error)     // Failed attempt, with its reason
+	MarkLatency(p2p.ID, time.Duration)      // Time it took to connect
 	MarkBad(*p2p.NetAddress, time.Duration) // Move peer to bad peers list
 	// Add bad peers back to addrBook
 	ReinstateBadPeers()
@@ -74,11 +78,15 @@ type AddrBook interface {
 	GetSelection() []*p2p.NetAddress
 	// Send a selection of addresses with bias
 	GetSelectionWithBias(biasTowardsNewAddrs int) []*p2p.NetAddress
+	// Addresses with the lowest latency, at most one per network group
+	BestAddresses(n int) []*p2p.NetAddress
 
 	Size() int
 
 	// Persist to disk
 	Save()
+	// Wait until the address book is saved after it was stopped
+	Wait()
 }
 
 var _ AddrBook = (*addrBook)(nil)
@@ -102,6 +110,7 @@ type addrBook struct {
 
 	// immutable after creation
 	filePath          string
+	db                dbm.DB // if set, the book is persisted in db instead of filePath
 	key               string // random prefix for bucket placement
 	routabilityStrict bool
 	hashKey           []byte
@@ -134,7 +143,7 @@ func NewAddrBook(filePath string, routabilityStrict bool) AddrBook {
 }
 
 // Initialize the buckets.
-// When modifying this, don't forget to update loadFromFile()
+// When modifying this, don't forget to update restore()
 func (a *addrBook) init() {
 	a.key = crypto.CRandHex(24) // 24/2 * 8 = 96 bits
 	// New addr buckets
@@ -154,7 +163,7 @@ func (a *addrBook) OnStart() error {
 	if err := a.BaseService.OnStart(); err != nil {
 		return err
 	}
-	a.loadFromFile(a.filePath)
+	a.load()
 
 	// wg.Add to ensure that any invocation of .Wait()
 	// later on will wait for saveRoutine to terminate.
@@ -347,6 +356,32 @@ func (a *addrBook) MarkAttempt(addr *p2p.NetAddress) {
 	ka.markAttempt()
 }
 
+// MarkFailure implements AddrBook - it marks that an attempt to connect to the
+// address failed and records the reason.
+func (a *addrBook) MarkFailure(addr *p2p.NetAddress, reason error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[addr.ID]
+	if ka == nil {
+		return
+	}
+	ka.markFailure(reason.Error())
+}
+
+// MarkLatency implements AddrBook - it records the time it took to connect to
+// the peer.
+func (a *addrBook) MarkLatency(id p2p.ID, latency time.Duration) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil {
+		return
+	}
+	ka.markLatency(latency)
+}
+
 // MarkBad implements AddrBook. Kicks address out from book, places
 // the address in the badPeers pool.
 func (a *addrBook) MarkBad(addr *p2p.NetAddress, banTime time.Duration) {
@@ -470,6 +505,41 @@ func (a *addrBook) GetSelectionWithBias(biasTowardsNewAddrs int) []*p2p.NetAddre
 	return selection
 }
 
+// BestAddresses implements AddrBook.
+// It returns up to n addresses, ordered by increasing latency, with at most
+// one address per network group (see groupKey) so that the selection is not
+// dominated by a single /16. Addresses which were never connected to are
+// skipped.
+func (a *addrBook) BestAddresses(n int) []*p2p.NetAddress {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	candidates := make([]*knownAddress, 0, len(a.addrLookup))
+	for _, ka := range a.addrLookup {
+		if ka.Latency > 0 {
+			candidates = append(candidates, ka)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Latency < candidates[j].Latency
+	})
+
+	selection := make([]*p2p.NetAddress, 0, cmtmath.MinInt(n, len(candidates)))
+	groups := make(map[string]struct{})
+	for _, ka := range candidates {
+		if len(selection) >= n {
+			break
+		}
+		group := a.groupKey(ka.Addr)
+		if _, ok := groups[group]; ok {
+			continue
+		}
+		groups[group] = struct{}{}
+		selection = append(selection, ka.Addr)
+	}
+	return selection
+}
+
 //------------------------------------------------
 
 // Size returns the number of addresses in the book.
@@ -488,7 +558,23 @@ func (a *addrBook) size() int {
 
 // Save persists the address book to disk.
 func (a *addrBook) Save() {
-	a.saveToFile(a.filePath) // thread safe
+	a.save() // thread safe
+}
+
+func (a *addrBook) save() {
+	if a.db != nil {
+		a.saveToDB()
+		return
+	}
+	a.saveToFile(a.filePath)
+}
+
+func (a *addrBook) load() {
+	if a.db != nil {
+		a.loadFromDB()
+		return
+	}
+	a.loadFromFile(a.filePath)
 }
 
 func (a *addrBook) saveRoutine() {
@@ -499,13 +585,13 @@ out:
 	for {
 		select {
 		case <-saveFileTicker.C:
-			a.saveToFile(a.filePath)
+			a.save()
 		case <-a.Quit():
 			break out
 		}
 	}
 	saveFileTicker.Stop()
-	a.saveToFile(a.filePath)
+	a.save()
 }
 
 //----------------------------------------------------------
@@ -2,6 +2,7 @@ package pex
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -643,6 +645,123 @@ func TestAddrBookAddDoesNotOverwriteOldIP(t *testing.T) {
 	}
 }
 
+func TestAddrBookMarkFailureAndLatency(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	addr := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, addr))
+
+	book.MarkFailure(addr, errors.New("connection refused"))
+	book.MarkLatency(addr.ID, 50*time.Millisecond)
+
+	ka := book.(*addrBook).addrLookup[addr.ID]
+	assert.EqualValues(t, 1, ka.Attempts)
+	assert.False(t, ka.LastFailure.IsZero())
+	assert.Equal(t, "connection refused", ka.LastFailureReason)
+	assert.Equal(t, 50*time.Millisecond, ka.Latency)
+}
+
+func TestAddrBookBestAddresses(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	newAddr := func(ip string) *p2p.NetAddress {
+		id := p2p.ID(hex.EncodeToString(cmtrand.Bytes(p2p.IDByteLength)))
+		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(id, ip+":26656"))
+		require.NoError(t, err)
+		require.NoError(t, book.AddAddress(addr, addr))
+		return addr
+	}
+	var (
+		a1 = newAddr("12.1.2.3")
+		a2 = newAddr("12.1.5.6") // same /16 as a1
+		b  = newAddr("13.1.2.3")
+		c  = newAddr("14.1.2.3")
+		_  = newAddr("15.1.2.3") // never connected to
+	)
+	book.MarkLatency(a1.ID, 30*time.Millisecond)
+	book.MarkLatency(a2.ID, 10*time.Millisecond)
+	book.MarkLatency(b.ID, 20*time.Millisecond)
+	book.MarkLatency(c.ID, 40*time.Millisecond)
+
+	assert.Equal(t, []*p2p.NetAddress{a2, b, c}, book.BestAddresses(10))
+	assert.Equal(t, []*p2p.NetAddress{a2, b}, book.BestAddresses(2))
+	assert.Empty(t, book.BestAddresses(0))
+}
+
+func TestDBAddrBookSaveLoad(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	book := NewDBAddrBook(db, "", true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+
+	randAddrs := randNetAddressPairs(t, 100)
+	for _, addrSrc := range randAddrs {
+		require.NoError(t, book.AddAddress(addrSrc.addr, addrSrc.src))
+	}
+	good := randAddrs[0].addr
+	book.MarkGood(good.ID)
+	book.MarkLatency(good.ID, time.Second)
+	removed := randAddrs[1].addr
+	book.Save()
+	book.RemoveAddress(removed)
+	book.Save()
+	require.NoError(t, book.Stop())
+
+	book = NewDBAddrBook(db, "", true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+
+	assert.Equal(t, 99, book.Size())
+	assert.False(t, book.HasAddress(removed))
+	assert.True(t, book.IsGood(good))
+	assert.Equal(t, []*p2p.NetAddress{good}, book.BestAddresses(10))
+}
+
+func TestDBAddrBookMigratesFromFile(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	fileBook := NewAddrBook(fname, true)
+	fileBook.SetLogger(log.TestingLogger())
+	randAddrs := randNetAddressPairs(t, 10)
+	for _, addrSrc := range randAddrs {
+		require.NoError(t, fileBook.AddAddress(addrSrc.addr, addrSrc.src))
+	}
+	fileBook.MarkGood(randAddrs[0].addr.ID)
+	fileBook.Save()
+
+	db := dbm.NewMemDB()
+	book := NewDBAddrBook(db, fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	require.NoError(t, book.Stop())
+
+	assert.Equal(t, 10, book.Size())
+	assert.True(t, book.IsGood(randAddrs[0].addr))
+	assert.Equal(t, fileBook.(*addrBook).key, book.(*addrBook).key)
+
+	// The DB is used once migrated.
+	fileBook.RemoveAddress(randAddrs[0].addr)
+	fileBook.Save()
+
+	book = NewDBAddrBook(db, fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+
+	assert.Equal(t, 10, book.Size())
+}
+
 func TestAddrBookGroupKey(t *testing.T) {
 	// non-strict routability
 	testCases := []struct {
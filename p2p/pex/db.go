@@ -0,0 +1,145 @@
+package pex
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtrand "github.com/KYVENetwork/celestia-core/libs/rand"
+	"github.com/KYVENetwork/celestia-core/libs/service"
+	"github.com/KYVENetwork/celestia-core/p2p"
+)
+
+/* Loading & Saving to a DB */
+
+var (
+	addrBookKeyKey = []byte("key")
+	addrKeyPrefix  = []byte("addr:")
+)
+
+func addrKey(id p2p.ID) []byte {
+	return append(append([]byte{}, addrKeyPrefix...), []byte(id)...)
+}
+
+// NewDBAddrBook creates a new address book persisted in db, together with the
+// history of every address (see knownAddress). If db is empty and the JSON
+// address book at filePath exists, its addresses are migrated to db on start.
+// Use Start to begin processing asynchronous address updates.
+func NewDBAddrBook(db dbm.DB, filePath string, routabilityStrict bool) AddrBook {
+	am := &addrBook{
+		rand:              cmtrand.NewRand(),
+		ourAddrs:          make(map[string]struct{}),
+		privateIDs:        make(map[p2p.ID]struct{}),
+		addrLookup:        make(map[p2p.ID]*knownAddress),
+		badPeers:          make(map[p2p.ID]*knownAddress),
+		filePath:          filePath,
+		db:                db,
+		routabilityStrict: routabilityStrict,
+		hashKey:           newHashKey(),
+	}
+	am.init()
+	am.BaseService = *service.NewBaseService(nil, "AddrBook", am)
+	return am
+}
+
+func (a *addrBook) saveToDB() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.Logger.Info("Saving AddrBook to DB", "size", a.size())
+
+	if err := a.writeToDB(); err != nil {
+		a.Logger.Error("Failed to save AddrBook to DB", "err", err)
+	}
+}
+
+func (a *addrBook) writeToDB() error {
+	batch := a.db.NewBatch()
+	defer batch.Close()
+
+	// Delete the addresses which are no longer in the book.
+	iter, err := dbm.IteratePrefix(a.db, addrKeyPrefix)
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid(); iter.Next() {
+		id := p2p.ID(iter.Key()[len(addrKeyPrefix):])
+		if _, ok := a.addrLookup[id]; !ok {
+			if err := batch.Delete(iter.Key()); err != nil {
+				iter.Close()
+				return err
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if err := batch.Set(addrBookKeyKey, []byte(a.key)); err != nil {
+		return err
+	}
+	for id, ka := range a.addrLookup {
+		bz, err := json.Marshal(ka)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(addrKey(id), bz); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// Returns false if the book was never saved to the DB and there was no file
+// to migrate from.
+func (a *addrBook) loadFromDB() bool {
+	key, err := a.db.Get(addrBookKeyKey)
+	if err != nil {
+		panic(fmt.Sprintf("Error reading AddrBook key from DB: %v", err))
+	}
+	if key == nil {
+		return a.migrateFromFile()
+	}
+
+	iter, err := dbm.IteratePrefix(a.db, addrKeyPrefix)
+	if err != nil {
+		panic(fmt.Sprintf("Error reading AddrBook from DB: %v", err))
+	}
+	defer iter.Close()
+
+	addrs := make([]*knownAddress, 0)
+	for ; iter.Valid(); iter.Next() {
+		ka := &knownAddress{}
+		if err := json.Unmarshal(iter.Value(), ka); err != nil {
+			panic(fmt.Sprintf("Error reading address %X from DB: %v", iter.Key(), err))
+		}
+		addrs = append(addrs, ka)
+	}
+	if err := iter.Error(); err != nil {
+		panic(fmt.Sprintf("Error reading AddrBook from DB: %v", err))
+	}
+
+	a.restore(string(key), addrs)
+	return true
+}
+
+// migrateFromFile loads the addresses from the JSON address book, if it
+// exists, and saves them to the DB.
+func (a *addrBook) migrateFromFile() bool {
+	if a.filePath == "" {
+		return false
+	}
+	if _, err := os.Stat(a.filePath); os.IsNotExist(err) {
+		return false
+	}
+
+	a.loadFromFile(a.filePath)
+	a.Logger.Info("Migrating AddrBook from file to DB", "file", a.filePath, "size", a.size())
+	if err := a.writeToDB(); err != nil {
+		panic(fmt.Sprintf("Error migrating AddrBook to DB: %v", err))
+	}
+	return true
+}
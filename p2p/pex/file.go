@@ -64,10 +64,17 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 	}
 
 	// Restore all the fields...
+	a.restore(aJSON.Key, aJSON.Addrs)
+	return true
+}
+
+// restore restores the key and the buckets of the book from the persisted
+// addresses.
+func (a *addrBook) restore(key string, addrs []*knownAddress) {
 	// Restore the key
-	a.key = aJSON.Key
+	a.key = key
 	// Restore .bucketsNew & .bucketsOld
-	for _, ka := range aJSON.Addrs {
+	for _, ka := range addrs {
 		for _, bucketIndex := range ka.Buckets {
 			bucket := a.getBucket(ka.BucketType, bucketIndex)
 			bucket[ka.Addr.String()] = ka
@@ -79,5 +86,4 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 			a.nOld++
 		}
 	}
-	return true
 }
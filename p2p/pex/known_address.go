@@ -17,6 +17,14 @@ type knownAddress struct {
 	LastAttempt time.Time       `json:"last_attempt"`
 	LastSuccess time.Time       `json:"last_success"`
 	LastBanTime time.Time       `json:"last_ban_time"`
+
+	// Latency is the time it took to dial and handshake with the address the
+	// last time it was dialed successfully.
+	Latency time.Duration `json:"latency"`
+	// LastFailure is the time of the last failed dial and LastFailureReason
+	// its error.
+	LastFailure       time.Time `json:"last_failure"`
+	LastFailureReason string    `json:"last_failure_reason,omitempty"`
 }
 
 func newKnownAddress(addr *p2p.NetAddress, src *p2p.NetAddress) *knownAddress {
@@ -55,6 +63,16 @@ func (ka *knownAddress) markGood() {
 	ka.LastSuccess = now
 }
 
+func (ka *knownAddress) markFailure(reason string) {
+	ka.markAttempt()
+	ka.LastFailure = ka.LastAttempt
+	ka.LastFailureReason = reason
+}
+
+func (ka *knownAddress) markLatency(latency time.Duration) {
+	ka.Latency = latency
+}
+
 func (ka *knownAddress) ban(banTime time.Duration) {
 	if ka.LastBanTime.Before(time.Now().Add(banTime)) {
 		ka.LastBanTime = time.Now().Add(banTime)
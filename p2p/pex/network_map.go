@@ -0,0 +1,106 @@
+package pex
+
+import (
+	"sort"
+	"time"
+
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	"github.com/KYVENetwork/celestia-core/p2p"
+)
+
+// NodeRecord is what a crawling seed node knows about a node of the network.
+type NodeRecord struct {
+	ID   p2p.ID          `json:"id"`
+	Addr *p2p.NetAddress `json:"addr"`
+	// NodeInfo is nil until the node was connected to.
+	NodeInfo *p2p.DefaultNodeInfo `json:"node_info,omitempty"`
+	// Reachable is true if the node was dialed successfully the last time we
+	// tried.
+	Reachable bool `json:"reachable"`
+	// The last time the node was connected to.
+	LastSeen time.Time `json:"last_seen"`
+	// The last time we crawled the node or attempted to do so.
+	LastCrawled time.Time `json:"last_crawled"`
+	// The error of the last failed attempt to dial the node.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// networkMap records the nodes discovered while crawling the network.
+// It is safe for concurrent use.
+type networkMap struct {
+	mtx   cmtsync.Mutex
+	nodes map[p2p.ID]*NodeRecord
+}
+
+func newNetworkMap() *networkMap {
+	return &networkMap{
+		nodes: make(map[p2p.ID]*NodeRecord),
+	}
+}
+
+func (m *networkMap) node(addr *p2p.NetAddress) *NodeRecord {
+	n, ok := m.nodes[addr.ID]
+	if !ok {
+		n = &NodeRecord{ID: addr.ID, Addr: addr}
+		m.nodes[addr.ID] = n
+	}
+	return n
+}
+
+// markCrawled records an attempt to crawl the node at addr, which failed if
+// err is not nil.
+func (m *networkMap) markCrawled(addr *p2p.NetAddress, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	n := m.node(addr)
+	n.LastCrawled = time.Now()
+	if err != nil {
+		n.Reachable = false
+		n.LastError = err.Error()
+	}
+}
+
+// markConnected records the NodeInfo of a node we are connected to. Only
+// outbound connections prove that the node is reachable at addr.
+func (m *networkMap) markConnected(addr *p2p.NetAddress, nodeInfo p2p.DefaultNodeInfo, outbound bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	n := m.node(addr)
+	n.Addr = addr
+	n.NodeInfo = &nodeInfo
+	n.LastSeen = time.Now()
+	if outbound {
+		n.Reachable = true
+		n.LastError = ""
+	}
+}
+
+// cleanup removes the nodes which were neither crawled nor seen since
+// maxAge.
+func (m *networkMap) cleanup(maxAge time.Duration) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for id, n := range m.nodes {
+		if time.Since(n.LastCrawled) > maxAge && time.Since(n.LastSeen) > maxAge {
+			delete(m.nodes, id)
+		}
+	}
+}
+
+// list returns a copy of the records, sorted by ID.
+func (m *networkMap) list() []NodeRecord {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	nodes := make([]NodeRecord, 0, len(m.nodes))
+	for _, n := range m.nodes {
+		nodes = append(nodes, *n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+	return nodes
+}
@@ -3,6 +3,7 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -43,6 +44,10 @@ const (
 	// check some peers every this
 	crawlPeerPeriod = 30 * time.Second
 
+	// defaultNetworkMapRetention is how long the records of the network map
+	// are kept after the node was last crawled or seen.
+	defaultNetworkMapRetention = 24 * time.Hour
+
 	maxAttemptsToDial = 16 // ~ 35h in total (last attempt - 18h)
 
 	// if node connects to seed, it does not have any trusted peers.
@@ -98,6 +103,7 @@ type Reactor struct {
 
 	// seed/crawled mode fields
 	crawlPeerInfos map[p2p.ID]crawlPeerInfo
+	networkMap     *networkMap
 }
 
 func (r *Reactor) minReceiveRequestInterval() time.Duration {
@@ -122,6 +128,11 @@ type ReactorConfig struct {
 	// Seeds is a list of addresses reactor may use
 	// if it can't connect to peers in the addrbook.
 	Seeds []string
+
+	// How long a seed keeps the record of a node in its network map after the
+	// node was last crawled or seen (if zero, defaultNetworkMapRetention is
+	// used).
+	NetworkMapRetention time.Duration
 }
 
 type _attemptsToDial struct {
@@ -138,6 +149,7 @@ func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
 		requestsSent:         cmap.NewCMap(),
 		lastReceivedRequests: cmap.NewCMap(),
 		crawlPeerInfos:       make(map[p2p.ID]crawlPeerInfo),
+		networkMap:           newNetworkMap(),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEX", r)
 	return r
@@ -192,6 +204,10 @@ func (r *Reactor) GetChannels() []*conn.ChannelDescriptor {
 // AddPeer implements Reactor by adding peer to the address book (if inbound)
 // or by requesting more addresses (if outbound).
 func (r *Reactor) AddPeer(p Peer) {
+	if r.config.SeedMode {
+		r.recordPeer(p)
+	}
+
 	if p.IsOutbound() {
 		// For outbound peers, the address is already in the books -
 		// either via DialPeersAsync or r.Receive.
@@ -217,6 +233,27 @@ func (r *Reactor) AddPeer(p Peer) {
 	}
 }
 
+// recordPeer records the NodeInfo of the peer in the network map.
+func (r *Reactor) recordPeer(p Peer) {
+	nodeInfo, ok := p.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok {
+		return
+	}
+	// The port an inbound peer connects from is not the one it listens on,
+	// but the address it reports listening on is not to be trusted either, so
+	// it is recorded under the IP it connects from and the port it reports.
+	addr := p.SocketAddr()
+	if !p.IsOutbound() {
+		listenAddr, err := nodeInfo.NetAddress()
+		if err != nil {
+			return
+		}
+		addr = p2p.NewNetAddressIPPort(p.RemoteIP(), listenAddr.Port)
+		addr.ID = nodeInfo.ID()
+	}
+	r.networkMap.markConnected(addr, nodeInfo, p.IsOutbound())
+}
+
 // RemovePeer implements Reactor by resetting peer's requests info.
 func (r *Reactor) RemovePeer(p Peer, reason interface{}) {
 	id := string(p.ID())
@@ -271,7 +308,7 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		} else {
 			// Check we're not receiving requests too frequently.
 			if err := r.receiveRequest(e.Src); err != nil {
-				r.Switch.StopPeerForError(e.Src, err)
+				r.Switch.StopPeerForFault(e.Src, err)
 				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 				return
 			}
@@ -282,7 +319,7 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		// If we asked for addresses, add them to the book
 		addrs, err := p2p.NetAddressesFromProto(msg.Addrs)
 		if err != nil {
-			r.Switch.StopPeerForError(e.Src, err)
+			r.Switch.StopPeerForFault(e.Src, err)
 			r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 			return
 		}
@@ -491,26 +528,34 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := cmtmath.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
-	maxAttempts := numToDial * 3
-
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	// Try maxAttempts times to pick twice as many candidates as addresses to
+	// dial, and dial the ones of the peers with the highest trust scores.
+	var (
+		candidates  []*p2p.NetAddress
+		selected    = make(map[p2p.ID]bool)
+		maxAttempts = numToDial * 3
+	)
+	for i := 0; i < maxAttempts && len(candidates) < 2*numToDial; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if selected[try.ID] {
 			continue
 		}
-		if r.Switch.IsDialingOrExistingAddress(try) {
+		if r.Switch.IsDialingOrExistingAddress(try) || r.Switch.IsPeerBanned(try) {
 			continue
 		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialling again, or have dialed too many times already
-		toDial[try.ID] = try
+		selected[try.ID] = true
+		candidates = append(candidates, try)
 	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return r.Switch.PeerTrustScore(candidates[i].ID) > r.Switch.PeerTrustScore(candidates[j].ID)
+	})
+	toDial := candidates[:cmtmath.MinInt(numToDial, len(candidates))]
 
 	// Dial picked addresses
 	for _, addr := range toDial {
@@ -580,6 +625,7 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 		}
 	}
 
+	start := time.Now()
 	err := r.Switch.DialPeerWithAddress(addr)
 	if err != nil {
 		if _, ok := err.(p2p.ErrCurrentlyDialingOrExistingAddress); ok {
@@ -597,6 +643,8 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 		return fmt.Errorf("dialing failed (attempts: %d): %w", attempts+1, err)
 	}
 
+	r.book.MarkLatency(addr.ID, time.Since(start))
+
 	// cleanup any history
 	r.attemptsToDial.Delete(addr.DialString())
 	return nil
@@ -734,10 +782,12 @@ func (r *Reactor) crawlPeers(addrs []*p2p.NetAddress) {
 			case errMaxAttemptsToDial, errTooEarlyToDial, p2p.ErrCurrentlyDialingOrExistingAddress:
 				r.Logger.Debug(err.Error(), "addr", addr)
 			default:
+				r.networkMap.markCrawled(addr, err)
 				r.Logger.Debug(err.Error(), "addr", addr)
 			}
 			continue
 		}
+		r.networkMap.markCrawled(addr, nil)
 
 		peer := r.Switch.Peers().Get(addr.ID)
 		if peer != nil {
@@ -758,6 +808,18 @@ func (r *Reactor) cleanupCrawlPeerInfos() {
 			delete(r.crawlPeerInfos, id)
 		}
 	}
+	retention := r.config.NetworkMapRetention
+	if retention == 0 {
+		retention = defaultNetworkMapRetention
+	}
+	r.networkMap.cleanup(retention)
+}
+
+// NetworkMap returns the nodes discovered while crawling the network in
+// seed mode, together with their NodeInfo and reachability. It is empty if
+// the reactor is not in seed mode.
+func (r *Reactor) NetworkMap() []NodeRecord {
+	return r.networkMap.list()
 }
 
 // attemptDisconnects checks if we've been with each peer long enough to disconnect
@@ -779,6 +841,6 @@ func markAddrInBookBasedOnErr(addr *p2p.NetAddress, book AddrBook, err error) {
 	case p2p.ErrSwitchAuthenticationFailure:
 		book.MarkBad(addr, defaultBanTime)
 	default:
-		book.MarkAttempt(addr)
+		book.MarkFailure(addr, err)
 	}
 }
@@ -3,6 +3,7 @@ package pex
 import (
 	"encoding/hex"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/KYVENetwork/celestia-core/config"
 	"github.com/KYVENetwork/celestia-core/libs/log"
+	cmtrand "github.com/KYVENetwork/celestia-core/libs/rand"
 	"github.com/KYVENetwork/celestia-core/p2p"
 	"github.com/KYVENetwork/celestia-core/p2p/mock"
 	tmp2p "github.com/KYVENetwork/celestia-core/proto/celestiacore/p2p"
@@ -332,6 +334,80 @@ func TestPEXReactorSeedMode(t *testing.T) {
 	assert.Equal(t, 0, sw.Peers().Size())
 }
 
+func TestPEXReactorSeedModeNetworkMap(t *testing.T) {
+	// directory to store address books
+	dir, err := os.MkdirTemp("", "pex_reactor")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	pexR, book := createReactor(&ReactorConfig{SeedMode: true, SeedDisconnectWaitPeriod: time.Minute})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(pexR)
+	sw.SetAddrBook(book)
+	err = sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop() //nolint:errcheck // ignore for tests
+
+	peerSwitch := testCreateDefaultPeer(dir, 1)
+	require.NoError(t, peerSwitch.Start())
+	defer peerSwitch.Stop() //nolint:errcheck // ignore for tests
+
+	// nothing listens on this address
+	unreachable := p2p.NewNetAddressIPPort(net.ParseIP("127.0.0.1"), 1)
+	unreachable.ID = p2p.ID(hex.EncodeToString(cmtrand.Bytes(p2p.IDByteLength)))
+
+	pexR.crawlPeers([]*p2p.NetAddress{peerSwitch.NetAddress(), unreachable})
+
+	nodes := pexR.NetworkMap()
+	require.Len(t, nodes, 2)
+	for _, node := range nodes {
+		assert.False(t, node.LastCrawled.IsZero())
+		switch node.ID {
+		case peerSwitch.NodeInfo().ID():
+			assert.True(t, node.Reachable)
+			assert.False(t, node.LastSeen.IsZero())
+			require.NotNil(t, node.NodeInfo)
+			assert.Equal(t, peerSwitch.NodeInfo(), *node.NodeInfo)
+		case unreachable.ID:
+			assert.False(t, node.Reachable)
+			assert.Nil(t, node.NodeInfo)
+			assert.NotEmpty(t, node.LastError)
+		default:
+			t.Fatalf("unexpected node %v", node.ID)
+		}
+	}
+
+	// Records of nodes neither crawled nor seen for too long are removed.
+	pexR.networkMap.cleanup(0)
+	assert.Empty(t, pexR.NetworkMap())
+}
+
+// spoofingPeer is an inbound peer reporting to listen on another IP.
+type spoofingPeer struct {
+	*mock.Peer
+}
+
+func (p spoofingPeer) NodeInfo() p2p.NodeInfo {
+	return p2p.DefaultNodeInfo{DefaultNodeID: p.ID(), ListenAddr: "1.2.3.4:26656"}
+}
+
+func TestPEXReactorSeedModeRecordsInboundPeerIP(t *testing.T) {
+	pexR, book := createReactor(&ReactorConfig{SeedMode: true})
+	defer teardownReactor(book)
+
+	// the peer is recorded under the IP it connects from, and the port it
+	// reports listening on
+	peer := spoofingPeer{mock.NewPeer(net.ParseIP("5.6.7.8"))}
+	pexR.recordPeer(peer)
+
+	nodes := pexR.NetworkMap()
+	require.Len(t, nodes, 1)
+	assert.Equal(t, peer.ID(), nodes[0].ID)
+	assert.Equal(t, "5.6.7.8:26656", nodes[0].Addr.DialString())
+	assert.False(t, nodes[0].Reachable)
+}
+
 func TestPEXReactorDoesNotDisconnectFromPersistentPeerInSeedMode(t *testing.T) {
 	// directory to store address books
 	dir, err := os.MkdirTemp("", "pex_reactor")
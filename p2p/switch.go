@@ -13,6 +13,7 @@ import (
 	"github.com/KYVENetwork/celestia-core/p2p/conn"
 	"github.com/KYVENetwork/celestia-core/pkg/trace"
 	"github.com/KYVENetwork/celestia-core/pkg/trace/schema"
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -40,6 +41,9 @@ func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
+	// NOTE: ChannelSendRates and ChannelRecvRates are checked by P2PConfig.ValidateBasic
+	mConfig.ChannelSendRates, _ = cfg.ChannelSendRateLimits()
+	mConfig.ChannelRecvRates, _ = cfg.ChannelRecvRateLimits()
 	return mConfig
 }
 
@@ -93,6 +97,8 @@ type Switch struct {
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
+	peerManager *PeerManager
+
 	metrics     *Metrics
 	mlc         *metricsLabelCache
 	traceClient trace.Tracer
@@ -128,6 +134,7 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
+		peerManager:          NewPeerManager(dbm.NewMemDB(), cfg.PeerBanDuration),
 		mlc:                  newMetricsLabelCache(),
 		traceClient:          trace.NoOpTracer(),
 	}
@@ -163,6 +170,12 @@ func WithTracer(tracer trace.Tracer) SwitchOption {
 	return func(sw *Switch) { sw.traceClient = tracer }
 }
 
+// WithPeerManager sets the PeerManager tracking the trust scores of peers. By
+// default, the scores are only kept in memory.
+func WithPeerManager(peerManager *PeerManager) SwitchOption {
+	return func(sw *Switch) { sw.peerManager = peerManager }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -237,6 +250,11 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	// Load the trust history of peers before any of them connects.
+	if err := sw.peerManager.Start(); err != nil {
+		return fmt.Errorf("failed to start peer manager: %w", err)
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -265,6 +283,10 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "error", err)
 		}
 	}
+
+	if err := sw.peerManager.Stop(); err != nil {
+		sw.Logger.Error("error while stopping peer manager", "error", err)
+	}
 }
 
 //---------------------------------------------------------------------
@@ -397,6 +419,18 @@ func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	}
 }
 
+// StopPeerForFault lowers the trust score of the peer, like MarkPeerAsBad,
+// and disconnects from it like StopPeerForError. It is meant for protocol
+// faults of the peer, e.g. malformed or invalid messages, as opposed to
+// connection errors or slowness.
+func (sw *Switch) StopPeerForFault(peer Peer, reason interface{}) {
+	sw.peerManager.MarkBad(peer.ID())
+	if sw.banIfUntrusted(peer, reason) {
+		return
+	}
+	sw.StopPeerForError(peer, reason)
+}
+
 // StopPeerGracefully disconnects from a peer gracefully.
 // TODO: handle graceful disconnects.
 func (sw *Switch) StopPeerGracefully(peer Peer) {
@@ -404,6 +438,12 @@ func (sw *Switch) StopPeerGracefully(peer Peer) {
 	sw.stopAndRemovePeer(peer, nil)
 }
 
+// StopReconnecting stops reconnecting to the peer with the given ID, if a
+// reconnection is in progress. The reconnection stops before its next dial.
+func (sw *Switch) StopReconnecting(id ID) {
+	sw.reconnecting.Delete(string(id))
+}
+
 func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 	sw.transport.Cleanup(peer)
 	schema.WritePeerUpdate(sw.traceClient, string(peer.ID()), schema.PeerDisconnect, fmt.Sprintf("%v", reason))
@@ -446,7 +486,7 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 	start := time.Now()
 	sw.Logger.Info("Reconnecting to peer", "addr", addr)
 	for i := 0; i < reconnectAttempts; i++ {
-		if !sw.IsRunning() {
+		if !sw.IsRunning() || !sw.reconnecting.Has(string(addr.ID)) {
 			return
 		}
 
@@ -473,6 +513,9 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 		// sleep an exponentially increasing amount
 		sleepIntervalSeconds := math.Pow(reconnectBackOffBaseSeconds, float64(i))
 		sw.randomSleep(time.Duration(sleepIntervalSeconds) * time.Second)
+		if !sw.reconnecting.Has(string(addr.ID)) {
+			return
+		}
 
 		err := sw.DialPeerWithAddress(addr)
 		if err == nil {
@@ -493,11 +536,66 @@ func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 // MarkPeerAsGood marks the given peer as good when it did something useful
 // like contributed to consensus.
 func (sw *Switch) MarkPeerAsGood(peer Peer) {
+	sw.peerManager.MarkGood(peer.ID())
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.ID())
 	}
 }
 
+// MarkPeerAsBad lowers the trust score of the given peer when it sent us
+// something we could not use, like a tx failing the pre-check, without
+// disconnecting from it. Once its score falls below MinPeerTrustScore, the
+// peer is evicted and banned for PeerBanDuration, unless it is persistent or
+// unconditional.
+func (sw *Switch) MarkPeerAsBad(peer Peer, reason interface{}) {
+	sw.peerManager.MarkBad(peer.ID())
+	sw.banIfUntrusted(peer, reason)
+}
+
+// PeerTrustScore returns the trust score of the peer with the given ID, from
+// 0 to MaxTrustScore.
+func (sw *Switch) PeerTrustScore(id ID) int {
+	return sw.peerManager.Score(id)
+}
+
+// banIfUntrusted evicts and bans the peer if its trust score fell below
+// MinPeerTrustScore, and returns whether it did. Persistent and unconditional
+// peers are always trusted.
+func (sw *Switch) banIfUntrusted(peer Peer, reason interface{}) bool {
+	if peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+		return false
+	}
+	score := sw.peerManager.Score(peer.ID())
+	if score >= sw.config.MinPeerTrustScore {
+		return false
+	}
+
+	sw.peerManager.Ban(peer.ID())
+	if !peer.IsRunning() {
+		return true
+	}
+	sw.Logger.Info("Evicting untrusted peer", "peer", peer, "score", score, "err", reason)
+	sw.metrics.EvictedPeers.With("reason", "untrusted").Add(1)
+	sw.stopAndRemovePeer(peer, ErrSwitchUntrustedPeer{ID: peer.ID(), Score: score})
+	return true
+}
+
+// IsPeerBanned returns whether the peer at the address is currently banned for
+// its trust score, so that it is not dialed.
+func (sw *Switch) IsPeerBanned(addr *NetAddress) bool {
+	_, banned := sw.isPeerBanned(addr.ID, sw.IsPeerPersistent(addr))
+	return banned
+}
+
+// isPeerBanned returns the trust score of the peer and whether it is banned.
+// Persistent and unconditional peers are never banned.
+func (sw *Switch) isPeerBanned(id ID, persistent bool) (int, bool) {
+	if persistent || sw.IsPeerUnconditional(id) {
+		return 0, false
+	}
+	return sw.peerManager.Score(id), sw.peerManager.IsBanned(id)
+}
+
 //---------------------------------------------------------------------
 // Dialing
 
@@ -575,7 +673,8 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 			err := sw.DialPeerWithAddress(addr)
 			if err != nil {
 				switch err.(type) {
-				case ErrSwitchConnectToSelf, ErrSwitchDuplicatePeerID, ErrCurrentlyDialingOrExistingAddress:
+				case ErrSwitchConnectToSelf, ErrSwitchDuplicatePeerID, ErrCurrentlyDialingOrExistingAddress,
+					ErrSwitchUntrustedPeer:
 					sw.Logger.Debug("Error dialing peer", "err", err)
 				default:
 					sw.Logger.Error("Error dialing peer", "err", err)
@@ -588,12 +687,17 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 // DialPeerWithAddress dials the given peer and runs sw.addPeer if it connects
 // and authenticates successfully.
 // If we're currently dialing this address or it belongs to an existing peer,
-// ErrCurrentlyDialingOrExistingAddress is returned.
+// ErrCurrentlyDialingOrExistingAddress is returned. Banned peers (see
+// MarkPeerAsBad) are not dialed.
 func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
 
+	if score, banned := sw.isPeerBanned(addr.ID, sw.IsPeerPersistent(addr)); banned {
+		return ErrSwitchUntrustedPeer{ID: addr.ID, Score: score}
+	}
+
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
 
@@ -732,7 +836,7 @@ func (sw *Switch) acceptRoutine() {
 		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) {
 			// Ignore connection if we already have enough peers.
 			_, in, _ := sw.NumPeers()
-			if in >= sw.config.MaxNumInboundPeers {
+			if in >= sw.config.MaxNumInboundPeers && !sw.evictInboundPeer(p) {
 				sw.Logger.Info(
 					"Ignoring inbound connection: already have enough inbound peers",
 					"address", p.SocketAddr(),
@@ -825,6 +929,10 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if score, banned := sw.isPeerBanned(p.ID(), p.IsPersistent()); banned {
+		return ErrRejected{id: p.ID(), err: ErrSwitchUntrustedPeer{ID: p.ID(), Score: score}, isFiltered: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
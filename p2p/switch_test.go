@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
@@ -485,6 +486,68 @@ func TestSwitchStopPeerForError(t *testing.T) {
 
 	assert.Equal(t, len(sw1.Peers().List()), 0)
 	assert.EqualValues(t, 0, peersMetricValue())
+	// which is not a fault of the peer
+	assert.Equal(t, MaxTrustScore, sw1.PeerTrustScore(p.ID()))
+}
+
+func TestSwitchMarkPeerAsBadEvictsUntrustedPeer(t *testing.T) {
+	sw1, sw2 := MakeSwitchPair(t, func(i int, sw *Switch) *Switch {
+		if i == 0 {
+			c := *cfg
+			c.MinPeerTrustScore = 50
+			c.PeerBanDuration = 500 * time.Millisecond
+			sw.config = &c
+			sw.peerManager = NewPeerManager(dbm.NewMemDB(), c.PeerBanDuration)
+		}
+		return initSwitchFunc(i, sw)
+	})
+	t.Cleanup(func() {
+		if err := sw1.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	p := sw1.Peers().List()[0]
+	assert.Equal(t, MaxTrustScore, sw1.PeerTrustScore(p.ID()))
+
+	sw1.MarkPeerAsBad(p, errors.New("useless message"))
+	assert.Less(t, sw1.PeerTrustScore(p.ID()), 50)
+	assert.Equal(t, 0, sw1.Peers().Size())
+
+	// the untrusted peer is no longer dialed
+	addr := sw2.NetAddress()
+	err := sw1.DialPeerWithAddress(addr)
+	require.Error(t, err)
+	assert.IsType(t, ErrSwitchUntrustedPeer{}, err)
+
+	// until the ban expires
+	time.Sleep(500 * time.Millisecond)
+	_, banned := sw1.isPeerBanned(addr.ID, false)
+	assert.False(t, banned)
+}
+
+func TestSwitchStopPeerForFault(t *testing.T) {
+	sw1, sw2 := MakeSwitchPair(t, initSwitchFunc)
+	t.Cleanup(func() {
+		if err := sw1.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	p := sw1.Peers().List()[0]
+	sw1.StopPeerForFault(p, errors.New("malformed message"))
+	assert.Less(t, sw1.PeerTrustScore(p.ID()), MaxTrustScore)
+	assert.Equal(t, 0, sw1.Peers().Size())
+
+	// the peer isn't banned, as MinPeerTrustScore is 0
+	_, banned := sw1.isPeerBanned(p.ID(), false)
+	assert.False(t, banned)
 }
 
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
@@ -427,11 +427,27 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	nodeInfo, err = mt.handshakeAuthenticated(c, secretConn, PubKeyToID(secretConn.RemotePubKey()), dialedAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+// handshakeAuthenticated exchanges NodeInfo over an authenticated connection,
+// whose remote side was authenticated as connID, and checks it against the
+// dialed address and our own NodeInfo. c is the underlying connection.
+func (mt *MultiplexTransport) handshakeAuthenticated(
+	c net.Conn,
+	authConn net.Conn,
+	connID ID,
+	dialedAddr *NetAddress,
+) (NodeInfo, error) {
 	// For outgoing conns, ensure connection key matches dialed key.
-	connID := PubKeyToID(secretConn.RemotePubKey())
 	if dialedAddr != nil {
 		if dialedID := dialedAddr.ID; connID != dialedID {
-			return nil, nil, ErrRejected{
+			return nil, ErrRejected{
 				conn: c,
 				id:   connID,
 				err: fmt.Errorf(
@@ -444,9 +460,9 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	nodeInfo, err := handshake(authConn, mt.handshakeTimeout, mt.nodeInfo)
 	if err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:          c,
 			err:           fmt.Errorf("handshake failed: %v", err),
 			isAuthFailure: true,
@@ -454,7 +470,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -463,7 +479,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Ensure connection key matches self reported key.
 	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -477,7 +493,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Reject self.
 	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -486,7 +502,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -494,7 +510,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return nodeInfo, nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
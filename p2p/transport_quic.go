@@ -0,0 +1,401 @@
+package p2p
+
+import (
+	"context"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	"github.com/KYVENetwork/celestia-core/p2p/conn"
+)
+
+const (
+	// quicALPN is the application protocol negotiated on QUIC connections.
+	quicALPN = "celestia-core-p2p"
+
+	// quicDialTimeout is the timeout of the QUIC handshake when dialing, so
+	// that peers which don't support QUIC are quickly dialed over TCP instead.
+	quicDialTimeout = 2 * time.Second
+
+	// tcpPeerTTL is how long a peer which could only be dialed over TCP is
+	// dialed over TCP directly, before QUIC is tried again.
+	tcpPeerTTL = time.Hour
+)
+
+// quicConn adapts the control stream of a QUIC connection, on which the
+// peers authenticate and exchange their NodeInfo, to a net.Conn. Closing it
+// closes the whole QUIC connection.
+type quicConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+// LocalAddr implements net.Conn.
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// RemoteAddr implements net.Conn.
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// Close implements net.Conn.
+func (c *quicConn) Close() error {
+	return c.conn.CloseWithError(0, "")
+}
+
+// QUICTransport accepts and dials QUIC connections, on which every channel of
+// a peer is sent on its own stream (see conn.QUICConnection), so that large
+// messages on one channel do not delay the messages of the others. Peers
+// authenticate with their node key, bound to the TLS session of the
+// connection (see conn.AuthenticateQUICConnection).
+//
+// QUIC listens on the UDP port of the listen address. To stay interoperable
+// with peers which do not support QUIC, the embedded MultiplexTransport keeps
+// accepting TCP connections on the same port, and peers which can not be
+// dialed over QUIC within quicDialTimeout are dialed over TCP, for tcpPeerTTL.
+// The connection filters and limits of the MultiplexTransport apply to both.
+type QUICTransport struct {
+	*MultiplexTransport
+
+	listener   *quic.Listener
+	tlsConfig  *tls.Config
+	quicConfig *quic.Config
+
+	// Number of open incoming QUIC connections.
+	numIncoming int32
+
+	// Peers which could only be dialed over TCP, until when they are dialed
+	// over TCP directly.
+	mtx      cmtsync.Mutex
+	tcpPeers map[ID]time.Time
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC transport falling back to the given
+// MultiplexTransport for peers which only support TCP.
+func NewQUICTransport(mt *MultiplexTransport) *QUICTransport {
+	return &QUICTransport{
+		MultiplexTransport: mt,
+		quicConfig: &quic.Config{
+			HandshakeIdleTimeout: mt.handshakeTimeout,
+			MaxIdleTimeout:       mt.mConfig.PingInterval + mt.mConfig.PongTimeout,
+			KeepAlivePeriod:      mt.mConfig.PingInterval,
+			// The control stream only.
+			MaxIncomingStreams: 1,
+			// A stream per channel.
+			MaxIncomingUniStreams: 256,
+		},
+		tcpPeers: make(map[ID]time.Time),
+	}
+}
+
+// Dial implements Transport. It falls back to TCP if the peer can not be
+// dialed over QUIC.
+func (qt *QUICTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	if qt.isTCPPeer(addr.ID) {
+		return qt.MultiplexTransport.Dial(addr, cfg)
+	}
+
+	c, err := qt.dialQUIC(addr)
+	if err != nil {
+		p, tcpErr := qt.MultiplexTransport.Dial(addr, cfg)
+		if tcpErr != nil {
+			return nil, fmt.Errorf("quic: %v, tcp: %w", err, tcpErr)
+		}
+		qt.setTCPPeer(addr.ID)
+		return p, nil
+	}
+
+	if err := qt.filterConn(c); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgradeQUIC(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	err := qt.MultiplexTransport.Close()
+
+	if qt.listener != nil {
+		if qerr := qt.listener.Close(); err == nil {
+			err = qerr
+		}
+	}
+
+	return err
+}
+
+// Listen implements transportLifecycle. It listens for TCP and QUIC
+// connections on the address.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	tlsConfig, err := newQUICTLSConfig()
+	if err != nil {
+		return err
+	}
+	qt.tlsConfig = tlsConfig
+
+	if err := qt.MultiplexTransport.Listen(addr); err != nil {
+		return err
+	}
+
+	// Listen on the same port as TCP, which is only known once listening if
+	// the address has port 0.
+	port := qt.MultiplexTransport.listener.Addr().(*net.TCPAddr).Port
+	udpAddr := net.JoinHostPort(addr.IP.String(), strconv.Itoa(port))
+
+	ln, err := quic.ListenAddr(udpAddr, qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		_ = qt.MultiplexTransport.Close()
+		return err
+	}
+
+	qt.listener = ln
+
+	go qt.acceptQUICPeers()
+
+	return nil
+}
+
+func (qt *QUICTransport) acceptQUICPeers() {
+	for {
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- accept{err: err}
+			return
+		}
+
+		if !qt.trackIncoming(qc) {
+			_ = qc.CloseWithError(0, "too many connections")
+			continue
+		}
+
+		// Connection upgrade and filtering should be asynchronous to avoid
+		// Head-of-line blocking, see MultiplexTransport.acceptPeers.
+		go func(qc quic.Connection) {
+			defer func() {
+				if r := recover(); r != nil {
+					err := ErrRejected{
+						err:           fmt.Errorf("recovered from panic: %v", r),
+						isAuthFailure: true,
+					}
+					select {
+					case qt.acceptc <- accept{err: err}:
+					case <-qt.closec:
+						// Give up if the transport was closed.
+						_ = qc.CloseWithError(0, "")
+						return
+					}
+				}
+			}()
+
+			var (
+				c        *quicConn
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+			)
+
+			c, err := acceptQUICStream(qc, qt.handshakeTimeout)
+			if err == nil {
+				err = qt.filterConn(c)
+				if err == nil {
+					nodeInfo, err = qt.upgradeQUIC(c, nil)
+					if err == nil {
+						netAddr = NewNetAddress(nodeInfo.ID(), qc.RemoteAddr())
+					}
+				}
+			}
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = qc.CloseWithError(0, "")
+				return
+			}
+		}(qc)
+	}
+}
+
+// trackIncoming counts the incoming QUIC connection against the maximum
+// number of incoming connections until it is closed. It returns false if the
+// maximum is reached.
+func (qt *QUICTransport) trackIncoming(qc quic.Connection) bool {
+	if qt.maxIncomingConnections > 0 &&
+		atomic.AddInt32(&qt.numIncoming, 1) > int32(qt.maxIncomingConnections) {
+		atomic.AddInt32(&qt.numIncoming, -1)
+		return false
+	}
+
+	go func() {
+		<-qc.Context().Done()
+		atomic.AddInt32(&qt.numIncoming, -1)
+	}()
+
+	return true
+}
+
+func (qt *QUICTransport) dialQUIC(addr NetAddress) (*quicConn, error) {
+	if qt.tlsConfig == nil {
+		return nil, errors.New("quic transport is not listening")
+	}
+
+	timeout := qt.dialTimeout
+	if timeout > quicDialTimeout {
+		timeout = quicDialTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	qc, err := quic.DialAddr(ctx, addr.DialString(), qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := qc.OpenStreamSync(ctx)
+	if err != nil {
+		_ = qc.CloseWithError(0, "")
+		return nil, err
+	}
+
+	return &quicConn{Stream: stream, conn: qc}, nil
+}
+
+// upgradeQUIC authenticates the remote side of the QUIC connection and
+// exchanges NodeInfo with it.
+func (qt *QUICTransport) upgradeQUIC(
+	c *quicConn,
+	dialedAddr *NetAddress,
+) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	if err := c.SetDeadline(time.Now().Add(qt.handshakeTimeout)); err != nil {
+		return nil, err
+	}
+
+	remotePubKey, err := conn.AuthenticateQUICConnection(c.conn, c, qt.nodeKey.PrivKey)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("quic authentication failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	return qt.handshakeAuthenticated(c, c, PubKeyToID(remotePubKey), dialedAddr)
+}
+
+func (qt *QUICTransport) isTCPPeer(id ID) bool {
+	qt.mtx.Lock()
+	defer qt.mtx.Unlock()
+
+	until, ok := qt.tcpPeers[id]
+	if ok && !time.Now().Before(until) {
+		delete(qt.tcpPeers, id)
+		return false
+	}
+	return ok
+}
+
+// setTCPPeer dials the peer over TCP directly for tcpPeerTTL. The peers, for
+// which it has expired, are removed.
+func (qt *QUICTransport) setTCPPeer(id ID) {
+	qt.mtx.Lock()
+	defer qt.mtx.Unlock()
+
+	now := time.Now()
+	for peerID, until := range qt.tcpPeers {
+		if !now.Before(until) {
+			delete(qt.tcpPeers, peerID)
+		}
+	}
+	qt.tcpPeers[id] = now.Add(tcpPeerTTL)
+}
+
+// acceptQUICStream accepts the control stream opened by the dialing side.
+func acceptQUICStream(qc quic.Connection, timeout time.Duration) (*quicConn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stream, err := qc.AcceptStream(ctx)
+	if err != nil {
+		_ = qc.CloseWithError(0, "")
+		return nil, err
+	}
+
+	return &quicConn{Stream: stream, conn: qc}, nil
+}
+
+// newQUICTLSConfig returns the TLS config of QUIC connections. The
+// certificate is ephemeral and self-signed, as peers are authenticated with
+// their node key once the TLS session is established.
+func newQUICTLSConfig() (*tls.Config, error) {
+	pub, priv, err := stded25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{certDER},
+			PrivateKey:  priv,
+		}},
+		// Peers are authenticated with their node key instead.
+		InsecureSkipVerify: true, //nolint:gosec
+		NextProtos:         []string{quicALPN},
+		MinVersion:         tls.VersionTLS13,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/crypto/ed25519"
+)
+
+func testSetupQUICTransport(t *testing.T) *QUICTransport {
+	var (
+		pv = ed25519.GenPrivKey()
+		id = PubKeyToID(pv.PubKey())
+		qt = NewQUICTransport(newMultiplexTransport(
+			testNodeInfo(id, "transport"),
+			NodeKey{PrivKey: pv},
+		))
+	)
+
+	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+	t.Cleanup(func() { _ = qt.Close() })
+
+	return qt
+}
+
+func TestQUICTransportDialAccept(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := testSetupQUICTransport(t)
+
+	acceptc := make(chan Peer, 1)
+	errc := make(chan error, 1)
+	go func() {
+		p, err := qt.Accept(peerConfig{})
+		if err != nil {
+			errc <- err
+			return
+		}
+		acceptc <- p
+	}()
+
+	addr := NewNetAddress(qt.nodeKey.ID(), qt.listener.Addr())
+	p, err := dialer.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	defer p.CloseConn() //nolint:errcheck // ignore for tests
+
+	assert.Equal(t, qt.nodeKey.ID(), p.ID())
+	assert.True(t, p.IsOutbound())
+	assert.IsType(t, &net.UDPAddr{}, p.RemoteAddr())
+	assert.IsType(t, &quicConn{}, p.(*peer).peerConn.conn)
+
+	select {
+	case ap := <-acceptc:
+		assert.Equal(t, dialer.nodeKey.ID(), ap.ID())
+		assert.False(t, ap.IsOutbound())
+		assert.IsType(t, &net.UDPAddr{}, ap.RemoteAddr())
+	case err := <-errc:
+		t.Fatal(err)
+	}
+}
+
+func TestQUICTransportFallbackToTCP(t *testing.T) {
+	mt := testSetupMultiplexTransport(t)
+	defer mt.Close() //nolint:errcheck // ignore for tests
+	dialer := testSetupQUICTransport(t)
+
+	acceptc := make(chan Peer, 1)
+	go func() {
+		p, err := mt.Accept(peerConfig{})
+		if err != nil {
+			t.Error(err)
+		}
+		acceptc <- p
+	}()
+
+	addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+	p, err := dialer.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	defer p.CloseConn() //nolint:errcheck // ignore for tests
+
+	assert.Equal(t, mt.nodeKey.ID(), p.ID())
+	assert.IsType(t, &net.TCPAddr{}, p.RemoteAddr())
+	assert.True(t, dialer.isTCPPeer(mt.nodeKey.ID()))
+
+	// QUIC is tried again once the fallback has expired
+	dialer.mtx.Lock()
+	dialer.tcpPeers[mt.nodeKey.ID()] = time.Now()
+	dialer.mtx.Unlock()
+	assert.False(t, dialer.isTCPPeer(mt.nodeKey.ID()))
+
+	ap := <-acceptc
+	require.NotNil(t, ap)
+	assert.Equal(t, dialer.nodeKey.ID(), ap.ID())
+}
@@ -1,6 +1,7 @@
 package trust
 
 import (
+	"container/list"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -11,7 +12,13 @@ import (
 	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
 )
 
-const defaultStorePeriodicSaveInterval = 1 * time.Minute
+const (
+	defaultStorePeriodicSaveInterval = 1 * time.Minute
+
+	// DefaultStoreMaxSize is the default maximum number of trust metrics kept
+	// by a MetricStore.
+	DefaultStoreMaxSize = 10000
+)
 
 var trustMetricKey = []byte("trustMetricStore")
 
@@ -22,6 +29,13 @@ type MetricStore struct {
 	// Maps a Peer.Key to that peer's TrustMetric
 	peerMetrics map[string]*Metric
 
+	// Peer keys from the most to the least recently used, and their elements
+	recentKeys  *list.List
+	recentElems map[string]*list.Element
+
+	// Maximum number of trust metrics kept (0 - unbounded)
+	maxSize int
+
 	// Mutex that protects the map and history data file
 	mtx cmtsync.Mutex
 
@@ -38,6 +52,9 @@ type MetricStore struct {
 func NewTrustMetricStore(db dbm.DB, tmc MetricConfig) *MetricStore {
 	tms := &MetricStore{
 		peerMetrics: make(map[string]*Metric),
+		recentKeys:  list.New(),
+		recentElems: make(map[string]*list.Element),
+		maxSize:     DefaultStoreMaxSize,
 		db:          db,
 		config:      tmc,
 	}
@@ -78,6 +95,17 @@ func (tms *MetricStore) OnStop() {
 	tms.saveToDB()
 }
 
+// SetMaxSize sets the maximum number of trust metrics kept (0 - unbounded).
+// Once the store is full, the trust metric of the least recently used peer
+// key is stopped and removed to make room for a new one.
+// It must be called before Start.
+func (tms *MetricStore) SetMaxSize(size int) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	tms.maxSize = size
+}
+
 // Size returns the number of entries in the trust metric store
 func (tms *MetricStore) Size() int {
 	tms.mtx.Lock()
@@ -95,7 +123,7 @@ func (tms *MetricStore) AddPeerTrustMetric(key string, tm *Metric) {
 	if key == "" || tm == nil {
 		return
 	}
-	tms.peerMetrics[key] = tm
+	tms.addPeerTrustMetric(key, tm)
 }
 
 // GetPeerTrustMetric returns a trust metric by peer key
@@ -111,11 +139,23 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 			tms.Logger.Error("unable to start metric store", "error", err)
 		}
 		// The metric needs to be in the map
-		tms.peerMetrics[key] = tm
+		tms.addPeerTrustMetric(key, tm)
+	} else {
+		tms.recentKeys.MoveToFront(tms.recentElems[key])
 	}
 	return tm
 }
 
+// LookupPeerTrustMetric returns the trust metric of a peer key, without
+// creating one when the store has no history for the peer
+func (tms *MetricStore) LookupPeerTrustMetric(key string) (*Metric, bool) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	tm, ok := tms.peerMetrics[key]
+	return tm, ok
+}
+
 // PeerDisconnected pauses the trust metric associated with the peer identified by the key
 func (tms *MetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
@@ -143,6 +183,31 @@ func (tms *MetricStore) size() int {
 	return len(tms.peerMetrics)
 }
 
+// addPeerTrustMetric associates the trust metric with the peer key, as the most
+// recently used one, and evicts the least recently used trust metrics if the
+// store is full
+func (tms *MetricStore) addPeerTrustMetric(key string, tm *Metric) {
+	if elem, ok := tms.recentElems[key]; ok {
+		tms.recentKeys.MoveToFront(elem)
+		tms.peerMetrics[key] = tm
+		return
+	}
+
+	for tms.maxSize > 0 && tms.size() >= tms.maxSize {
+		elem := tms.recentKeys.Back()
+		evicted := tms.recentKeys.Remove(elem).(string)
+		delete(tms.recentElems, evicted)
+		if etm := tms.peerMetrics[evicted]; etm.IsRunning() {
+			if err := etm.Stop(); err != nil {
+				tms.Logger.Error("unable to stop evicted metric", "error", err)
+			}
+		}
+		delete(tms.peerMetrics, evicted)
+	}
+	tms.peerMetrics[key] = tm
+	tms.recentElems[key] = tms.recentKeys.PushFront(key)
+}
+
 /* Loading & Saving */
 /* Both loadFromDB and savetoDB assume the mutex has been acquired */
 
@@ -174,7 +239,7 @@ func (tms *MetricStore) loadFromDB() bool {
 		}
 		tm.Init(p)
 		// Load the peer trust metric into the store
-		tms.peerMetrics[key] = tm
+		tms.addPeerTrustMetric(key, tm)
 	}
 	return true
 }
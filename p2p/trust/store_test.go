@@ -161,3 +161,38 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	err = store.Stop()
 	require.NoError(t, err)
 }
+
+func TestTrustMetricStoreMaxSize(t *testing.T) {
+	historyDB, err := dbm.NewDB("", "memdb", "")
+	require.NoError(t, err)
+
+	store := NewTrustMetricStore(historyDB, DefaultConfig())
+	store.SetLogger(log.TestingLogger())
+	store.SetMaxSize(2)
+	err = store.Start()
+	require.NoError(t, err)
+
+	first := store.GetPeerTrustMetric("peer_1")
+	store.GetPeerTrustMetric("peer_2")
+	// peer_1 is used again, so peer_2 is the least recently used one
+	store.GetPeerTrustMetric("peer_1")
+	store.GetPeerTrustMetric("peer_3")
+
+	assert.Equal(t, 2, store.Size())
+	_, ok := store.LookupPeerTrustMetric("peer_2")
+	assert.False(t, ok)
+	tm, ok := store.LookupPeerTrustMetric("peer_1")
+	assert.True(t, ok)
+	assert.Equal(t, first, tm)
+
+	// the metric of an evicted peer is stopped
+	second := store.GetPeerTrustMetric("peer_2")
+	assert.Equal(t, 2, store.Size())
+	_, ok = store.LookupPeerTrustMetric("peer_1")
+	assert.False(t, ok)
+	assert.False(t, first.IsRunning())
+	assert.True(t, second.IsRunning())
+
+	err = store.Stop()
+	require.NoError(t, err)
+}
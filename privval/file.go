@@ -249,9 +249,10 @@ func (pv *FilePV) GetPubKey() (crypto.PubKey, error) {
 }
 
 // SignVote signs a canonical representation of the vote, along with the
-// chainID. Implements PrivValidator.
-func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote) error {
-	if err := pv.signVote(chainID, vote); err != nil {
+// chainID, and its extension if signExtension is true. Implements
+// PrivValidator.
+func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	if err := pv.signVote(chainID, vote, signExtension); err != nil {
 		return fmt.Errorf("error signing vote: %v", err)
 	}
 	return nil
@@ -300,7 +301,7 @@ func (pv *FilePV) String() string {
 // signVote checks if the vote is good to sign and sets the vote signature.
 // It may need to set the timestamp as well if the vote is otherwise the same as
 // a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
-func (pv *FilePV) signVote(chainID string, vote *cmtproto.Vote) error {
+func (pv *FilePV) signVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
 	height, round, step := vote.Height, vote.Round, voteToStep(vote)
 
 	lss := pv.LastSignState
@@ -312,6 +313,23 @@ func (pv *FilePV) signVote(chainID string, vote *cmtproto.Vote) error {
 
 	signBytes := types.VoteSignBytes(chainID, vote)
 
+	// Vote extensions are non-deterministic, so they are not covered by the
+	// double-sign protection and are signed on every call. Only precommits
+	// for a block may carry one, once vote extensions are enabled.
+	extendable := vote.Type == cmtproto.PrecommitType && len(vote.BlockID.Hash) != 0
+	switch {
+	case signExtension && extendable:
+		extSig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	case len(vote.Extension) > 0 && !extendable:
+		return errors.New("unexpected vote extension - extensions are only allowed in non-nil precommits")
+	case len(vote.Extension) > 0:
+		return errors.New("unexpected vote extension - vote extensions are not enabled")
+	}
+
 	// We might crash before writing to the wal,
 	// causing us to try to re-sign for the same HRS.
 	// If signbytes are the same, use the last signature.
@@ -57,7 +57,7 @@ func TestResetValidator(t *testing.T) {
 	randBytes := cmtrand.Bytes(tmhash.Size)
 	blockID := types.BlockID{Hash: randBytes, PartSetHeader: types.PartSetHeader{}}
 	vote := newVote(privVal.Key.Address, 0, height, round, voteType, blockID)
-	err = privVal.SignVote("mychainid", vote.ToProto())
+	err = privVal.SignVote("mychainid", vote.ToProto(), false)
 	assert.NoError(t, err, "expected no error signing vote")
 
 	// priv val after signing is not same as empty
@@ -183,11 +183,11 @@ func TestSignVote(t *testing.T) {
 	// sign a vote for first time
 	vote := newVote(privVal.Key.Address, 0, height, round, voteType, block1)
 	v := vote.ToProto()
-	err = privVal.SignVote("mychainid", v)
+	err = privVal.SignVote("mychainid", v, false)
 	assert.NoError(err, "expected no error signing vote")
 
 	// try to sign the same vote again; should be fine
-	err = privVal.SignVote("mychainid", v)
+	err = privVal.SignVote("mychainid", v, false)
 	assert.NoError(err, "expected no error on signing same vote")
 
 	// now try some bad votes
@@ -200,18 +200,50 @@ func TestSignVote(t *testing.T) {
 
 	for _, c := range cases {
 		cpb := c.ToProto()
-		err = privVal.SignVote("mychainid", cpb)
+		err = privVal.SignVote("mychainid", cpb, false)
 		assert.Error(err, "expected error on signing conflicting vote")
 	}
 
 	// try signing a vote with a different time stamp
 	sig := vote.Signature
 	vote.Timestamp = vote.Timestamp.Add(time.Duration(1000))
-	err = privVal.SignVote("mychainid", v)
+	err = privVal.SignVote("mychainid", v, false)
 	assert.NoError(err)
 	assert.Equal(sig, vote.Signature)
 }
 
+func TestSignVoteExtension(t *testing.T) {
+	tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := os.CreateTemp("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+
+	randbytes := cmtrand.Bytes(tmhash.Size)
+	block := types.BlockID{
+		Hash:          randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes},
+	}
+
+	// the extension is signed once vote extensions are enabled
+	vote := newVote(privVal.Key.Address, 0, 10, 0, cmtproto.PrecommitType, block)
+	vote.Extension = []byte("extension")
+	v := vote.ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", v, true))
+	vote.ExtensionSignature = v.ExtensionSignature
+	assert.NoError(t, vote.VerifyExtension("mychainid", privVal.Key.PubKey))
+
+	// but not before
+	v = newVote(privVal.Key.Address, 0, 11, 0, cmtproto.PrecommitType, block).ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", v, false))
+	assert.Empty(t, v.ExtensionSignature)
+
+	v = newVote(privVal.Key.Address, 0, 12, 0, cmtproto.PrecommitType, block).ToProto()
+	v.Extension = []byte("extension")
+	assert.Error(t, privVal.SignVote("mychainid", v, false))
+}
+
 func TestSignProposal(t *testing.T) {
 	assert := assert.New(t)
 
@@ -307,7 +339,7 @@ func TestDifferByTimestamp(t *testing.T) {
 		blockID := types.BlockID{Hash: randbytes, PartSetHeader: types.PartSetHeader{}}
 		vote := newVote(privVal.Key.Address, 0, height, round, voteType, blockID)
 		v := vote.ToProto()
-		err := privVal.SignVote("mychainid", v)
+		err := privVal.SignVote("mychainid", v, false)
 		assert.NoError(t, err, "expected no error signing vote")
 
 		signBytes := types.VoteSignBytes(chainID, v)
@@ -318,7 +350,7 @@ func TestDifferByTimestamp(t *testing.T) {
 		v.Timestamp = v.Timestamp.Add(time.Millisecond)
 		var emptySig []byte
 		v.Signature = emptySig
-		err = privVal.SignVote("mychainid", v)
+		err = privVal.SignVote("mychainid", v, false)
 		assert.NoError(t, err, "expected no error on signing same vote")
 
 		assert.Equal(t, timeStamp, v.Timestamp)
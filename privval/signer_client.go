@@ -90,8 +90,10 @@ func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
 	return pk, nil
 }
 
-// SignVote requests a remote signer to sign a vote
-func (sc *SignerClient) SignVote(chainID string, vote *cmtproto.Vote) error {
+// SignVote requests a remote signer to sign a vote. The remote signer protocol
+// has no way to skip the vote extension, so its signature is dropped from the
+// response if signExtension is false.
+func (sc *SignerClient) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
 	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.SignVoteRequest{Vote: vote, ChainId: chainID}))
 	if err != nil {
 		return err
@@ -106,6 +108,9 @@ func (sc *SignerClient) SignVote(chainID string, vote *cmtproto.Vote) error {
 	}
 
 	*vote = resp.Vote
+	if !signExtension {
+		vote.ExtensionSignature = nil
+	}
 
 	return nil
 }
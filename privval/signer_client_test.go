@@ -191,8 +191,8 @@ func TestSignerVote(t *testing.T) {
 			}
 		})
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 
 		assert.Equal(t, want.Signature, have.Signature)
 	}
@@ -237,8 +237,8 @@ func TestSignerVoteResetDeadline(t *testing.T) {
 
 		time.Sleep(testTimeoutReadWrite2o3)
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 		assert.Equal(t, want.Signature, have.Signature)
 
 		// TODO(jleni): Clarify what is actually being tested
@@ -246,8 +246,8 @@ func TestSignerVoteResetDeadline(t *testing.T) {
 		// This would exceed the deadline if it was not extended by the previous message
 		time.Sleep(testTimeoutReadWrite2o3)
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 		assert.Equal(t, want.Signature, have.Signature)
 	}
 }
@@ -298,8 +298,8 @@ func TestSignerVoteKeepAlive(t *testing.T) {
 		time.Sleep(testTimeoutReadWrite * 3)
 		tc.signerServer.Logger.Debug("TEST: Forced Wait DONE---------------------------------------------")
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 
 		assert.Equal(t, want.Signature, have.Signature)
 	}
@@ -378,13 +378,13 @@ func TestSignerSignVoteErrors(t *testing.T) {
 			}
 		})
 
-		err := tc.signerClient.SignVote(tc.chainID, vote.ToProto())
+		err := tc.signerClient.SignVote(tc.chainID, vote.ToProto(), false)
 		require.Equal(t, err.(*RemoteSignerError).Description, types.ErroringMockPVErr.Error())
 
-		err = tc.mockPV.SignVote(tc.chainID, vote.ToProto())
+		err = tc.mockPV.SignVote(tc.chainID, vote.ToProto(), false)
 		require.Error(t, err)
 
-		err = tc.signerClient.SignVote(tc.chainID, vote.ToProto())
+		err = tc.signerClient.SignVote(tc.chainID, vote.ToProto(), false)
 		require.Error(t, err)
 	}
 }
@@ -433,7 +433,7 @@ func TestSignerUnexpectedResponse(t *testing.T) {
 		ts := time.Now()
 		want := &types.Vote{Timestamp: ts, Type: cmtproto.PrecommitType}
 
-		e := tc.signerClient.SignVote(tc.chainID, want.ToProto())
+		e := tc.signerClient.SignVote(tc.chainID, want.ToProto(), false)
 		assert.EqualError(t, e, "empty response")
 	}
 }
@@ -57,7 +57,10 @@ func DefaultValidationRequestHandler(
 
 		vote := r.SignVoteRequest.Vote
 
-		err = privVal.SignVote(chainID, vote)
+		// The request does not tell whether vote extensions are enabled, so
+		// the extension is always signed; the client drops the signature if
+		// they are not.
+		err = privVal.SignVote(chainID, vote, true)
 		if err != nil {
 			res = mustWrapMsg(&privvalproto.SignedVoteResponse{
 				Vote: cmtproto.Vote{}, Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
@@ -115,7 +115,8 @@ func (m *NoBlockResponse) GetHeight() int64 {
 
 // BlockResponse returns block to the requested
 type BlockResponse struct {
-	Block *types.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Block     *types.Block          `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	ExtCommit *types.ExtendedCommit `protobuf:"bytes,2,opt,name=ext_commit,json=extCommit,proto3" json:"ext_commit,omitempty"`
 }
 
 func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
@@ -158,6 +159,13 @@ func (m *BlockResponse) GetBlock() *types.Block {
 	return nil
 }
 
+func (m *BlockResponse) GetExtCommit() *types.ExtendedCommit {
+	if m != nil {
+		return m.ExtCommit
+	}
+	return nil
+}
+
 // StatusRequest requests the status of a peer.
 type StatusRequest struct {
 }
@@ -386,31 +394,34 @@ func init() {
 }
 
 var fileDescriptor_9fd32b237f5ed27a = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0x9b, 0xb6, 0x17, 0x4e, 0x9b, 0x86, 0x9b, 0xc5, 0xb5, 0xba, 0x18, 0x24, 0x62,
-	0xad, 0x0b, 0x13, 0xa8, 0x5b, 0x17, 0x12, 0x10, 0x0a, 0x6a, 0x85, 0x08, 0x05, 0x45, 0x28, 0x49,
-	0x18, 0x9a, 0xd0, 0x36, 0x53, 0x33, 0x13, 0xc4, 0xb7, 0xf0, 0x41, 0x7c, 0x10, 0x97, 0x5d, 0xba,
-	0x94, 0xf6, 0x45, 0xa4, 0x33, 0x69, 0x4c, 0x02, 0xb1, 0xbb, 0xcc, 0xc9, 0x7f, 0xbe, 0xf3, 0xcf,
-	0x9f, 0x13, 0x38, 0xf2, 0xf1, 0x0c, 0x53, 0x16, 0xba, 0x3e, 0x89, 0xb1, 0xe5, 0xcd, 0x88, 0x3f,
-	0xf5, 0x03, 0x37, 0x8c, 0x2c, 0xf6, 0xba, 0xc0, 0xd4, 0x5c, 0xc4, 0x84, 0x11, 0x7d, 0x2f, 0x2f,
-	0x32, 0x7f, 0x44, 0x07, 0xa8, 0xd0, 0xcd, 0x5b, 0x04, 0x43, 0x34, 0x1a, 0x5d, 0x68, 0xd9, 0x9b,
-	0xa3, 0x83, 0x9f, 0x13, 0x4c, 0x99, 0xfe, 0x1f, 0x1a, 0x01, 0x0e, 0x27, 0x01, 0xeb, 0xc8, 0x87,
-	0x72, 0x4f, 0x71, 0xd2, 0x93, 0x71, 0x0a, 0xda, 0x90, 0xa4, 0x4a, 0xba, 0x20, 0x11, 0xc5, 0x95,
-	0xd2, 0x4b, 0x50, 0x8b, 0x42, 0x0b, 0xea, 0x7c, 0x24, 0xd7, 0x35, 0xfb, 0xfb, 0x66, 0xc1, 0xac,
-	0xb8, 0x86, 0xe8, 0x10, 0x3a, 0x43, 0x03, 0xf5, 0x9e, 0xb9, 0x2c, 0xa1, 0xa9, 0x2b, 0xe3, 0x02,
-	0xda, 0xdb, 0xc2, 0xef, 0xc3, 0x75, 0x1d, 0x6a, 0x9e, 0x4b, 0x71, 0xe7, 0x0f, 0xaf, 0xf2, 0x67,
-	0xe3, 0x5d, 0x81, 0xbf, 0xb7, 0x98, 0x52, 0x77, 0x82, 0xf5, 0x1b, 0x50, 0xf9, 0x8c, 0x71, 0x2c,
-	0xd0, 0xa9, 0xa7, 0x63, 0xb3, 0x22, 0x40, 0x33, 0x9f, 0xce, 0x40, 0x72, 0x5a, 0x5e, 0x3e, 0xad,
-	0x11, 0xfc, 0x8b, 0xc8, 0x78, 0x0b, 0x14, 0xd6, 0xf8, 0xe8, 0x66, 0xbf, 0x57, 0x49, 0x2c, 0xe5,
-	0x38, 0x90, 0x1c, 0x2d, 0x2a, 0x45, 0x7b, 0x07, 0xed, 0x12, 0x54, 0xe1, 0xd0, 0xee, 0x2e, 0x9b,
-	0x19, 0x52, 0xf5, 0xca, 0x40, 0xca, 0x03, 0xcc, 0xee, 0x5d, 0xdb, 0x01, 0x2c, 0x7c, 0x80, 0x0d,
-	0x90, 0xe6, 0x0b, 0xba, 0x03, 0x5a, 0x06, 0x4c, 0x2d, 0xd6, 0x39, 0xf1, 0x64, 0x27, 0x31, 0xf3,
-	0xd8, 0xa6, 0x85, 0x8a, 0x5d, 0x07, 0x85, 0x26, 0x73, 0xfb, 0xe9, 0x63, 0x85, 0xe4, 0xe5, 0x0a,
-	0xc9, 0x5f, 0x2b, 0x24, 0xbf, 0xad, 0x91, 0xb4, 0x5c, 0x23, 0xe9, 0x73, 0x8d, 0xa4, 0x47, 0x7b,
-	0x12, 0xb2, 0x20, 0xf1, 0x4c, 0x9f, 0xcc, 0xad, 0xeb, 0x87, 0xd1, 0xd5, 0x10, 0xb3, 0x17, 0x12,
-	0x4f, 0xad, 0xed, 0xc4, 0x33, 0xbe, 0xe4, 0x7c, 0xb1, 0xad, 0x8a, 0xbf, 0xc6, 0x6b, 0xf0, 0xd7,
-	0xe7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xc4, 0x9e, 0xa3, 0x57, 0x03, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x8e, 0x93, 0x40,
+	0x1c, 0xc6, 0x41, 0xda, 0x1a, 0xff, 0x96, 0x12, 0x39, 0x68, 0xf5, 0x40, 0x0c, 0xc6, 0x5a, 0x0f,
+	0x42, 0xa2, 0x57, 0x2f, 0x62, 0x9a, 0x34, 0x51, 0x6b, 0x82, 0x49, 0x13, 0x8d, 0x49, 0x03, 0xf4,
+	0x9f, 0x42, 0x5a, 0x98, 0xca, 0x0c, 0xb1, 0x9e, 0x7d, 0x81, 0x7d, 0x90, 0x7d, 0x90, 0x3d, 0xf6,
+	0xb8, 0xc7, 0x4d, 0xfb, 0x22, 0x9b, 0xce, 0x50, 0x16, 0x48, 0xd9, 0xde, 0x60, 0xe6, 0xfb, 0x7e,
+	0xf3, 0x7d, 0xf3, 0xcf, 0xc0, 0xab, 0x00, 0x57, 0x48, 0x59, 0xe4, 0x05, 0x24, 0x45, 0xdb, 0x5f,
+	0x91, 0x60, 0x19, 0x84, 0x5e, 0x94, 0xd8, 0xec, 0xdf, 0x1a, 0xa9, 0xb5, 0x4e, 0x09, 0x23, 0xfa,
+	0xb3, 0xb2, 0xc8, 0xba, 0x13, 0xbd, 0x30, 0x2a, 0x6e, 0x6e, 0x11, 0x0c, 0x61, 0x3c, 0xb9, 0x5f,
+	0x02, 0x9b, 0x03, 0xe8, 0x3a, 0x07, 0xb9, 0x8b, 0x7f, 0x32, 0xa4, 0x4c, 0x7f, 0x0a, 0x9d, 0x10,
+	0xa3, 0x45, 0xc8, 0xfa, 0xf2, 0x4b, 0x79, 0xa8, 0xb8, 0xf9, 0x9f, 0xf9, 0x16, 0xb4, 0x09, 0xc9,
+	0x95, 0x74, 0x4d, 0x12, 0x8a, 0x8d, 0xd2, 0xff, 0x32, 0xa8, 0x55, 0xa5, 0x0d, 0x6d, 0x9e, 0x89,
+	0x0b, 0x1f, 0xbf, 0x7f, 0x6e, 0x55, 0xda, 0x88, 0x38, 0xc2, 0x21, 0x74, 0xfa, 0x27, 0x00, 0xdc,
+	0xb0, 0x59, 0x40, 0xe2, 0x38, 0x62, 0xfd, 0x07, 0xdc, 0x65, 0x9e, 0x72, 0x8d, 0x36, 0x0c, 0x93,
+	0x39, 0xce, 0x3f, 0x73, 0xa5, 0xfb, 0x08, 0x37, 0x4c, 0x7c, 0x9a, 0x1a, 0xa8, 0x3f, 0x98, 0xc7,
+	0x32, 0x9a, 0x37, 0x33, 0x3f, 0x42, 0xef, 0xb8, 0x70, 0x7f, 0x01, 0x5d, 0x87, 0x96, 0xef, 0x51,
+	0xe4, 0xe7, 0x2a, 0x2e, 0xff, 0x36, 0x2f, 0x15, 0x78, 0xf8, 0x0d, 0x29, 0xf5, 0x16, 0xa8, 0x7f,
+	0x05, 0x95, 0xc7, 0x9c, 0xa5, 0x02, 0x9d, 0xd7, 0x7a, 0x6d, 0x35, 0x0c, 0xc9, 0x2a, 0xdf, 0xf0,
+	0x58, 0x72, 0xbb, 0x7e, 0xf9, 0xc6, 0xa7, 0xf0, 0x24, 0x21, 0xb3, 0x23, 0x50, 0x44, 0xcb, 0x2b,
+	0x0f, 0x1b, 0x89, 0xb5, 0x59, 0x8c, 0x25, 0x57, 0x4b, 0x6a, 0xe3, 0xf9, 0x0e, 0xbd, 0x1a, 0x54,
+	0xe1, 0xd0, 0xc1, 0xb9, 0x98, 0x05, 0x52, 0xf5, 0xeb, 0x40, 0xca, 0x2f, 0xb0, 0xe8, 0xdd, 0x3a,
+	0x03, 0xac, 0x0c, 0xe0, 0x00, 0xa4, 0xe5, 0x05, 0xdd, 0x05, 0xad, 0x00, 0xe6, 0x11, 0xdb, 0x9c,
+	0xf8, 0xe6, 0x2c, 0xb1, 0xc8, 0xd8, 0xa3, 0x95, 0x15, 0xa7, 0x0d, 0x0a, 0xcd, 0x62, 0xe7, 0xf7,
+	0xd5, 0xce, 0x90, 0xb7, 0x3b, 0x43, 0xbe, 0xd9, 0x19, 0xf2, 0xc5, 0xde, 0x90, 0xb6, 0x7b, 0x43,
+	0xba, 0xde, 0x1b, 0xd2, 0x2f, 0x67, 0x11, 0xb1, 0x30, 0xf3, 0xad, 0x80, 0xc4, 0xf6, 0x97, 0x9f,
+	0xd3, 0xd1, 0x04, 0xd9, 0x5f, 0x92, 0x2e, 0xed, 0xe3, 0x89, 0xef, 0xf8, 0x43, 0xe1, 0x8f, 0xc3,
+	0x6e, 0x78, 0x99, 0x7e, 0x87, 0x6f, 0x7f, 0xb8, 0x1d, 0x00, 0x69, 0xfb, 0x9c, 0x9f, 0xbb, 0x03,
+	0x00, 0x00,
 }
 
 func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
@@ -489,6 +500,18 @@ func (m *BlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtCommit != nil {
+		{
+			size, err := m.ExtCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Block != nil {
 		{
 			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
@@ -742,6 +765,10 @@ func (m *BlockResponse) Size() (n int) {
 		l = m.Block.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ExtCommit != nil {
+		l = m.ExtCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1051,6 +1078,42 @@ func (m *BlockResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtCommit == nil {
+				m.ExtCommit = &types.ExtendedCommit{}
+			}
+			if err := m.ExtCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
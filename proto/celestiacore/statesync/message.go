@@ -11,6 +11,8 @@ var _ p2p.Wrapper = &ChunkRequest{}
 var _ p2p.Wrapper = &ChunkResponse{}
 var _ p2p.Wrapper = &SnapshotsRequest{}
 var _ p2p.Wrapper = &SnapshotsResponse{}
+var _ p2p.Wrapper = &NodeSnapshotRequest{}
+var _ p2p.Wrapper = &NodeSnapshotResponse{}
 
 func (m *SnapshotsResponse) Wrap() proto.Message {
 	sm := &Message{}
@@ -36,6 +38,18 @@ func (m *ChunkRequest) Wrap() proto.Message {
 	return sm
 }
 
+func (m *NodeSnapshotRequest) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_NodeSnapshotRequest{NodeSnapshotRequest: m}
+	return sm
+}
+
+func (m *NodeSnapshotResponse) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_NodeSnapshotResponse{NodeSnapshotResponse: m}
+	return sm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped state sync
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -52,6 +66,12 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_SnapshotsResponse:
 		return m.GetSnapshotsResponse(), nil
 
+	case *Message_NodeSnapshotRequest:
+		return m.GetNodeSnapshotRequest(), nil
+
+	case *Message_NodeSnapshotResponse:
+		return m.GetNodeSnapshotResponse(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
@@ -5,6 +5,7 @@ package statesync
 
 import (
 	fmt "fmt"
+	types "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,11 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Message struct {
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*Message_SnapshotsRequest
 	//	*Message_SnapshotsResponse
 	//	*Message_ChunkRequest
 	//	*Message_ChunkResponse
+	//	*Message_NodeSnapshotRequest
+	//	*Message_NodeSnapshotResponse
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -83,11 +85,19 @@ type Message_ChunkRequest struct {
 type Message_ChunkResponse struct {
 	ChunkResponse *ChunkResponse `protobuf:"bytes,4,opt,name=chunk_response,json=chunkResponse,proto3,oneof" json:"chunk_response,omitempty"`
 }
+type Message_NodeSnapshotRequest struct {
+	NodeSnapshotRequest *NodeSnapshotRequest `protobuf:"bytes,5,opt,name=node_snapshot_request,json=nodeSnapshotRequest,proto3,oneof" json:"node_snapshot_request,omitempty"`
+}
+type Message_NodeSnapshotResponse struct {
+	NodeSnapshotResponse *NodeSnapshotResponse `protobuf:"bytes,6,opt,name=node_snapshot_response,json=nodeSnapshotResponse,proto3,oneof" json:"node_snapshot_response,omitempty"`
+}
 
-func (*Message_SnapshotsRequest) isMessage_Sum()  {}
-func (*Message_SnapshotsResponse) isMessage_Sum() {}
-func (*Message_ChunkRequest) isMessage_Sum()      {}
-func (*Message_ChunkResponse) isMessage_Sum()     {}
+func (*Message_SnapshotsRequest) isMessage_Sum()     {}
+func (*Message_SnapshotsResponse) isMessage_Sum()    {}
+func (*Message_ChunkRequest) isMessage_Sum()         {}
+func (*Message_ChunkResponse) isMessage_Sum()        {}
+func (*Message_NodeSnapshotRequest) isMessage_Sum()  {}
+func (*Message_NodeSnapshotResponse) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -124,6 +134,20 @@ func (m *Message) GetChunkResponse() *ChunkResponse {
 	return nil
 }
 
+func (m *Message) GetNodeSnapshotRequest() *NodeSnapshotRequest {
+	if x, ok := m.GetSum().(*Message_NodeSnapshotRequest); ok {
+		return x.NodeSnapshotRequest
+	}
+	return nil
+}
+
+func (m *Message) GetNodeSnapshotResponse() *NodeSnapshotResponse {
+	if x, ok := m.GetSum().(*Message_NodeSnapshotResponse); ok {
+		return x.NodeSnapshotResponse
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -131,6 +155,8 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_SnapshotsResponse)(nil),
 		(*Message_ChunkRequest)(nil),
 		(*Message_ChunkResponse)(nil),
+		(*Message_NodeSnapshotRequest)(nil),
+		(*Message_NodeSnapshotResponse)(nil),
 	}
 }
 
@@ -382,12 +408,171 @@ func (m *ChunkResponse) GetMissing() bool {
 	return false
 }
 
+type NodeSnapshotRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *NodeSnapshotRequest) Reset()         { *m = NodeSnapshotRequest{} }
+func (m *NodeSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeSnapshotRequest) ProtoMessage()    {}
+func (*NodeSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108471f26748cd58, []int{5}
+}
+func (m *NodeSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSnapshotRequest.Merge(m, src)
+}
+func (m *NodeSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSnapshotRequest proto.InternalMessageInfo
+
+func (m *NodeSnapshotRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type NodeSnapshotResponse struct {
+	Height uint64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Blocks []*NodeSnapshotBlock `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *NodeSnapshotResponse) Reset()         { *m = NodeSnapshotResponse{} }
+func (m *NodeSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeSnapshotResponse) ProtoMessage()    {}
+func (*NodeSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108471f26748cd58, []int{6}
+}
+func (m *NodeSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSnapshotResponse.Merge(m, src)
+}
+func (m *NodeSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSnapshotResponse proto.InternalMessageInfo
+
+func (m *NodeSnapshotResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NodeSnapshotResponse) GetBlocks() []*NodeSnapshotBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type NodeSnapshotBlock struct {
+	BlockMeta    *types.BlockMeta    `protobuf:"bytes,1,opt,name=block_meta,json=blockMeta,proto3" json:"block_meta,omitempty"`
+	Commit       *types.Commit       `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	ValidatorSet *types.ValidatorSet `protobuf:"bytes,3,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *NodeSnapshotBlock) Reset()         { *m = NodeSnapshotBlock{} }
+func (m *NodeSnapshotBlock) String() string { return proto.CompactTextString(m) }
+func (*NodeSnapshotBlock) ProtoMessage()    {}
+func (*NodeSnapshotBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108471f26748cd58, []int{7}
+}
+func (m *NodeSnapshotBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeSnapshotBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeSnapshotBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeSnapshotBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSnapshotBlock.Merge(m, src)
+}
+func (m *NodeSnapshotBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeSnapshotBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSnapshotBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSnapshotBlock proto.InternalMessageInfo
+
+func (m *NodeSnapshotBlock) GetBlockMeta() *types.BlockMeta {
+	if m != nil {
+		return m.BlockMeta
+	}
+	return nil
+}
+
+func (m *NodeSnapshotBlock) GetCommit() *types.Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *NodeSnapshotBlock) GetValidatorSet() *types.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Message)(nil), "celestiacore.statesync.Message")
 	proto.RegisterType((*SnapshotsRequest)(nil), "celestiacore.statesync.SnapshotsRequest")
 	proto.RegisterType((*SnapshotsResponse)(nil), "celestiacore.statesync.SnapshotsResponse")
 	proto.RegisterType((*ChunkRequest)(nil), "celestiacore.statesync.ChunkRequest")
 	proto.RegisterType((*ChunkResponse)(nil), "celestiacore.statesync.ChunkResponse")
+	proto.RegisterType((*NodeSnapshotRequest)(nil), "celestiacore.statesync.NodeSnapshotRequest")
+	proto.RegisterType((*NodeSnapshotResponse)(nil), "celestiacore.statesync.NodeSnapshotResponse")
+	proto.RegisterType((*NodeSnapshotBlock)(nil), "celestiacore.statesync.NodeSnapshotBlock")
 }
 
 func init() {
@@ -395,33 +580,44 @@ func init() {
 }
 
 var fileDescriptor_108471f26748cd58 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0xca, 0xd3, 0x40,
-	0x14, 0x4d, 0xfa, 0xcf, 0xb5, 0x91, 0x76, 0x90, 0x12, 0x5c, 0x04, 0x09, 0x0a, 0x75, 0x61, 0x02,
-	0xfa, 0x04, 0x56, 0x84, 0x42, 0xb1, 0x8b, 0x51, 0x14, 0xeb, 0x42, 0xa6, 0xe9, 0x98, 0x84, 0x9a,
-	0x4c, 0xcc, 0x9d, 0xa0, 0x7d, 0x00, 0x57, 0x6e, 0x7c, 0x2c, 0x97, 0x5d, 0xba, 0x94, 0xf4, 0x45,
-	0x24, 0x93, 0x1f, 0x63, 0xad, 0xe8, 0x07, 0xdf, 0x2e, 0xe7, 0xe4, 0xde, 0x93, 0x73, 0x4e, 0xb8,
-	0x60, 0x7b, 0xfc, 0x3d, 0x47, 0x19, 0x32, 0x4f, 0xa4, 0xdc, 0x45, 0xc9, 0x24, 0xc7, 0x43, 0xec,
-	0xb9, 0xf2, 0x90, 0x70, 0x74, 0x92, 0x54, 0x48, 0x41, 0x66, 0xed, 0x19, 0xa7, 0x99, 0xb1, 0xf3,
-	0x0e, 0x0c, 0x9f, 0x71, 0x44, 0xe6, 0x73, 0xf2, 0x0a, 0xa6, 0x18, 0xb3, 0x04, 0x03, 0x21, 0xf1,
-	0x6d, 0xca, 0x3f, 0x64, 0x1c, 0xa5, 0xa9, 0xdf, 0xd1, 0xe7, 0x37, 0x1e, 0xce, 0x9d, 0xcb, 0xfb,
-	0xce, 0xf3, 0x7a, 0x81, 0x96, 0xf3, 0x4b, 0x8d, 0x4e, 0xf0, 0x8c, 0x23, 0x1b, 0x20, 0x6d, 0x61,
-	0x4c, 0x44, 0x8c, 0xdc, 0xec, 0x28, 0xe5, 0xfb, 0xff, 0xa1, 0x5c, 0x2e, 0x2c, 0x35, 0x3a, 0xc5,
-	0x73, 0x92, 0xac, 0xc0, 0xf0, 0x82, 0x2c, 0xde, 0x37, 0x86, 0xbb, 0x4a, 0xf6, 0xee, 0xdf, 0x64,
-	0x9f, 0x14, 0xc3, 0xbf, 0xcc, 0x8e, 0xbd, 0x16, 0x26, 0x6b, 0xb8, 0x59, 0x8b, 0x55, 0x26, 0x7b,
-	0x4a, 0xed, 0xde, 0x3f, 0xd4, 0x1a, 0x83, 0x86, 0xd7, 0x26, 0x16, 0x7d, 0xe8, 0x62, 0x16, 0xd9,
-	0x04, 0x26, 0xe7, 0x3d, 0xd9, 0x5f, 0x74, 0x98, 0xfe, 0x11, 0x91, 0xcc, 0x60, 0x10, 0xf0, 0xd0,
-	0x0f, 0xca, 0xde, 0x7b, 0xb4, 0x42, 0x05, 0xff, 0x4e, 0xa4, 0x11, 0x93, 0xaa, 0x35, 0x83, 0x56,
-	0xa8, 0xe0, 0xd5, 0x17, 0x51, 0xc5, 0x36, 0x68, 0x85, 0x08, 0x81, 0x5e, 0xc0, 0x30, 0x50, 0xf6,
-	0xc7, 0x54, 0x3d, 0x93, 0xdb, 0x30, 0x8a, 0xb8, 0x64, 0x3b, 0x26, 0x99, 0xd9, 0x57, 0x7c, 0x83,
-	0xed, 0x17, 0x30, 0x6e, 0x17, 0x73, 0x65, 0x1f, 0xb7, 0xa0, 0x1f, 0xc6, 0x3b, 0xfe, 0xa9, 0xb2,
-	0x51, 0x02, 0xfb, 0xb3, 0x0e, 0xc6, 0x6f, 0x0d, 0x5d, 0x8f, 0x6e, 0xc1, 0xaa, 0x9c, 0x55, 0xbc,
-	0x12, 0x10, 0x13, 0x86, 0x51, 0x88, 0x18, 0xc6, 0xbe, 0x8a, 0x37, 0xa2, 0x35, 0x5c, 0xbc, 0xf9,
-	0x96, 0x5b, 0xfa, 0x31, 0xb7, 0xf4, 0x1f, 0xb9, 0xa5, 0x7f, 0x3d, 0x59, 0xda, 0xf1, 0x64, 0x69,
-	0xdf, 0x4f, 0x96, 0xb6, 0x79, 0xec, 0x87, 0x32, 0xc8, 0xb6, 0x8e, 0x27, 0x22, 0x77, 0xf5, 0xfa,
-	0xe5, 0xd3, 0x35, 0x97, 0x1f, 0x45, 0xba, 0x77, 0xeb, 0xdf, 0xfd, 0x40, 0x9d, 0x94, 0x3a, 0x21,
-	0xf7, 0xf2, 0x95, 0x6d, 0x07, 0xea, 0xed, 0xa3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xce,
-	0xa3, 0x9f, 0x86, 0x03, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xdb, 0x24, 0x2d, 0x43, 0x8c, 0x9a, 0x6d, 0x88, 0xa2, 0x48, 0x58, 0x91, 0x05, 0x52,
+	0x11, 0x34, 0x91, 0xca, 0x95, 0x4b, 0x53, 0x55, 0xaa, 0x54, 0x35, 0x87, 0x2d, 0x2a, 0xa2, 0x1c,
+	0xa2, 0x8d, 0xbd, 0xc4, 0x56, 0x62, 0x6f, 0xea, 0xd9, 0x14, 0xfa, 0x03, 0x38, 0x71, 0xe1, 0x7f,
+	0xf0, 0x47, 0x38, 0x70, 0xe8, 0x91, 0x23, 0x4a, 0xfe, 0x08, 0xf2, 0xfa, 0xa3, 0x4e, 0xe2, 0x40,
+	0x91, 0xb8, 0x79, 0x66, 0xdf, 0x7b, 0xfb, 0xc6, 0xcf, 0x1e, 0x30, 0x2d, 0x3e, 0xe6, 0x28, 0x5d,
+	0x66, 0x89, 0x80, 0x77, 0x50, 0x32, 0xc9, 0xf1, 0xc6, 0xb7, 0x3a, 0xf2, 0x66, 0xc2, 0xb1, 0x3d,
+	0x09, 0x84, 0x14, 0xa4, 0x9e, 0xc5, 0xb4, 0x53, 0x4c, 0xd3, 0x58, 0xe0, 0x2a, 0x46, 0x96, 0xd7,
+	0x34, 0x73, 0xce, 0xaf, 0xd9, 0xd8, 0xb5, 0x99, 0x14, 0x41, 0x84, 0x31, 0xbf, 0x15, 0x61, 0xeb,
+	0x8c, 0x23, 0xb2, 0x21, 0x27, 0x6f, 0xa1, 0x8a, 0x3e, 0x9b, 0xa0, 0x23, 0x24, 0xf6, 0x03, 0x7e,
+	0x35, 0xe5, 0x28, 0x1b, 0x5a, 0x4b, 0xdb, 0x7b, 0x78, 0xb0, 0xd7, 0xce, 0xf7, 0xd0, 0x3e, 0x4f,
+	0x08, 0x34, 0xc2, 0x9f, 0x14, 0xe8, 0x0e, 0x2e, 0xf5, 0xc8, 0x25, 0x90, 0xac, 0x30, 0x4e, 0x84,
+	0x8f, 0xbc, 0xb1, 0xa1, 0x94, 0x9f, 0xdf, 0x43, 0x39, 0x22, 0x9c, 0x14, 0x68, 0x15, 0x97, 0x9b,
+	0xe4, 0x14, 0x74, 0xcb, 0x99, 0xfa, 0xa3, 0xd4, 0xf0, 0xa6, 0x92, 0x7d, 0xba, 0x4e, 0xf6, 0x28,
+	0x04, 0xdf, 0x99, 0xad, 0x58, 0x99, 0x9a, 0xf4, 0xe0, 0x51, 0x22, 0x16, 0x9b, 0x2c, 0x2a, 0xb5,
+	0x67, 0x7f, 0x51, 0x4b, 0x0d, 0xea, 0x56, 0xb6, 0x41, 0x18, 0x3c, 0xf6, 0x85, 0xcd, 0xfb, 0x89,
+	0xed, 0xd4, 0x64, 0x49, 0xc9, 0xbe, 0x58, 0x27, 0xdb, 0x13, 0x36, 0x4f, 0xe6, 0xbf, 0xf3, 0xba,
+	0xeb, 0xaf, 0xb6, 0x89, 0x0d, 0xf5, 0xe5, 0x2b, 0x62, 0xeb, 0x65, 0x75, 0xc7, 0xcb, 0xfb, 0xdd,
+	0x91, 0x4e, 0x50, 0xf3, 0x73, 0xfa, 0xdd, 0x12, 0x6c, 0xe2, 0xd4, 0x33, 0x09, 0xec, 0x2c, 0x07,
+	0x6e, 0x7e, 0xd1, 0xa0, 0xba, 0x92, 0x15, 0xa9, 0x43, 0xd9, 0xe1, 0xee, 0xd0, 0x89, 0x3e, 0xa0,
+	0x22, 0x8d, 0xab, 0xb0, 0xff, 0x41, 0x04, 0x1e, 0x93, 0x2a, 0x7e, 0x9d, 0xc6, 0x55, 0xd8, 0x57,
+	0xaf, 0x0e, 0x55, 0x7e, 0x3a, 0x8d, 0x2b, 0x42, 0xa0, 0xe8, 0x30, 0x74, 0x54, 0x0e, 0x15, 0xaa,
+	0x9e, 0x49, 0x13, 0xb6, 0x3d, 0x2e, 0x99, 0xcd, 0x24, 0x53, 0x2f, 0xb2, 0x42, 0xd3, 0xda, 0x7c,
+	0x03, 0x95, 0x6c, 0xc2, 0xff, 0xec, 0xa3, 0x06, 0x25, 0xd7, 0xb7, 0xf9, 0xa7, 0xd8, 0x46, 0x54,
+	0x98, 0x9f, 0x35, 0xd0, 0x17, 0xa2, 0xfe, 0x3f, 0xba, 0x61, 0x57, 0xcd, 0x19, 0x8f, 0x17, 0x15,
+	0xa4, 0x01, 0x5b, 0x9e, 0x8b, 0xe8, 0xfa, 0x43, 0x35, 0xde, 0x36, 0x4d, 0x4a, 0x73, 0x1f, 0x76,
+	0x73, 0x3e, 0x8d, 0x75, 0x66, 0xcc, 0x2b, 0xa8, 0xe5, 0xa5, 0xbc, 0xd6, 0xfc, 0x21, 0x94, 0x07,
+	0x63, 0x61, 0x8d, 0xb0, 0xb1, 0xd1, 0xda, 0xfc, 0xd3, 0xbf, 0x99, 0x55, 0xed, 0x86, 0x0c, 0x1a,
+	0x13, 0xcd, 0x1f, 0x1a, 0x54, 0x57, 0x4e, 0xc9, 0x6b, 0x00, 0x75, 0xde, 0x0f, 0x73, 0x8a, 0x57,
+	0xca, 0x93, 0x45, 0xf1, 0x68, 0x71, 0x29, 0xf8, 0x19, 0x97, 0x8c, 0x3e, 0x18, 0x24, 0x8f, 0xe4,
+	0x00, 0xca, 0x96, 0xf0, 0x3c, 0x57, 0xc6, 0x2b, 0xa3, 0x99, 0xc7, 0x3c, 0x52, 0x08, 0x1a, 0x23,
+	0xc9, 0x31, 0xe8, 0xe9, 0xaa, 0xeb, 0x23, 0x4f, 0xd6, 0x42, 0x2b, 0x8f, 0x7a, 0x91, 0x00, 0xcf,
+	0xb9, 0xa4, 0x95, 0xeb, 0x4c, 0xd5, 0x7d, 0xff, 0x7d, 0x66, 0x68, 0xb7, 0x33, 0x43, 0xfb, 0x35,
+	0x33, 0xb4, 0xaf, 0x73, 0xa3, 0x70, 0x3b, 0x37, 0x0a, 0x3f, 0xe7, 0x46, 0xe1, 0xf2, 0x70, 0xe8,
+	0x4a, 0x67, 0x3a, 0x68, 0x5b, 0xc2, 0xeb, 0x9c, 0xbe, 0xbb, 0x38, 0xee, 0x71, 0xf9, 0x51, 0x04,
+	0xa3, 0x4e, 0xa2, 0xbf, 0xaf, 0x96, 0xae, 0x5a, 0xb2, 0x9d, 0xfc, 0x1d, 0x3f, 0x28, 0xab, 0xd3,
+	0x57, 0xbf, 0x07, 0x00, 0x6e, 0x56, 0xbf, 0x24, 0x04, 0x06, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -540,6 +736,48 @@ func (m *Message_ChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_NodeSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NodeSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NodeSnapshotRequest != nil {
+		{
+			size, err := m.NodeSnapshotRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_NodeSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NodeSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NodeSnapshotResponse != nil {
+		{
+			size, err := m.NodeSnapshotResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -708,6 +946,135 @@ func (m *ChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeSnapshotBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeSnapshotBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeSnapshotBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockMeta != nil {
+		{
+			size, err := m.BlockMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -779,29 +1146,53 @@ func (m *Message_ChunkResponse) Size() (n int) {
 	}
 	return n
 }
-func (m *SnapshotsRequest) Size() (n int) {
+func (m *Message_NodeSnapshotRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.NodeSnapshotRequest != nil {
+		l = m.NodeSnapshotRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
-
-func (m *SnapshotsResponse) Size() (n int) {
+func (m *Message_NodeSnapshotResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	if m.Format != 0 {
-		n += 1 + sovTypes(uint64(m.Format))
+	if m.NodeSnapshotResponse != nil {
+		l = m.NodeSnapshotResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.Chunks != 0 {
-		n += 1 + sovTypes(uint64(m.Chunks))
+	return n
+}
+func (m *SnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTypes(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovTypes(uint64(m.Chunks))
 	}
 	l = len(m.Hash)
 	if l > 0 {
@@ -857,6 +1248,57 @@ func (m *ChunkResponse) Size() (n int) {
 	return n
 }
 
+func (m *NodeSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *NodeSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeSnapshotBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockMeta != nil {
+		l = m.BlockMeta.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1032,6 +1474,76 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_ChunkResponse{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSnapshotRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NodeSnapshotRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_NodeSnapshotRequest{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSnapshotResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NodeSnapshotResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_NodeSnapshotResponse{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1546,6 +2058,336 @@ func (m *ChunkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NodeSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &NodeSnapshotBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSnapshotBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSnapshotBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSnapshotBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockMeta == nil {
+				m.BlockMeta = &types.BlockMeta{}
+			}
+			if err := m.BlockMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &types.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &types.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
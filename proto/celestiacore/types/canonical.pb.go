@@ -308,11 +308,82 @@ func (m *CanonicalVote) GetChainID() string {
 	return ""
 }
 
+// CanonicalVoteExtension provides us a way to serialize a vote extension from
+// a particular validator such that we can sign over those serialized bytes.
+type CanonicalVoteExtension struct {
+	Extension []byte `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	Height    int64  `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int64  `protobuf:"fixed64,3,opt,name=round,proto3" json:"round,omitempty"`
+	ChainId   string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *CanonicalVoteExtension) Reset()         { *m = CanonicalVoteExtension{} }
+func (m *CanonicalVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*CanonicalVoteExtension) ProtoMessage()    {}
+func (*CanonicalVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8a5dbd3dc2b23d8, []int{4}
+}
+func (m *CanonicalVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalVoteExtension.Merge(m, src)
+}
+func (m *CanonicalVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalVoteExtension proto.InternalMessageInfo
+
+func (m *CanonicalVoteExtension) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *CanonicalVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CanonicalVoteExtension) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CanonicalVoteExtension) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CanonicalBlockID)(nil), "celestiacore.types.CanonicalBlockID")
 	proto.RegisterType((*CanonicalPartSetHeader)(nil), "celestiacore.types.CanonicalPartSetHeader")
 	proto.RegisterType((*CanonicalProposal)(nil), "celestiacore.types.CanonicalProposal")
 	proto.RegisterType((*CanonicalVote)(nil), "celestiacore.types.CanonicalVote")
+	proto.RegisterType((*CanonicalVoteExtension)(nil), "celestiacore.types.CanonicalVoteExtension")
 }
 
 func init() {
@@ -320,39 +391,41 @@ func init() {
 }
 
 var fileDescriptor_d8a5dbd3dc2b23d8 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0xa6, 0x4e, 0xe2, 0x6c, 0x1b, 0x28, 0xab, 0xaa, 0xb2, 0x72, 0xb0, 0x83, 0x85, 0x50,
-	0x40, 0xc2, 0x96, 0x8a, 0x38, 0x72, 0x71, 0x41, 0x22, 0xa2, 0x40, 0xe5, 0x56, 0xe5, 0xe7, 0x12,
-	0x6d, 0xec, 0xc5, 0xb6, 0xea, 0x78, 0x2c, 0x7b, 0x23, 0xd4, 0x1b, 0x8f, 0xd0, 0x27, 0xe1, 0x39,
-	0x7a, 0xec, 0x91, 0x0b, 0x01, 0x39, 0x2f, 0x82, 0x3c, 0xce, 0x9f, 0xd4, 0xc0, 0x05, 0xd4, 0x8b,
-	0xb5, 0x33, 0xf3, 0xcd, 0x37, 0x9f, 0xbf, 0xd1, 0x50, 0xd3, 0x13, 0xb1, 0xc8, 0x65, 0xc4, 0x3d,
-	0xc8, 0x84, 0x2d, 0x2f, 0x52, 0x91, 0xdb, 0x1e, 0x4f, 0x20, 0x89, 0x3c, 0x1e, 0x5b, 0x69, 0x06,
-	0x12, 0x18, 0x5b, 0xc7, 0x58, 0x88, 0xe9, 0xee, 0x05, 0x10, 0x00, 0x96, 0xed, 0xf2, 0x55, 0x21,
-	0xbb, 0xfa, 0x06, 0x36, 0xfc, 0xce, 0xeb, 0x46, 0x00, 0x10, 0xc4, 0xc2, 0xc6, 0x68, 0x34, 0xf9,
-	0x6c, 0xcb, 0x68, 0x2c, 0x72, 0xc9, 0xc7, 0x69, 0x05, 0x30, 0xbf, 0x12, 0xba, 0x7b, 0xb8, 0x18,
-	0xef, 0xc4, 0xe0, 0x9d, 0x0f, 0x5e, 0x30, 0x46, 0x95, 0x90, 0xe7, 0xa1, 0x46, 0x7a, 0xa4, 0xbf,
-	0xe3, 0xe2, 0x9b, 0x7d, 0xa0, 0x77, 0x53, 0x9e, 0xc9, 0x61, 0x2e, 0xe4, 0x30, 0x14, 0xdc, 0x17,
-	0x99, 0x56, 0xef, 0x91, 0xfe, 0xf6, 0xc1, 0x63, 0xeb, 0xa6, 0x5a, 0x6b, 0x49, 0x79, 0xcc, 0x33,
-	0x79, 0x22, 0xe4, 0x2b, 0xec, 0x70, 0x94, 0xab, 0xa9, 0x51, 0x73, 0x3b, 0xe9, 0x7a, 0xd2, 0x74,
-	0xe8, 0xfe, 0x66, 0x38, 0xdb, 0xa3, 0x0d, 0x09, 0x92, 0xc7, 0x28, 0xa4, 0xe3, 0x56, 0xc1, 0x52,
-	0x5d, 0x7d, 0xa5, 0xce, 0xfc, 0x51, 0xa7, 0xf7, 0x56, 0x24, 0x19, 0xa4, 0x90, 0xf3, 0x98, 0x3d,
-	0xa3, 0x4a, 0x29, 0x07, 0xdb, 0xef, 0x1c, 0xdc, 0xdf, 0x24, 0xf4, 0x24, 0x0a, 0x12, 0xe1, 0xbf,
-	0xc9, 0x83, 0xd3, 0x8b, 0x54, 0xb8, 0x08, 0x67, 0xfb, 0xb4, 0x19, 0x8a, 0x28, 0x08, 0x25, 0x8e,
-	0xd8, 0x75, 0xe7, 0x51, 0x29, 0x27, 0x83, 0x49, 0xe2, 0x6b, 0x5b, 0x98, 0xae, 0x02, 0xf6, 0x88,
-	0xb6, 0x53, 0x88, 0x87, 0x55, 0x45, 0xe9, 0x91, 0xfe, 0x96, 0xb3, 0x53, 0x4c, 0x0d, 0xf5, 0xf8,
-	0xdd, 0x91, 0x5b, 0xe6, 0x5c, 0x35, 0x85, 0x18, 0x5f, 0xec, 0x88, 0xaa, 0xa3, 0xd2, 0xe2, 0x61,
-	0xe4, 0x6b, 0x0d, 0x34, 0xef, 0xc1, 0x5f, 0xcd, 0x9b, 0xef, 0xc3, 0xd9, 0x2e, 0xa6, 0x46, 0x6b,
-	0x1e, 0xb8, 0x2d, 0xa4, 0x18, 0xf8, 0xcc, 0xa1, 0xed, 0xe5, 0x36, 0xb5, 0x26, 0xd2, 0x75, 0xad,
-	0x6a, 0xdf, 0xd6, 0x62, 0xdf, 0xd6, 0xe9, 0x02, 0xe1, 0xa8, 0xa5, 0xf7, 0x97, 0x3f, 0x0d, 0xe2,
-	0xae, 0xda, 0xd8, 0x43, 0xaa, 0x7a, 0x21, 0x8f, 0x92, 0x52, 0x51, 0xab, 0x47, 0xfa, 0xed, 0x6a,
-	0xd6, 0x61, 0x99, 0x2b, 0x67, 0x61, 0x71, 0xe0, 0x9b, 0xdf, 0xea, 0xb4, 0xb3, 0x94, 0x75, 0x06,
-	0x52, 0xdc, 0x8e, 0xb7, 0xeb, 0x86, 0x29, 0xff, 0xd7, 0xb0, 0xc6, 0xbf, 0x1b, 0xd6, 0xfc, 0xb3,
-	0x61, 0xce, 0xfb, 0xab, 0x42, 0x27, 0xd7, 0x85, 0x4e, 0x7e, 0x15, 0x3a, 0xb9, 0x9c, 0xe9, 0xb5,
-	0xeb, 0x99, 0x5e, 0xfb, 0x3e, 0xd3, 0x6b, 0x9f, 0x9e, 0x07, 0x91, 0x0c, 0x27, 0x23, 0xcb, 0x83,
-	0xb1, 0xfd, 0xfa, 0xe3, 0xd9, 0xcb, 0xb7, 0x42, 0x7e, 0x81, 0xec, 0xdc, 0x5e, 0xfc, 0xd7, 0x13,
-	0x3c, 0xe5, 0xea, 0xd2, 0x6f, 0x5e, 0xf7, 0xa8, 0x89, 0x95, 0xa7, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0x50, 0x84, 0x40, 0x48, 0x04, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0xa9, 0x93, 0xd8, 0xdb, 0x06, 0xca, 0xaa, 0x8a, 0x4c, 0x84, 0xec, 0x60, 0x21,
+	0x14, 0x90, 0xb0, 0xa5, 0x22, 0x8e, 0x5c, 0x5c, 0x2a, 0x11, 0x51, 0xa0, 0x72, 0xab, 0xf2, 0x71,
+	0x89, 0x36, 0xf6, 0x62, 0x5b, 0x75, 0xbc, 0x96, 0xbd, 0x11, 0xf4, 0x04, 0x8f, 0xd0, 0x27, 0xe1,
+	0x39, 0x7a, 0xec, 0x91, 0x0b, 0x01, 0x39, 0x2f, 0x82, 0x76, 0xfd, 0x91, 0xa0, 0x46, 0x95, 0x10,
+	0x88, 0x8b, 0xb5, 0x33, 0xf3, 0xdf, 0x99, 0xbf, 0x7e, 0x23, 0x2f, 0x34, 0x5c, 0x12, 0x91, 0x8c,
+	0x85, 0xd8, 0xa5, 0x29, 0xb1, 0xd8, 0x59, 0x42, 0x32, 0xcb, 0xc5, 0x31, 0x8d, 0x43, 0x17, 0x47,
+	0x66, 0x92, 0x52, 0x46, 0x11, 0x5a, 0xd5, 0x98, 0x42, 0xd3, 0xdf, 0xf1, 0xa9, 0x4f, 0x45, 0xd9,
+	0xe2, 0xa7, 0x42, 0xd9, 0xd7, 0xd6, 0x74, 0x13, 0xdf, 0xb2, 0xae, 0xfb, 0x94, 0xfa, 0x11, 0xb1,
+	0x44, 0x34, 0x99, 0x7d, 0xb0, 0x58, 0x38, 0x25, 0x19, 0xc3, 0xd3, 0xa4, 0x10, 0x18, 0x5f, 0x00,
+	0xdc, 0xde, 0xab, 0xc6, 0xdb, 0x11, 0x75, 0x4f, 0x47, 0xcf, 0x10, 0x82, 0x52, 0x80, 0xb3, 0x40,
+	0x05, 0x03, 0x30, 0xdc, 0x72, 0xc4, 0x19, 0xbd, 0x85, 0x37, 0x13, 0x9c, 0xb2, 0x71, 0x46, 0xd8,
+	0x38, 0x20, 0xd8, 0x23, 0xa9, 0xda, 0x1c, 0x80, 0xe1, 0xe6, 0xee, 0x43, 0xf3, 0xaa, 0x5b, 0xb3,
+	0x6e, 0x79, 0x88, 0x53, 0x76, 0x44, 0xd8, 0x73, 0x71, 0xc3, 0x96, 0x2e, 0xe6, 0x7a, 0xc3, 0xe9,
+	0x26, 0xab, 0x49, 0xc3, 0x86, 0xbd, 0xf5, 0x72, 0xb4, 0x03, 0x5b, 0x8c, 0x32, 0x1c, 0x09, 0x23,
+	0x5d, 0xa7, 0x08, 0x6a, 0x77, 0xcd, 0xa5, 0x3b, 0xe3, 0x7b, 0x13, 0xde, 0x5a, 0x36, 0x49, 0x69,
+	0x42, 0x33, 0x1c, 0xa1, 0x27, 0x50, 0xe2, 0x76, 0xc4, 0xf5, 0x1b, 0xbb, 0x77, 0xd7, 0x19, 0x3d,
+	0x0a, 0xfd, 0x98, 0x78, 0x2f, 0x33, 0xff, 0xf8, 0x2c, 0x21, 0x8e, 0x90, 0xa3, 0x1e, 0x6c, 0x07,
+	0x24, 0xf4, 0x03, 0x26, 0x46, 0x6c, 0x3b, 0x65, 0xc4, 0xed, 0xa4, 0x74, 0x16, 0x7b, 0xea, 0x86,
+	0x48, 0x17, 0x01, 0x7a, 0x00, 0x95, 0x84, 0x46, 0xe3, 0xa2, 0x22, 0x0d, 0xc0, 0x70, 0xc3, 0xde,
+	0xca, 0xe7, 0xba, 0x7c, 0xf8, 0xfa, 0xc0, 0xe1, 0x39, 0x47, 0x4e, 0x68, 0x24, 0x4e, 0xe8, 0x00,
+	0xca, 0x13, 0x8e, 0x78, 0x1c, 0x7a, 0x6a, 0x4b, 0xc0, 0xbb, 0x77, 0x2d, 0xbc, 0x72, 0x1f, 0xf6,
+	0x66, 0x3e, 0xd7, 0x3b, 0x65, 0xe0, 0x74, 0x44, 0x8b, 0x91, 0x87, 0x6c, 0xa8, 0xd4, 0xdb, 0x54,
+	0xdb, 0xa2, 0x5d, 0xdf, 0x2c, 0xf6, 0x6d, 0x56, 0xfb, 0x36, 0x8f, 0x2b, 0x85, 0x2d, 0x73, 0xf6,
+	0xe7, 0x3f, 0x74, 0xe0, 0x2c, 0xaf, 0xa1, 0xfb, 0x50, 0x76, 0x03, 0x1c, 0xc6, 0xdc, 0x51, 0x67,
+	0x00, 0x86, 0x4a, 0x31, 0x6b, 0x8f, 0xe7, 0xf8, 0x2c, 0x51, 0x1c, 0x79, 0xc6, 0xd7, 0x26, 0xec,
+	0xd6, 0xb6, 0x4e, 0x28, 0x23, 0xff, 0x87, 0xed, 0x2a, 0x30, 0xe9, 0xdf, 0x02, 0x6b, 0xfd, 0x3d,
+	0xb0, 0xf6, 0x35, 0xc0, 0x3e, 0xc3, 0xde, 0x6f, 0xbc, 0xf6, 0x3f, 0x31, 0x12, 0x67, 0x21, 0x8d,
+	0xd1, 0x1d, 0xa8, 0x90, 0x2a, 0x28, 0xff, 0xb0, 0x65, 0xe2, 0x0f, 0xf9, 0xdc, 0x5e, 0x71, 0xc3,
+	0xf9, 0x28, 0xb5, 0x01, 0xfb, 0xcd, 0x45, 0xae, 0x81, 0xcb, 0x5c, 0x03, 0x3f, 0x73, 0x0d, 0x9c,
+	0x2f, 0xb4, 0xc6, 0xe5, 0x42, 0x6b, 0x7c, 0x5b, 0x68, 0x8d, 0xf7, 0x4f, 0xfd, 0x90, 0x05, 0xb3,
+	0x89, 0xe9, 0xd2, 0xa9, 0xf5, 0xe2, 0xdd, 0xc9, 0xfe, 0x2b, 0xc2, 0x3e, 0xd2, 0xf4, 0xd4, 0xaa,
+	0xc0, 0x3e, 0x12, 0x6f, 0x49, 0xf1, 0xd4, 0x5c, 0x7d, 0x5e, 0x26, 0x6d, 0x51, 0x79, 0xfc, 0x6b,
+	0x00, 0xae, 0xcb, 0x1a, 0x2d, 0xc9, 0x04, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -569,6 +642,55 @@ func (m *CanonicalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CanonicalVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Round))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Height))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCanonical(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCanonical(v)
 	base := offset
@@ -670,6 +792,29 @@ func (m *CanonicalVote) Size() (n int) {
 	return n
 }
 
+func (m *CanonicalVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	if m.Round != 0 {
+		n += 9
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	return n
+}
+
 func sovCanonical(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1295,6 +1440,142 @@ func (m *CanonicalVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CanonicalVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCanonical
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Round = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCanonical(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCanonical(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
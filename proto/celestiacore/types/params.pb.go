@@ -34,6 +34,8 @@ type ConsensusParams struct {
 	Evidence  *EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
 	Validator *ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
 	Version   *VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Synchrony *SynchronyParams `protobuf:"bytes,5,opt,name=synchrony,proto3" json:"synchrony,omitempty"`
+	Feature   *FeatureParams   `protobuf:"bytes,6,opt,name=feature,proto3" json:"feature,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +99,20 @@ func (m *ConsensusParams) GetVersion() *VersionParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetSynchrony() *SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetFeature() *FeatureParams {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -327,6 +343,124 @@ func (m *VersionParams) GetApp() uint64 {
 	return 0
 }
 
+// SynchronyParams bound the clock drift and message delay under which the
+// timestamp of a proposed block is considered timely by the validators. They
+// only apply while proposer-based timestamps are enabled.
+type SynchronyParams struct {
+	// Max difference between the clocks of correct validators.
+	Precision time.Duration `protobuf:"bytes,1,opt,name=precision,proto3,stdduration" json:"precision"`
+	// Max time it takes a proposal to reach the validators.
+	MessageDelay time.Duration `protobuf:"bytes,2,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay"`
+}
+
+func (m *SynchronyParams) Reset()         { *m = SynchronyParams{} }
+func (m *SynchronyParams) String() string { return proto.CompactTextString(m) }
+func (*SynchronyParams) ProtoMessage()    {}
+func (*SynchronyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24a30aebafc6b63, []int{5}
+}
+func (m *SynchronyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SynchronyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SynchronyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SynchronyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynchronyParams.Merge(m, src)
+}
+func (m *SynchronyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SynchronyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynchronyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SynchronyParams proto.InternalMessageInfo
+
+func (m *SynchronyParams) GetPrecision() time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *SynchronyParams) GetMessageDelay() time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return 0
+}
+
+// FeatureParams schedule the heights from which optional consensus features
+// are enabled.
+type FeatureParams struct {
+	// Height from which proposer-based timestamps (PBTS) are used as block time
+	// instead of the median of the LastCommit timestamps (BFT time).
+	// Note: 0 disables PBTS.
+	PbtsEnableHeight int64 `protobuf:"varint,1,opt,name=pbts_enable_height,json=pbtsEnableHeight,proto3" json:"pbts_enable_height,omitempty"`
+	// Height from which the validators extend their precommits through the
+	// ExtendVote ABCI method. The extensions of the precommits of height h are
+	// delivered to the proposer of height h+1 in PrepareProposal.
+	// Note: 0 disables vote extensions.
+	VoteExtensionsEnableHeight int64 `protobuf:"varint,2,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"`
+}
+
+func (m *FeatureParams) Reset()         { *m = FeatureParams{} }
+func (m *FeatureParams) String() string { return proto.CompactTextString(m) }
+func (*FeatureParams) ProtoMessage()    {}
+func (*FeatureParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24a30aebafc6b63, []int{6}
+}
+func (m *FeatureParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureParams.Merge(m, src)
+}
+func (m *FeatureParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureParams proto.InternalMessageInfo
+
+func (m *FeatureParams) GetPbtsEnableHeight() int64 {
+	if m != nil {
+		return m.PbtsEnableHeight
+	}
+	return 0
+}
+
+func (m *FeatureParams) GetVoteExtensionsEnableHeight() int64 {
+	if m != nil {
+		return m.VoteExtensionsEnableHeight
+	}
+	return 0
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
@@ -339,7 +473,7 @@ func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24a30aebafc6b63, []int{5}
+	return fileDescriptor_b24a30aebafc6b63, []int{7}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,48 +522,58 @@ func init() {
 	proto.RegisterType((*EvidenceParams)(nil), "celestiacore.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "celestiacore.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "celestiacore.types.VersionParams")
+	proto.RegisterType((*SynchronyParams)(nil), "celestiacore.types.SynchronyParams")
+	proto.RegisterType((*FeatureParams)(nil), "celestiacore.types.FeatureParams")
 	proto.RegisterType((*HashedParams)(nil), "celestiacore.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("celestiacore/types/params.proto", fileDescriptor_b24a30aebafc6b63) }
 
 var fileDescriptor_b24a30aebafc6b63 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0x65, 0x6c, 0xdd, 0xd7, 0x75, 0x9d, 0x2c, 0x24, 0xca, 0x90, 0x92, 0x12, 0x24,
-	0x34, 0x69, 0x22, 0x91, 0x40, 0x5c, 0x40, 0x80, 0x56, 0x98, 0x06, 0x9a, 0x3a, 0xa1, 0x08, 0x4d,
-	0x62, 0x1c, 0x22, 0xa7, 0x35, 0x59, 0xd4, 0x3a, 0x8e, 0x62, 0xa7, 0xb4, 0x6f, 0xc0, 0x91, 0x23,
-	0xc7, 0x1d, 0xe1, 0x0d, 0x78, 0x84, 0x1d, 0x77, 0xe4, 0x04, 0xa8, 0xbd, 0xf0, 0x18, 0x28, 0x4e,
-	0x4c, 0xdb, 0xad, 0x37, 0xdb, 0xdf, 0xef, 0xff, 0x77, 0xbe, 0xff, 0x17, 0x83, 0xd5, 0xa5, 0x03,
-	0x2a, 0x64, 0x44, 0xba, 0x3c, 0xa5, 0xae, 0x1c, 0x27, 0x54, 0xb8, 0x09, 0x49, 0x09, 0x13, 0x4e,
-	0x92, 0x72, 0xc9, 0x31, 0x9e, 0x07, 0x1c, 0x05, 0xec, 0xdc, 0x0c, 0x79, 0xc8, 0x55, 0xd9, 0xcd,
-	0x57, 0x05, 0xb9, 0x63, 0x86, 0x9c, 0x87, 0x03, 0xea, 0xaa, 0x5d, 0x90, 0x7d, 0x74, 0x7b, 0x59,
-	0x4a, 0x64, 0xc4, 0xe3, 0xa2, 0x6e, 0x7f, 0x5e, 0x81, 0xc6, 0x4b, 0x1e, 0x0b, 0x1a, 0x8b, 0x4c,
-	0xbc, 0x55, 0x77, 0xe0, 0xc7, 0x70, 0x23, 0x18, 0xf0, 0x6e, 0xbf, 0x89, 0x5a, 0x68, 0xb7, 0xf6,
-	0xd0, 0x72, 0xae, 0xdf, 0xe6, 0xb4, 0x73, 0xa0, 0xe0, 0xbd, 0x82, 0xc6, 0xcf, 0xa1, 0x4a, 0x87,
-	0x51, 0x8f, 0xc6, 0x5d, 0xda, 0x5c, 0x51, 0x4a, 0x7b, 0x99, 0xf2, 0xa0, 0x64, 0x4a, 0xf1, 0x7f,
-	0x0d, 0xde, 0x87, 0x8d, 0x21, 0x19, 0x44, 0x3d, 0x22, 0x79, 0xda, 0x34, 0x94, 0xc1, 0xbd, 0x65,
-	0x06, 0x27, 0x1a, 0x2a, 0x1d, 0x66, 0x2a, 0xfc, 0x14, 0xd6, 0x87, 0x34, 0x15, 0x11, 0x8f, 0x9b,
-	0xab, 0xca, 0xe0, 0xee, 0x52, 0x83, 0x02, 0x29, 0xe5, 0x5a, 0x61, 0x53, 0xa8, 0xcd, 0x75, 0x85,
-	0xef, 0xc0, 0x06, 0x23, 0x23, 0x3f, 0x18, 0x4b, 0x2a, 0x54, 0x12, 0x86, 0x57, 0x65, 0x64, 0xd4,
-	0xce, 0xf7, 0xf8, 0x16, 0xac, 0xe7, 0xc5, 0x90, 0x08, 0xd5, 0xaa, 0xe1, 0xad, 0x31, 0x32, 0x3a,
-	0x24, 0x02, 0xb7, 0x60, 0x53, 0x46, 0x8c, 0xfa, 0x11, 0x97, 0xc4, 0x67, 0x42, 0xf5, 0x61, 0x78,
-	0x90, 0x9f, 0xbd, 0xe1, 0x92, 0x74, 0x84, 0xfd, 0x1d, 0xc1, 0xd6, 0x62, 0x06, 0x78, 0x0f, 0x70,
-	0xee, 0x46, 0x42, 0xea, 0xc7, 0x19, 0xf3, 0x55, 0x9c, 0xfa, 0xce, 0x06, 0x23, 0xa3, 0xfd, 0x90,
-	0x1e, 0x67, 0x4c, 0x7d, 0x9c, 0xc0, 0x1d, 0xd8, 0xd6, 0xb0, 0x9e, 0x65, 0x19, 0xf7, 0x6d, 0xa7,
-	0x18, 0xb6, 0xa3, 0x87, 0xed, 0xbc, 0x2a, 0x81, 0x76, 0xf5, 0xe2, 0x97, 0x55, 0xf9, 0xfa, 0xdb,
-	0x42, 0xde, 0x56, 0xe1, 0xa7, 0x2b, 0x8b, 0x6d, 0x1a, 0x8b, 0x6d, 0xda, 0x2f, 0xa0, 0x71, 0x25,
-	0x6d, 0x6c, 0x43, 0x3d, 0xc9, 0x02, 0xbf, 0x4f, 0xc7, 0xbe, 0x4a, 0xb3, 0x89, 0x5a, 0xc6, 0xee,
-	0x86, 0x57, 0x4b, 0xb2, 0xe0, 0x88, 0x8e, 0xdf, 0xe5, 0x47, 0x4f, 0xaa, 0x3f, 0xce, 0x2d, 0xf4,
-	0xf7, 0xdc, 0x42, 0xf6, 0x1e, 0xd4, 0x17, 0xd2, 0xc6, 0xdb, 0x60, 0x90, 0x24, 0x51, 0xbd, 0xad,
-	0x7a, 0xf9, 0x72, 0x0e, 0x3e, 0x85, 0xcd, 0xd7, 0x44, 0x9c, 0xd1, 0x5e, 0xc9, 0xde, 0x87, 0x86,
-	0x8a, 0xc2, 0xbf, 0x3a, 0x87, 0xba, 0x3a, 0xee, 0xe8, 0x61, 0xd8, 0x50, 0x9f, 0x71, 0xb3, 0x91,
-	0xd4, 0x34, 0x75, 0x48, 0x44, 0xfb, 0xc3, 0xb7, 0x89, 0x89, 0x2e, 0x26, 0x26, 0xba, 0x9c, 0x98,
-	0xe8, 0xcf, 0xc4, 0x44, 0x5f, 0xa6, 0x66, 0xe5, 0x72, 0x6a, 0x56, 0x7e, 0x4e, 0xcd, 0xca, 0xe9,
-	0xb3, 0x30, 0x92, 0x67, 0x59, 0xe0, 0x74, 0x39, 0x73, 0x8f, 0xde, 0x9f, 0x1c, 0x1c, 0x53, 0xf9,
-	0x89, 0xa7, 0x7d, 0x57, 0xff, 0x3c, 0x0f, 0xd4, 0x43, 0x2c, 0x1e, 0xd7, 0xf5, 0xb7, 0x19, 0xac,
-	0xa9, 0xca, 0xa3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x81, 0x41, 0x71, 0xb8, 0x03, 0x00,
-	0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0x33, 0xd7, 0xfd, 0x48, 0x27, 0x4d, 0x53, 0x8d, 0xae, 0x74, 0x73, 0x8b, 0x70, 0x8a,
+	0x91, 0x50, 0xa5, 0x82, 0x23, 0x81, 0xd8, 0x80, 0x00, 0x35, 0x34, 0xb4, 0xa8, 0x6a, 0x85, 0x0c,
+	0xaa, 0x44, 0x59, 0x58, 0x63, 0xe7, 0xd4, 0xb1, 0x1a, 0x7b, 0x2c, 0xcf, 0x38, 0xc4, 0x3b, 0x96,
+	0x2c, 0x59, 0xb2, 0x42, 0x5d, 0xc2, 0x1b, 0xf0, 0x08, 0x5d, 0x76, 0xc9, 0x0a, 0x50, 0xba, 0xe1,
+	0x31, 0xd0, 0x8c, 0xed, 0xe6, 0xa3, 0xad, 0x04, 0x3b, 0xcf, 0x9c, 0xdf, 0xff, 0x9c, 0x99, 0x73,
+	0xfe, 0x1e, 0xdc, 0x70, 0xa1, 0x07, 0x5c, 0xf8, 0xd4, 0x65, 0x31, 0x34, 0x45, 0x1a, 0x01, 0x6f,
+	0x46, 0x34, 0xa6, 0x01, 0x37, 0xa3, 0x98, 0x09, 0x46, 0xc8, 0x38, 0x60, 0x2a, 0x60, 0xe5, 0x5f,
+	0x8f, 0x79, 0x4c, 0x85, 0x9b, 0xf2, 0x2b, 0x23, 0x57, 0x74, 0x8f, 0x31, 0xaf, 0x07, 0x4d, 0xb5,
+	0x72, 0x92, 0xc3, 0x66, 0x27, 0x89, 0xa9, 0xf0, 0x59, 0x98, 0xc5, 0x8d, 0xf7, 0x1a, 0xae, 0x3d,
+	0x65, 0x21, 0x87, 0x90, 0x27, 0xfc, 0x85, 0xaa, 0x41, 0xee, 0xe3, 0x59, 0xa7, 0xc7, 0xdc, 0xa3,
+	0x3a, 0x5a, 0x45, 0x6b, 0x95, 0xbb, 0x0d, 0xf3, 0x62, 0x35, 0xb3, 0x25, 0x81, 0x8c, 0xb7, 0x32,
+	0x9a, 0x3c, 0xc6, 0x65, 0xe8, 0xfb, 0x1d, 0x08, 0x5d, 0xa8, 0xff, 0xa3, 0x94, 0xc6, 0x65, 0xca,
+	0x76, 0xce, 0xe4, 0xe2, 0x73, 0x0d, 0xd9, 0xc0, 0x0b, 0x7d, 0xda, 0xf3, 0x3b, 0x54, 0xb0, 0xb8,
+	0xae, 0xa9, 0x04, 0x37, 0x2f, 0x4b, 0xb0, 0x5f, 0x40, 0x79, 0x86, 0x91, 0x8a, 0x3c, 0xc4, 0xf3,
+	0x7d, 0x88, 0xb9, 0xcf, 0xc2, 0xfa, 0x8c, 0x4a, 0x70, 0xe3, 0xd2, 0x04, 0x19, 0x92, 0xcb, 0x0b,
+	0x85, 0xac, 0xcf, 0xd3, 0xd0, 0xed, 0xc6, 0x2c, 0x4c, 0xeb, 0xb3, 0x57, 0xd7, 0x7f, 0x59, 0x40,
+	0x45, 0xfd, 0x73, 0x95, 0xac, 0x7f, 0x08, 0x54, 0x24, 0x31, 0xd4, 0xe7, 0xae, 0xae, 0xff, 0x2c,
+	0x43, 0x8a, 0xfa, 0xb9, 0xc2, 0x00, 0x5c, 0x19, 0xeb, 0x2a, 0xb9, 0x86, 0x17, 0x02, 0x3a, 0xb0,
+	0x9d, 0x54, 0x00, 0x57, 0x93, 0xd0, 0xac, 0x72, 0x40, 0x07, 0x2d, 0xb9, 0x26, 0xff, 0xe1, 0x79,
+	0x19, 0xf4, 0x28, 0x57, 0xad, 0xd6, 0xac, 0xb9, 0x80, 0x0e, 0xb6, 0x28, 0x27, 0xab, 0x78, 0x51,
+	0xf8, 0x01, 0xd8, 0x3e, 0x13, 0xd4, 0x0e, 0xb8, 0xea, 0xa3, 0x66, 0x61, 0xb9, 0xf7, 0x9c, 0x09,
+	0xba, 0xcb, 0x8d, 0x2f, 0x08, 0x2f, 0x4d, 0xce, 0x80, 0xac, 0x63, 0x22, 0xb3, 0x51, 0x0f, 0xec,
+	0x30, 0x09, 0x6c, 0x35, 0xce, 0xa2, 0x66, 0x2d, 0xa0, 0x83, 0x0d, 0x0f, 0xf6, 0x92, 0x40, 0x1d,
+	0x8e, 0x93, 0x5d, 0xbc, 0x5c, 0xc0, 0x85, 0x97, 0xf2, 0x71, 0xff, 0x6f, 0x66, 0x66, 0x33, 0x0b,
+	0xb3, 0x99, 0x9b, 0x39, 0xd0, 0x2a, 0x9f, 0x7c, 0x6f, 0x94, 0x3e, 0xfe, 0x68, 0x20, 0x6b, 0x29,
+	0xcb, 0x57, 0x44, 0x26, 0xaf, 0xa9, 0x4d, 0x5e, 0xd3, 0x78, 0x82, 0x6b, 0x53, 0xd3, 0x26, 0x06,
+	0xae, 0x46, 0x89, 0x63, 0x1f, 0x41, 0x6a, 0xab, 0x6e, 0xd6, 0xd1, 0xaa, 0xb6, 0xb6, 0x60, 0x55,
+	0xa2, 0xc4, 0xd9, 0x81, 0xf4, 0x95, 0xdc, 0x7a, 0x50, 0xfe, 0x7a, 0xdc, 0x40, 0xbf, 0x8e, 0x1b,
+	0xc8, 0x58, 0xc7, 0xd5, 0x89, 0x69, 0x93, 0x65, 0xac, 0xd1, 0x28, 0x52, 0x77, 0x9b, 0xb1, 0xe4,
+	0xe7, 0x18, 0xfc, 0x09, 0xe1, 0xda, 0xd4, 0x70, 0xa5, 0x29, 0xa2, 0x18, 0x5c, 0x5f, 0x79, 0x0a,
+	0xfd, 0xf9, 0x35, 0x47, 0x2a, 0xb2, 0x8d, 0xab, 0x01, 0x70, 0xae, 0x1a, 0x06, 0x3d, 0x9a, 0xfe,
+	0x4d, 0xb7, 0x16, 0x73, 0xe5, 0xa6, 0x14, 0x1a, 0xef, 0x10, 0xae, 0x4e, 0x98, 0x87, 0xdc, 0xc6,
+	0x24, 0x72, 0x04, 0xb7, 0x21, 0xa4, 0x4e, 0x0f, 0xec, 0x2e, 0xf8, 0x5e, 0x57, 0xe4, 0x93, 0x5b,
+	0x96, 0x91, 0xb6, 0x0a, 0x6c, 0xab, 0x7d, 0xb2, 0x81, 0xaf, 0xf7, 0x99, 0x00, 0x1b, 0x06, 0x02,
+	0x42, 0x79, 0xb6, 0x69, 0x61, 0xe6, 0xa5, 0x15, 0x09, 0xb5, 0xcf, 0x99, 0xf1, 0x14, 0xc6, 0x01,
+	0x5e, 0xdc, 0xa6, 0xbc, 0x0b, 0x9d, 0xfc, 0x00, 0xb7, 0x70, 0x4d, 0xd9, 0xc5, 0x9e, 0xf6, 0x6a,
+	0x55, 0x6d, 0xef, 0x16, 0x86, 0x35, 0x70, 0x75, 0xc4, 0x8d, 0x6c, 0x5b, 0x29, 0xa8, 0x2d, 0xca,
+	0x5b, 0x6f, 0x3e, 0x0f, 0x75, 0x74, 0x32, 0xd4, 0xd1, 0xe9, 0x50, 0x47, 0x3f, 0x87, 0x3a, 0xfa,
+	0x70, 0xa6, 0x97, 0x4e, 0xcf, 0xf4, 0xd2, 0xb7, 0x33, 0xbd, 0x74, 0xf0, 0xc8, 0xf3, 0x45, 0x37,
+	0x71, 0x4c, 0x97, 0x05, 0xcd, 0x9d, 0xd7, 0xfb, 0xed, 0x3d, 0x10, 0x6f, 0x59, 0x7c, 0xd4, 0x2c,
+	0x7e, 0xb0, 0x3b, 0xea, 0xb1, 0xcc, 0x1e, 0xc0, 0x8b, 0xef, 0xa7, 0x33, 0xa7, 0x22, 0xf7, 0x7e,
+	0x0f, 0x00, 0x94, 0x74, 0xd6, 0x31, 0x5c, 0x05, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -463,6 +607,12 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(that1.Version) {
 		return false
 	}
+	if !this.Synchrony.Equal(that1.Synchrony) {
+		return false
+	}
+	if !this.Feature.Equal(that1.Feature) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -578,6 +728,60 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SynchronyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SynchronyParams)
+	if !ok {
+		that2, ok := that.(SynchronyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.MessageDelay != that1.MessageDelay {
+		return false
+	}
+	return true
+}
+func (this *FeatureParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureParams)
+	if !ok {
+		that2, ok := that.(FeatureParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PbtsEnableHeight != that1.PbtsEnableHeight {
+		return false
+	}
+	if this.VoteExtensionsEnableHeight != that1.VoteExtensionsEnableHeight {
+		return false
+	}
+	return true
+}
 func (this *HashedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -625,6 +829,30 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Feature != nil {
+		{
+			size, err := m.Feature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Synchrony != nil {
+		{
+			size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Version != nil {
 		{
 			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
@@ -739,12 +967,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -815,6 +1043,78 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SynchronyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SynchronyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynchronyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MessageDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MessageDelay):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Precision, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Precision):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteExtensionsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteExtensionsEnableHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PbtsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PbtsEnableHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -973,6 +1273,14 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Version.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.Synchrony != nil {
+		l = m.Synchrony.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Feature != nil {
+		l = m.Feature.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1038,6 +1346,34 @@ func (m *VersionParams) Size() (n int) {
 	return n
 }
 
+func (m *SynchronyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Precision)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MessageDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeatureParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PbtsEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.PbtsEnableHeight))
+	}
+	if m.VoteExtensionsEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.VoteExtensionsEnableHeight))
+	}
+	return n
+}
+
 func (m *HashedParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1232,6 +1568,78 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchrony == nil {
+				m.Synchrony = &SynchronyParams{}
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feature == nil {
+				m.Feature = &FeatureParams{}
+			}
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1632,6 +2040,210 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SynchronyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Precision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PbtsEnableHeight", wireType)
+			}
+			m.PbtsEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PbtsEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionsEnableHeight", wireType)
+			}
+			m.VoteExtensionsEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExtensionsEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HashedParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
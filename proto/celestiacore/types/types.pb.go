@@ -567,6 +567,12 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Vote extension provided by the application. Only valid for precommits
+	// for a block.
+	Extension []byte `protobuf:"bytes,9,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Signature of the vote extension by the validator. Only valid for
+	// precommits for a block.
+	ExtensionSignature []byte `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -658,6 +664,20 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
+func (m *Vote) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Vote) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
 // Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -796,6 +816,165 @@ func (m *CommitSig) GetSignature() []byte {
 	return nil
 }
 
+// ExtendedCommit is a Commit whose signatures carry the vote extensions of the
+// precommits. It is stored locally for the next proposer and never gossiped
+// as part of a block.
+type ExtendedCommit struct {
+	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round              int32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockID            BlockID             `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `protobuf:"bytes,4,rep,name=extended_signatures,json=extendedSignatures,proto3" json:"extended_signatures"`
+}
+
+func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
+func (m *ExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommit) ProtoMessage()    {}
+func (*ExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a17c6fa7a0485f, []int{9}
+}
+func (m *ExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommit.Merge(m, src)
+}
+func (m *ExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommit proto.InternalMessageInfo
+
+func (m *ExtendedCommit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExtendedCommit) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ExtendedCommit) GetBlockID() BlockID {
+	if m != nil {
+		return m.BlockID
+	}
+	return BlockID{}
+}
+
+func (m *ExtendedCommit) GetExtendedSignatures() []ExtendedCommitSig {
+	if m != nil {
+		return m.ExtendedSignatures
+	}
+	return nil
+}
+
+// ExtendedCommitSig is a CommitSig along with the vote extension of the
+// precommit and its signature.
+type ExtendedCommitSig struct {
+	BlockIdFlag      BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=celestiacore.types.BlockIDFlag" json:"block_id_flag,omitempty"`
+	ValidatorAddress []byte      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Timestamp        time.Time   `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Signature        []byte      `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Vote extension data
+	Extension []byte `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Vote extension signature
+	ExtensionSignature []byte `protobuf:"bytes,6,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+}
+
+func (m *ExtendedCommitSig) Reset()         { *m = ExtendedCommitSig{} }
+func (m *ExtendedCommitSig) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommitSig) ProtoMessage()    {}
+func (*ExtendedCommitSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a17c6fa7a0485f, []int{10}
+}
+func (m *ExtendedCommitSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommitSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommitSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommitSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommitSig.Merge(m, src)
+}
+func (m *ExtendedCommitSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommitSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommitSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommitSig proto.InternalMessageInfo
+
+func (m *ExtendedCommitSig) GetBlockIdFlag() BlockIDFlag {
+	if m != nil {
+		return m.BlockIdFlag
+	}
+	return BlockIDFlagUnknown
+}
+
+func (m *ExtendedCommitSig) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ExtendedCommitSig) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
 type Proposal struct {
 	Type      SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=celestiacore.types.SignedMsgType" json:"type,omitempty"`
 	Height    int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -810,7 +989,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{9}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +1076,7 @@ func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
 func (m *SignedHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedHeader) ProtoMessage()    {}
 func (*SignedHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{10}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{12}
 }
 func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1128,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{11}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{13}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1182,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{12}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{14}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1260,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{13}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{15}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1322,7 @@ func (m *IndexWrapper) Reset()         { *m = IndexWrapper{} }
 func (m *IndexWrapper) String() string { return proto.CompactTextString(m) }
 func (*IndexWrapper) ProtoMessage()    {}
 func (*IndexWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{14}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{16}
 }
 func (m *IndexWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1385,7 @@ func (m *BlobTx) Reset()         { *m = BlobTx{} }
 func (m *BlobTx) String() string { return proto.CompactTextString(m) }
 func (*BlobTx) ProtoMessage()    {}
 func (*BlobTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{15}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{17}
 }
 func (m *BlobTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1449,7 @@ func (m *ShareProof) Reset()         { *m = ShareProof{} }
 func (m *ShareProof) String() string { return proto.CompactTextString(m) }
 func (*ShareProof) ProtoMessage()    {}
 func (*ShareProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{16}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{18}
 }
 func (m *ShareProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,7 +1527,7 @@ func (m *RowProof) Reset()         { *m = RowProof{} }
 func (m *RowProof) String() string { return proto.CompactTextString(m) }
 func (*RowProof) ProtoMessage()    {}
 func (*RowProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{17}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{19}
 }
 func (m *RowProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,7 +1617,7 @@ func (m *NMTProof) Reset()         { *m = NMTProof{} }
 func (m *NMTProof) String() string { return proto.CompactTextString(m) }
 func (*NMTProof) ProtoMessage()    {}
 func (*NMTProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5a17c6fa7a0485f, []int{18}
+	return fileDescriptor_c5a17c6fa7a0485f, []int{20}
 }
 func (m *NMTProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,6 +1686,8 @@ func init() {
 	proto.RegisterType((*Vote)(nil), "celestiacore.types.Vote")
 	proto.RegisterType((*Commit)(nil), "celestiacore.types.Commit")
 	proto.RegisterType((*CommitSig)(nil), "celestiacore.types.CommitSig")
+	proto.RegisterType((*ExtendedCommit)(nil), "celestiacore.types.ExtendedCommit")
+	proto.RegisterType((*ExtendedCommitSig)(nil), "celestiacore.types.ExtendedCommitSig")
 	proto.RegisterType((*Proposal)(nil), "celestiacore.types.Proposal")
 	proto.RegisterType((*SignedHeader)(nil), "celestiacore.types.SignedHeader")
 	proto.RegisterType((*LightBlock)(nil), "celestiacore.types.LightBlock")
@@ -1522,112 +1703,117 @@ func init() {
 func init() { proto.RegisterFile("celestiacore/types/types.proto", fileDescriptor_c5a17c6fa7a0485f) }
 
 var fileDescriptor_c5a17c6fa7a0485f = []byte{
-	// 1673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
-	0x15, 0x37, 0x25, 0x4a, 0xa2, 0x9e, 0x24, 0x5b, 0x26, 0x9c, 0x44, 0xd1, 0x26, 0xb2, 0xa2, 0xa2,
-	0xad, 0x93, 0xb6, 0xf2, 0xd6, 0x45, 0x8b, 0xe4, 0x50, 0x04, 0x96, 0xed, 0x6c, 0xb4, 0x6b, 0xcb,
-	0x06, 0xa5, 0x78, 0x91, 0xa2, 0x00, 0x41, 0x89, 0xb3, 0x32, 0xbb, 0x34, 0x87, 0xe5, 0x8c, 0x6c,
-	0x6d, 0x3e, 0x41, 0xe1, 0x4b, 0x73, 0x2e, 0xe0, 0x53, 0x5b, 0xa0, 0xdf, 0xa0, 0x5f, 0xa0, 0x28,
-	0x72, 0xcc, 0xad, 0x3d, 0x25, 0x85, 0xf7, 0x56, 0xe4, 0x43, 0x14, 0xf3, 0x66, 0x48, 0x89, 0x2b,
-	0x29, 0x09, 0x9a, 0x45, 0x2e, 0x06, 0xe7, 0xcd, 0xef, 0xfd, 0x7f, 0x6f, 0xde, 0x93, 0xa1, 0x31,
-	0x22, 0x3e, 0x61, 0xdc, 0x73, 0x46, 0x34, 0x22, 0xbb, 0xfc, 0x59, 0x48, 0x98, 0xfc, 0xdb, 0x0e,
-	0x23, 0xca, 0xa9, 0x69, 0xce, 0xdf, 0xb7, 0xf1, 0xa6, 0xbe, 0x35, 0xa6, 0x63, 0x8a, 0xd7, 0xbb,
-	0xe2, 0x4b, 0x22, 0xeb, 0xdb, 0x63, 0x4a, 0xc7, 0x3e, 0xd9, 0xc5, 0xd3, 0x70, 0xf2, 0x64, 0x97,
-	0x7b, 0x97, 0x84, 0x71, 0xe7, 0x32, 0x8c, 0x01, 0x29, 0x55, 0xa3, 0xe8, 0x59, 0xc8, 0xa9, 0x40,
-	0xd3, 0x27, 0x0a, 0xd0, 0x4c, 0x01, 0xae, 0x48, 0xc4, 0x3c, 0x1a, 0xcc, 0x5b, 0x53, 0x6f, 0x2d,
-	0xb1, 0xf6, 0xca, 0xf1, 0x3d, 0xd7, 0xe1, 0x34, 0x92, 0x98, 0xd6, 0x7b, 0x50, 0x39, 0x73, 0x22,
-	0xde, 0x27, 0xfc, 0x43, 0xe2, 0xb8, 0x24, 0x32, 0xb7, 0x20, 0xc7, 0x29, 0x77, 0xfc, 0x9a, 0xd6,
-	0xd4, 0x76, 0x2a, 0x96, 0x3c, 0x98, 0x26, 0xe8, 0x17, 0x0e, 0xbb, 0xa8, 0x65, 0x9a, 0xda, 0x4e,
-	0xd9, 0xc2, 0xef, 0xd6, 0xef, 0x40, 0x17, 0xac, 0x82, 0xc3, 0x0b, 0x5c, 0x32, 0x8d, 0x39, 0xf0,
-	0x20, 0xa8, 0xc3, 0x67, 0x9c, 0x30, 0xc5, 0x22, 0x0f, 0xe6, 0xaf, 0x20, 0x87, 0x3e, 0xd4, 0xb2,
-	0x4d, 0x6d, 0xa7, 0xb4, 0x57, 0x6f, 0xa7, 0x02, 0x26, 0xbd, 0x6c, 0x9f, 0x09, 0x44, 0x47, 0xff,
-	0xec, 0x8b, 0xed, 0x35, 0x4b, 0xc2, 0x5b, 0x01, 0x14, 0x3a, 0x3e, 0x1d, 0x3d, 0xed, 0x1e, 0x26,
-	0xa6, 0x68, 0x33, 0x53, 0xcc, 0x53, 0xd8, 0x08, 0x9d, 0x88, 0xdb, 0x8c, 0x70, 0xfb, 0x02, 0xfd,
-	0x40, 0xb5, 0xa5, 0xbd, 0xb7, 0xda, 0x8b, 0x19, 0x69, 0xa7, 0x1c, 0x56, 0x7a, 0x2a, 0xe1, 0x3c,
-	0xb1, 0xf5, 0x5f, 0x1d, 0xf2, 0x2a, 0x20, 0xef, 0x43, 0x41, 0x05, 0x17, 0x55, 0x96, 0xf6, 0xb6,
-	0xd3, 0x32, 0xd5, 0x65, 0xfb, 0x80, 0x06, 0x8c, 0x04, 0x6c, 0xc2, 0x94, 0xc4, 0x98, 0xcb, 0xfc,
-	0x11, 0x18, 0xa3, 0x0b, 0xc7, 0x0b, 0x6c, 0xcf, 0x45, 0xab, 0x8a, 0x9d, 0xd2, 0xdd, 0x17, 0xdb,
-	0x85, 0x03, 0x41, 0xeb, 0x1e, 0x5a, 0x05, 0xbc, 0xec, 0xba, 0xe6, 0xab, 0x90, 0xbf, 0x20, 0xde,
-	0xf8, 0x82, 0x63, 0x70, 0xb2, 0x96, 0x3a, 0x99, 0xef, 0x82, 0x2e, 0x8a, 0xa3, 0xa6, 0xab, 0x90,
-	0xc9, 0xca, 0x69, 0xc7, 0x95, 0xd3, 0x1e, 0xc4, 0x95, 0xd3, 0x31, 0x84, 0xe2, 0x4f, 0xbf, 0xdc,
-	0xd6, 0x2c, 0xe4, 0x30, 0x8f, 0xa0, 0xe2, 0x3b, 0x8c, 0xdb, 0x43, 0x11, 0x3a, 0xa1, 0x3e, 0x87,
-	0x22, 0xee, 0x2d, 0x0b, 0x8a, 0x0a, 0xaf, 0x32, 0xbe, 0x24, 0xf8, 0x24, 0xc9, 0x35, 0x77, 0xa0,
-	0x8a, 0x62, 0x46, 0xf4, 0xf2, 0xd2, 0xe3, 0x36, 0x46, 0x3f, 0x8f, 0xd1, 0x5f, 0x17, 0xf4, 0x03,
-	0x24, 0x7f, 0x28, 0xf2, 0x70, 0x0f, 0x8a, 0xae, 0xc3, 0x1d, 0x09, 0x29, 0x20, 0xc4, 0x10, 0x04,
-	0xbc, 0xfc, 0x31, 0x6c, 0x24, 0xd5, 0xc7, 0x24, 0xc4, 0x90, 0x52, 0x66, 0x64, 0x04, 0xde, 0x87,
-	0xad, 0x80, 0x4c, 0xb9, 0xfd, 0x22, 0xba, 0x88, 0x68, 0x53, 0xdc, 0x9d, 0xa7, 0x39, 0x7e, 0x08,
-	0xeb, 0xa3, 0x38, 0xfc, 0x12, 0x0b, 0x88, 0xad, 0x24, 0x54, 0x84, 0xbd, 0x0e, 0x86, 0x13, 0x86,
-	0x12, 0x50, 0x42, 0x40, 0xc1, 0x09, 0x43, 0xbc, 0x7a, 0x07, 0x36, 0xd1, 0xc7, 0x88, 0xb0, 0x89,
-	0xcf, 0x95, 0x90, 0x32, 0x62, 0x36, 0xc4, 0x85, 0x25, 0xe9, 0x88, 0xfd, 0x01, 0x54, 0xc8, 0x95,
-	0xe7, 0x92, 0x60, 0x44, 0x24, 0xae, 0x82, 0xb8, 0x72, 0x4c, 0x44, 0xd0, 0xdb, 0x50, 0x0d, 0x23,
-	0x1a, 0x52, 0x46, 0x22, 0xdb, 0x71, 0xdd, 0x88, 0x30, 0x56, 0x5b, 0x97, 0xf2, 0x62, 0xfa, 0xbe,
-	0x24, 0xb7, 0x6c, 0xd0, 0x0f, 0x1d, 0xee, 0x98, 0x55, 0xc8, 0xf2, 0x29, 0xab, 0x69, 0xcd, 0xec,
-	0x4e, 0xd9, 0x12, 0x9f, 0xe6, 0x36, 0x94, 0xd8, 0xef, 0x27, 0x4e, 0x44, 0x6c, 0xe6, 0x7d, 0x42,
-	0x30, 0x7d, 0xba, 0x05, 0x92, 0xd4, 0xf7, 0x3e, 0x21, 0x49, 0x33, 0xe4, 0x67, 0xcd, 0xf0, 0x50,
-	0x37, 0x32, 0xd5, 0xec, 0x43, 0xdd, 0xc8, 0x56, 0xf5, 0x87, 0xba, 0xa1, 0x57, 0x73, 0xad, 0x3f,
-	0x6a, 0xa0, 0x77, 0x7c, 0x3a, 0x34, 0xdf, 0x82, 0x72, 0xe0, 0x5c, 0x12, 0x16, 0x3a, 0x23, 0x22,
-	0xea, 0x41, 0xf6, 0x50, 0x29, 0xa1, 0x75, 0x5d, 0x21, 0x51, 0x64, 0x2c, 0xee, 0x74, 0xf1, 0x2d,
-	0x1c, 0x66, 0x17, 0xc2, 0x8a, 0xb8, 0x11, 0xb2, 0xd8, 0xe9, 0x65, 0x24, 0x9e, 0xab, 0x32, 0xff,
-	0x09, 0x6c, 0xce, 0x64, 0xc7, 0x40, 0x1d, 0x81, 0xd5, 0xe4, 0x42, 0x81, 0x5b, 0x5f, 0x65, 0x40,
-	0x3f, 0xa7, 0x9c, 0x98, 0xbf, 0x04, 0x5d, 0xd4, 0x1f, 0x5a, 0xb2, 0xbe, 0xbc, 0x5d, 0xfb, 0xde,
-	0x38, 0x20, 0xee, 0x09, 0x1b, 0x0f, 0x9e, 0x85, 0xc4, 0x42, 0xf8, 0x5c, 0xaf, 0x64, 0x52, 0xbd,
-	0xb2, 0x05, 0xb9, 0x88, 0x4e, 0x02, 0x17, 0x2d, 0xcc, 0x59, 0xf2, 0x60, 0x3e, 0x00, 0x23, 0x69,
-	0x01, 0xfd, 0x9b, 0x5b, 0x60, 0x43, 0xb4, 0x80, 0x68, 0x51, 0x45, 0xb0, 0x0a, 0x43, 0xd5, 0x09,
-	0x1d, 0x28, 0x26, 0xef, 0xb4, 0x6a, 0xa6, 0x6f, 0xd7, 0x8f, 0x33, 0x36, 0x11, 0xa7, 0xa4, 0xb0,
-	0x93, 0xca, 0x90, 0xf9, 0xab, 0x26, 0x17, 0xaa, 0x34, 0x52, 0x3d, 0x63, 0xcb, 0x57, 0xb6, 0x80,
-	0x9e, 0xcd, 0x7a, 0xa6, 0x8b, 0xcf, 0xed, 0x1b, 0x50, 0x64, 0xde, 0x38, 0x70, 0xf8, 0x24, 0x22,
-	0xaa, 0xad, 0x66, 0x84, 0xd6, 0x3f, 0x34, 0xc8, 0xcb, 0x36, 0x9d, 0x8b, 0x9c, 0xb6, 0x3c, 0x72,
-	0x99, 0x55, 0x91, 0xcb, 0x7e, 0x97, 0xc8, 0x1d, 0x00, 0x24, 0xe6, 0xb0, 0x9a, 0xde, 0xcc, 0xee,
-	0x94, 0xf6, 0xde, 0x5c, 0x26, 0x4a, 0x9a, 0xd9, 0xf7, 0xc6, 0xea, 0x25, 0x9a, 0x63, 0x6b, 0x7d,
-	0xa9, 0x41, 0x31, 0xb9, 0x37, 0x0f, 0xa0, 0x12, 0xdb, 0x66, 0x3f, 0xf1, 0x9d, 0xb1, 0xaa, 0xa1,
-	0xed, 0xaf, 0x31, 0xf0, 0x03, 0xdf, 0x19, 0x5b, 0x25, 0x65, 0x93, 0x38, 0x2c, 0xcf, 0x46, 0x66,
-	0x45, 0x36, 0x52, 0xe9, 0xcf, 0xfe, 0x7f, 0xe9, 0x4f, 0x25, 0x4a, 0x7f, 0x31, 0x51, 0x7f, 0xcf,
-	0x80, 0x71, 0x86, 0xcf, 0x83, 0xe3, 0x7f, 0x3f, 0xbd, 0x71, 0x0f, 0x8a, 0x21, 0xf5, 0x6d, 0x79,
-	0xa3, 0xe3, 0x8d, 0x11, 0x52, 0xdf, 0x5a, 0x48, 0x7f, 0xee, 0xa5, 0x35, 0x4e, 0xfe, 0x25, 0x44,
-	0xae, 0xf0, 0x62, 0xe4, 0xae, 0xa0, 0x2c, 0x83, 0xa1, 0xc6, 0xf6, 0x9e, 0x88, 0x02, 0x6e, 0x02,
-	0xda, 0xb2, 0x55, 0x43, 0x1a, 0x2e, 0xb1, 0x96, 0x42, 0x0a, 0x1e, 0x39, 0xe3, 0xd4, 0xf6, 0x50,
-	0x5f, 0x5d, 0xa0, 0x96, 0x42, 0xb6, 0xfe, 0xa4, 0x01, 0x1c, 0x8b, 0xf8, 0xa2, 0xcf, 0x62, 0xe4,
-	0x32, 0x34, 0xc3, 0x4e, 0x69, 0x6f, 0xae, 0x4e, 0x9e, 0xb2, 0xa1, 0xcc, 0xe6, 0xad, 0x3f, 0x82,
-	0xca, 0xac, 0x2c, 0x19, 0x89, 0x0d, 0x5a, 0x2a, 0x26, 0x99, 0x85, 0x7d, 0xc2, 0xad, 0xf2, 0xd5,
-	0xdc, 0xa9, 0xf5, 0x4f, 0x0d, 0x8a, 0x68, 0xd7, 0x09, 0xe1, 0x4e, 0x2a, 0x9b, 0xda, 0x77, 0xc9,
-	0xe6, 0x9b, 0x00, 0x52, 0x10, 0x4e, 0x25, 0x59, 0x65, 0x45, 0xa4, 0xe0, 0x50, 0x7a, 0x37, 0x09,
-	0x7d, 0xf6, 0x9b, 0x42, 0xaf, 0x9a, 0x3c, 0x4e, 0xc0, 0x6b, 0x50, 0x08, 0x26, 0x97, 0xb6, 0x98,
-	0x82, 0xba, 0xac, 0xdd, 0x60, 0x72, 0x39, 0x98, 0xb2, 0x96, 0x0f, 0x85, 0xc1, 0x14, 0xf7, 0x42,
-	0x51, 0xb0, 0x11, 0xa5, 0x6a, 0x0d, 0x91, 0x03, 0xcc, 0x10, 0x04, 0x9c, 0xba, 0xcb, 0xa6, 0xd7,
-	0xfd, 0x6f, 0xbd, 0x73, 0xc6, 0xdb, 0xe6, 0x6f, 0xa1, 0x8c, 0xaf, 0xea, 0xe3, 0xc8, 0x09, 0x43,
-	0x12, 0x99, 0xeb, 0x90, 0xe1, 0x53, 0xa5, 0x2b, 0xc3, 0xa7, 0xb3, 0x79, 0x88, 0x2f, 0x32, 0xee,
-	0xb8, 0xd9, 0x64, 0x1e, 0x76, 0x25, 0x4d, 0xf8, 0x22, 0x3c, 0x8d, 0x5f, 0xce, 0xa2, 0x95, 0x17,
-	0xc7, 0xae, 0xdb, 0x72, 0x20, 0x2f, 0x86, 0xf1, 0x60, 0xba, 0x20, 0xb7, 0x0d, 0xb9, 0xa1, 0x4f,
-	0x87, 0x52, 0x5e, 0x69, 0xaf, 0xb6, 0x22, 0x3b, 0x43, 0x4b, 0xc2, 0x56, 0xab, 0xf8, 0x4a, 0x03,
-	0xe8, 0x0b, 0x63, 0x64, 0xc8, 0xe2, 0xa8, 0xc8, 0xcd, 0x42, 0x46, 0xe5, 0x7d, 0x90, 0xe6, 0xda,
-	0xe8, 0x72, 0xac, 0xf2, 0x8d, 0x65, 0x2a, 0x7b, 0x27, 0x03, 0x19, 0x9e, 0x12, 0x4b, 0x64, 0xb2,
-	0x85, 0x5d, 0x22, 0xbb, 0xb8, 0x4b, 0xbc, 0x27, 0x52, 0x75, 0x2d, 0x35, 0xa8, 0xc1, 0xbb, 0x54,
-	0x81, 0x45, 0xaf, 0xa5, 0x02, 0x23, 0x52, 0x5f, 0xcb, 0xb7, 0x89, 0xdc, 0x8a, 0x6d, 0xe2, 0xaf,
-	0x1a, 0x18, 0xb1, 0x0c, 0x59, 0x1f, 0xd7, 0xb6, 0x28, 0x89, 0x78, 0x97, 0x12, 0x62, 0x2d, 0x71,
-	0x16, 0x1d, 0x9e, 0xf2, 0xf7, 0xeb, 0x8a, 0x41, 0x21, 0x45, 0xf4, 0x84, 0x30, 0xe5, 0x20, 0x7e,
-	0x0b, 0x25, 0x8c, 0x8b, 0x5f, 0x1c, 0x11, 0xbd, 0x56, 0x4b, 0x8e, 0x81, 0x04, 0x8b, 0x5e, 0x8b,
-	0xb4, 0x90, 0xc0, 0xc5, 0x2b, 0x69, 0x71, 0x9e, 0x04, 0xae, 0x45, 0xaf, 0x5b, 0x04, 0x8c, 0x38,
-	0x96, 0xe2, 0x35, 0x46, 0x06, 0x4c, 0x7f, 0xce, 0x92, 0x07, 0xb1, 0x02, 0x92, 0x64, 0x06, 0x8b,
-	0x4f, 0x81, 0x0b, 0xa8, 0x4b, 0x58, 0x2d, 0x8b, 0xae, 0xc8, 0x83, 0xd0, 0xef, 0x13, 0xe7, 0x89,
-	0x6c, 0x02, 0x39, 0x45, 0x0c, 0x41, 0x10, 0x4d, 0xf0, 0xce, 0xbf, 0x34, 0x28, 0xcd, 0x0d, 0x3c,
-	0xf3, 0xe7, 0xf0, 0x4a, 0xe7, 0xf8, 0xf4, 0xe0, 0x91, 0xdd, 0x3d, 0xb4, 0x3f, 0x38, 0xde, 0x7f,
-	0x60, 0x7f, 0xd4, 0x7b, 0xd4, 0x3b, 0x7d, 0xdc, 0xab, 0xae, 0xd5, 0x5f, 0xbd, 0xb9, 0x6d, 0x9a,
-	0x73, 0xd8, 0x8f, 0x82, 0xa7, 0x01, 0xbd, 0x0e, 0xcc, 0x5d, 0xd8, 0x4a, 0xb3, 0xec, 0x77, 0xfa,
-	0x47, 0xbd, 0x41, 0x55, 0xab, 0xbf, 0x72, 0x73, 0xdb, 0xdc, 0x9c, 0xe3, 0xd8, 0x1f, 0x32, 0x12,
-	0xf0, 0x45, 0x86, 0x83, 0xd3, 0x93, 0x93, 0xee, 0xa0, 0x9a, 0x59, 0x60, 0x50, 0x7b, 0xc8, 0xdb,
-	0xb0, 0x99, 0x66, 0xe8, 0x75, 0x8f, 0xab, 0xd9, 0xba, 0x79, 0x73, 0xdb, 0x5c, 0x9f, 0x43, 0xf7,
-	0x3c, 0xbf, 0x6e, 0xfc, 0xe1, 0xcf, 0x8d, 0xb5, 0xbf, 0xfd, 0xa5, 0xa1, 0x09, 0xcf, 0x2a, 0xa9,
-	0x91, 0x67, 0xfe, 0x14, 0x5e, 0xeb, 0x77, 0x1f, 0xf4, 0x8e, 0x0e, 0xed, 0x93, 0xfe, 0x03, 0x7b,
-	0xf0, 0xf1, 0xd9, 0xd1, 0x9c, 0x77, 0x1b, 0x37, 0xb7, 0xcd, 0x92, 0x72, 0x69, 0x15, 0xfa, 0xcc,
-	0x3a, 0x3a, 0x3f, 0x1d, 0x1c, 0x55, 0x35, 0x89, 0x3e, 0x8b, 0xc8, 0x15, 0xe5, 0x04, 0xd1, 0xf7,
-	0xe1, 0xf5, 0x25, 0xe8, 0xc4, 0xb1, 0xcd, 0x9b, 0xdb, 0x66, 0xe5, 0x2c, 0x22, 0x72, 0x10, 0x20,
-	0x47, 0x1b, 0x6a, 0x8b, 0x1c, 0xa7, 0x67, 0xa7, 0xfd, 0xfd, 0xe3, 0x6a, 0xb3, 0x5e, 0xbd, 0xb9,
-	0x6d, 0x96, 0xe3, 0xe9, 0x2e, 0xf0, 0x33, 0xcf, 0x3a, 0x8f, 0x3f, 0xbb, 0x6b, 0x68, 0x9f, 0xdf,
-	0x35, 0xb4, 0xff, 0xdc, 0x35, 0xb4, 0x4f, 0x9f, 0x37, 0xd6, 0x3e, 0x7f, 0xde, 0x58, 0xfb, 0xf7,
-	0xf3, 0xc6, 0xda, 0x6f, 0x7e, 0x3d, 0xf6, 0xf8, 0xc5, 0x64, 0xd8, 0x1e, 0xd1, 0xcb, 0xdd, 0x47,
-	0x1f, 0x9f, 0x1f, 0xf5, 0x08, 0xbf, 0xa6, 0xd1, 0xd3, 0xdd, 0xb8, 0x70, 0x7f, 0x86, 0xbf, 0xee,
-	0xe5, 0x3f, 0x18, 0x16, 0x7f, 0xf0, 0x0f, 0xf3, 0x78, 0xf3, 0x8b, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0xe7, 0x8d, 0x15, 0xe4, 0xbb, 0x10, 0x00, 0x00,
+	// 1759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x25, 0x4a, 0xa2, 0x9e, 0x24, 0x5b, 0x66, 0x9d, 0x44, 0xd1, 0x26, 0xb2, 0xa2, 0x22,
+	0xad, 0x93, 0xb6, 0xf2, 0xd6, 0x45, 0x8b, 0xe4, 0x50, 0x04, 0x96, 0xad, 0x6c, 0xb4, 0x6b, 0xcb,
+	0x06, 0xa5, 0x78, 0x91, 0x22, 0x00, 0x41, 0x89, 0xb3, 0x12, 0xbb, 0x34, 0x87, 0x25, 0x47, 0x96,
+	0x36, 0x9f, 0xa0, 0xf0, 0xa5, 0x39, 0x17, 0xf0, 0xa9, 0x2d, 0xd0, 0x4f, 0xd0, 0x1e, 0x7a, 0x2d,
+	0x8a, 0x1c, 0x73, 0x6b, 0x4f, 0x49, 0xe1, 0x45, 0x2f, 0x45, 0x3f, 0x44, 0x31, 0x6f, 0x86, 0x94,
+	0xb8, 0x92, 0x92, 0xb4, 0x59, 0xb4, 0x40, 0x2e, 0xc2, 0xcc, 0x7b, 0xbf, 0xf7, 0xe6, 0xfd, 0x9d,
+	0x79, 0x14, 0xd4, 0x86, 0xc4, 0x25, 0x21, 0x73, 0xac, 0x21, 0x0d, 0xc8, 0x3e, 0x7b, 0xe2, 0x93,
+	0x50, 0xfc, 0x36, 0xfd, 0x80, 0x32, 0xaa, 0xeb, 0x8b, 0xfc, 0x26, 0x72, 0xaa, 0x3b, 0x23, 0x3a,
+	0xa2, 0xc8, 0xde, 0xe7, 0x2b, 0x81, 0xac, 0xee, 0x8e, 0x28, 0x1d, 0xb9, 0x64, 0x1f, 0x77, 0x83,
+	0xc9, 0xa3, 0x7d, 0xe6, 0x5c, 0x92, 0x90, 0x59, 0x97, 0x7e, 0x04, 0x48, 0x1c, 0x35, 0x0c, 0x9e,
+	0xf8, 0x8c, 0x72, 0x34, 0x7d, 0x24, 0x01, 0xf5, 0x04, 0xe0, 0x8a, 0x04, 0xa1, 0x43, 0xbd, 0x45,
+	0x6b, 0xaa, 0x8d, 0x15, 0xd6, 0x5e, 0x59, 0xae, 0x63, 0x5b, 0x8c, 0x06, 0x02, 0xd3, 0x78, 0x1b,
+	0x4a, 0xe7, 0x56, 0xc0, 0x7a, 0x84, 0xbd, 0x47, 0x2c, 0x9b, 0x04, 0xfa, 0x0e, 0x64, 0x18, 0x65,
+	0x96, 0x5b, 0x51, 0xea, 0xca, 0x5e, 0xc9, 0x10, 0x1b, 0x5d, 0x07, 0x75, 0x6c, 0x85, 0xe3, 0x4a,
+	0xaa, 0xae, 0xec, 0x15, 0x0d, 0x5c, 0x37, 0x7e, 0x0e, 0x2a, 0x17, 0xe5, 0x12, 0x8e, 0x67, 0x93,
+	0x59, 0x24, 0x81, 0x1b, 0x4e, 0x1d, 0x3c, 0x61, 0x24, 0x94, 0x22, 0x62, 0xa3, 0xff, 0x04, 0x32,
+	0xe8, 0x43, 0x25, 0x5d, 0x57, 0xf6, 0x0a, 0x07, 0xd5, 0x66, 0x22, 0x60, 0xc2, 0xcb, 0xe6, 0x39,
+	0x47, 0xb4, 0xd4, 0x4f, 0x3e, 0xdb, 0xdd, 0x30, 0x04, 0xbc, 0xe1, 0x41, 0xae, 0xe5, 0xd2, 0xe1,
+	0xe3, 0xce, 0x71, 0x6c, 0x8a, 0x32, 0x37, 0x45, 0x3f, 0x83, 0x2d, 0xdf, 0x0a, 0x98, 0x19, 0x12,
+	0x66, 0x8e, 0xd1, 0x0f, 0x3c, 0xb6, 0x70, 0xf0, 0x5a, 0x73, 0x39, 0x23, 0xcd, 0x84, 0xc3, 0xf2,
+	0x9c, 0x92, 0xbf, 0x48, 0x6c, 0xfc, 0x53, 0x85, 0xac, 0x58, 0xea, 0xef, 0x40, 0x4e, 0x06, 0x17,
+	0x8f, 0x2c, 0x1c, 0xec, 0x26, 0x75, 0x4a, 0x66, 0xf3, 0x88, 0x7a, 0x21, 0xf1, 0xc2, 0x49, 0x28,
+	0x35, 0x46, 0x52, 0xfa, 0x77, 0x40, 0x1b, 0x8e, 0x2d, 0xc7, 0x33, 0x1d, 0x1b, 0xad, 0xca, 0xb7,
+	0x0a, 0xb7, 0x9f, 0xed, 0xe6, 0x8e, 0x38, 0xad, 0x73, 0x6c, 0xe4, 0x90, 0xd9, 0xb1, 0xf5, 0x17,
+	0x21, 0x3b, 0x26, 0xce, 0x68, 0xcc, 0x30, 0x38, 0x69, 0x43, 0xee, 0xf4, 0xb7, 0x40, 0xe5, 0xc5,
+	0x51, 0x51, 0x65, 0xc8, 0x44, 0xe5, 0x34, 0xa3, 0xca, 0x69, 0xf6, 0xa3, 0xca, 0x69, 0x69, 0xfc,
+	0xe0, 0x8f, 0x3f, 0xdf, 0x55, 0x0c, 0x94, 0xd0, 0xdb, 0x50, 0x72, 0xad, 0x90, 0x99, 0x03, 0x1e,
+	0x3a, 0x7e, 0x7c, 0x06, 0x55, 0xdc, 0x59, 0x15, 0x14, 0x19, 0x5e, 0x69, 0x7c, 0x81, 0xcb, 0x09,
+	0x92, 0xad, 0xef, 0x41, 0x19, 0xd5, 0x0c, 0xe9, 0xe5, 0xa5, 0xc3, 0x4c, 0x8c, 0x7e, 0x16, 0xa3,
+	0xbf, 0xc9, 0xe9, 0x47, 0x48, 0x7e, 0x8f, 0xe7, 0xe1, 0x0e, 0xe4, 0x6d, 0x8b, 0x59, 0x02, 0x92,
+	0x43, 0x88, 0xc6, 0x09, 0xc8, 0xfc, 0x2e, 0x6c, 0xc5, 0xd5, 0x17, 0x0a, 0x88, 0x26, 0xb4, 0xcc,
+	0xc9, 0x08, 0xbc, 0x0b, 0x3b, 0x1e, 0x99, 0x31, 0xf3, 0x59, 0x74, 0x1e, 0xd1, 0x3a, 0xe7, 0x5d,
+	0x24, 0x25, 0x5e, 0x87, 0xcd, 0x61, 0x14, 0x7e, 0x81, 0x05, 0xc4, 0x96, 0x62, 0x2a, 0xc2, 0x5e,
+	0x06, 0xcd, 0xf2, 0x7d, 0x01, 0x28, 0x20, 0x20, 0x67, 0xf9, 0x3e, 0xb2, 0xde, 0x84, 0x6d, 0xf4,
+	0x31, 0x20, 0xe1, 0xc4, 0x65, 0x52, 0x49, 0x11, 0x31, 0x5b, 0x9c, 0x61, 0x08, 0x3a, 0x62, 0xbf,
+	0x0d, 0x25, 0x72, 0xe5, 0xd8, 0xc4, 0x1b, 0x12, 0x81, 0x2b, 0x21, 0xae, 0x18, 0x11, 0x11, 0xf4,
+	0x06, 0x94, 0xfd, 0x80, 0xfa, 0x34, 0x24, 0x81, 0x69, 0xd9, 0x76, 0x40, 0xc2, 0xb0, 0xb2, 0x29,
+	0xf4, 0x45, 0xf4, 0x43, 0x41, 0x6e, 0x98, 0xa0, 0x1e, 0x5b, 0xcc, 0xd2, 0xcb, 0x90, 0x66, 0xb3,
+	0xb0, 0xa2, 0xd4, 0xd3, 0x7b, 0x45, 0x83, 0x2f, 0xf5, 0x5d, 0x28, 0x84, 0xbf, 0x98, 0x58, 0x01,
+	0x31, 0x43, 0xe7, 0x23, 0x82, 0xe9, 0x53, 0x0d, 0x10, 0xa4, 0x9e, 0xf3, 0x11, 0x89, 0x9b, 0x21,
+	0x3b, 0x6f, 0x86, 0xfb, 0xaa, 0x96, 0x2a, 0xa7, 0xef, 0xab, 0x5a, 0xba, 0xac, 0xde, 0x57, 0x35,
+	0xb5, 0x9c, 0x69, 0xfc, 0x4a, 0x01, 0xb5, 0xe5, 0xd2, 0x81, 0xfe, 0x1a, 0x14, 0x3d, 0xeb, 0x92,
+	0x84, 0xbe, 0x35, 0x24, 0xbc, 0x1e, 0x44, 0x0f, 0x15, 0x62, 0x5a, 0xc7, 0xe6, 0x1a, 0x79, 0xc6,
+	0xa2, 0x4e, 0xe7, 0x6b, 0xee, 0x70, 0x38, 0xe6, 0x56, 0x44, 0x8d, 0x90, 0xc6, 0x4e, 0x2f, 0x22,
+	0xf1, 0x42, 0xd0, 0xf4, 0xef, 0xc1, 0xf6, 0x5c, 0x77, 0x04, 0x54, 0x11, 0x58, 0x8e, 0x19, 0x12,
+	0xdc, 0xf8, 0x53, 0x1a, 0xd4, 0x0b, 0xca, 0x88, 0xfe, 0x63, 0x50, 0x79, 0xfd, 0xa1, 0x25, 0x9b,
+	0xab, 0xdb, 0xb5, 0xe7, 0x8c, 0x3c, 0x62, 0x9f, 0x86, 0xa3, 0xfe, 0x13, 0x9f, 0x18, 0x08, 0x5f,
+	0xe8, 0x95, 0x54, 0xa2, 0x57, 0x76, 0x20, 0x13, 0xd0, 0x89, 0x67, 0xa3, 0x85, 0x19, 0x43, 0x6c,
+	0xf4, 0x7b, 0xa0, 0xc5, 0x2d, 0xa0, 0x7e, 0x79, 0x0b, 0x6c, 0xf1, 0x16, 0xe0, 0x2d, 0x2a, 0x09,
+	0x46, 0x6e, 0x20, 0x3b, 0xa1, 0x05, 0xf9, 0xf8, 0x9e, 0xae, 0x64, 0xfe, 0x83, 0x7e, 0x9c, 0x8b,
+	0xf1, 0x38, 0xc5, 0x85, 0x1d, 0x57, 0x86, 0xc8, 0x5f, 0x39, 0x66, 0xc8, 0xd2, 0x48, 0xf4, 0x8c,
+	0x29, 0x6e, 0xd9, 0x1c, 0x7a, 0x36, 0xef, 0x99, 0x0e, 0xa7, 0xea, 0xaf, 0x40, 0x3e, 0x74, 0x46,
+	0x9e, 0xc5, 0x26, 0x01, 0x91, 0x6d, 0x35, 0x27, 0x70, 0x2e, 0x99, 0x31, 0xe2, 0x61, 0x4e, 0x44,
+	0x1b, 0xcd, 0x09, 0xfa, 0x3e, 0x7c, 0x2b, 0xde, 0x98, 0x73, 0x2d, 0xa2, 0x85, 0xf4, 0x98, 0xd5,
+	0x8b, 0x38, 0x8d, 0x3f, 0x2b, 0x90, 0x15, 0x5d, 0xbf, 0x90, 0x08, 0x65, 0x75, 0x22, 0x52, 0xeb,
+	0x12, 0x91, 0xfe, 0x3a, 0x89, 0x38, 0x02, 0x88, 0x0d, 0x0d, 0x2b, 0x6a, 0x3d, 0xbd, 0x57, 0x38,
+	0x78, 0x75, 0x95, 0x2a, 0x61, 0x66, 0xcf, 0x19, 0xc9, 0x8b, 0x6d, 0x41, 0xac, 0xf1, 0xb9, 0x02,
+	0xf9, 0x98, 0xaf, 0x1f, 0x41, 0x29, 0xb2, 0xcd, 0x7c, 0xe4, 0x5a, 0x23, 0x59, 0x92, 0xbb, 0x5f,
+	0x60, 0xe0, 0xbb, 0xae, 0x35, 0x32, 0x0a, 0xd2, 0x26, 0xbe, 0x59, 0x9d, 0xdc, 0xd4, 0x9a, 0xe4,
+	0x26, 0xaa, 0x29, 0xfd, 0xdf, 0x55, 0x53, 0x22, 0xef, 0xea, 0x33, 0x79, 0x6f, 0xfc, 0x43, 0x81,
+	0xcd, 0x36, 0xcf, 0x9f, 0x4d, 0xec, 0xff, 0x6f, 0xc2, 0x3e, 0x94, 0x35, 0x66, 0x13, 0xdb, 0x5c,
+	0xca, 0xdc, 0xeb, 0xab, 0x74, 0x26, 0xed, 0x9e, 0x67, 0x50, 0x8f, 0xf4, 0xf4, 0xe6, 0x99, 0xfc,
+	0x43, 0x0a, 0xb6, 0x97, 0xf0, 0xdf, 0xc4, 0x8c, 0x26, 0x3b, 0x39, 0xf3, 0x15, 0x3b, 0x39, 0xbb,
+	0xb6, 0x93, 0xff, 0x98, 0x02, 0xed, 0x1c, 0x9f, 0x23, 0xcb, 0xfd, 0xdf, 0xdc, 0xc5, 0x77, 0x20,
+	0xef, 0x53, 0xd7, 0x14, 0x1c, 0x15, 0x39, 0x9a, 0x4f, 0x5d, 0x63, 0xa9, 0xdc, 0x32, 0xcf, 0xed,
+	0xa2, 0xce, 0x3e, 0x87, 0x44, 0xe4, 0x9e, 0x6d, 0xad, 0x2b, 0x28, 0x8a, 0x60, 0xc8, 0x31, 0xf1,
+	0x80, 0x47, 0x81, 0xaf, 0x2a, 0xca, 0xaa, 0xd1, 0x56, 0x18, 0x2e, 0xb0, 0x46, 0x76, 0x1c, 0xcb,
+	0x88, 0x99, 0xaa, 0x92, 0x5a, 0x2f, 0x23, 0xea, 0xd9, 0x90, 0xc8, 0xc6, 0xaf, 0x15, 0x80, 0x13,
+	0x1e, 0x5f, 0xf4, 0x99, 0x8f, 0x78, 0x21, 0x9a, 0x61, 0x26, 0x4e, 0xaf, 0xaf, 0x4f, 0x9e, 0xb4,
+	0xa1, 0x18, 0x2e, 0x5a, 0xdf, 0x86, 0xd2, 0xbc, 0xca, 0x43, 0x12, 0x19, 0xb4, 0x52, 0x4d, 0x3c,
+	0x7b, 0xf5, 0x08, 0x33, 0x8a, 0x57, 0x0b, 0xbb, 0xc6, 0x5f, 0x14, 0xc8, 0xa3, 0x5d, 0xa7, 0x84,
+	0x59, 0x89, 0x6c, 0x2a, 0x5f, 0x27, 0x9b, 0xaf, 0x02, 0x08, 0x45, 0x38, 0x05, 0x89, 0x2a, 0xcb,
+	0x23, 0x05, 0x87, 0xa0, 0xb7, 0xe2, 0xd0, 0xa7, 0xbf, 0x2c, 0xf4, 0xf2, 0x0e, 0x89, 0x12, 0xf0,
+	0x12, 0xe4, 0xbc, 0xc9, 0xa5, 0xc9, 0xa7, 0x2e, 0x55, 0xd4, 0xae, 0x37, 0xb9, 0xec, 0xcf, 0xc2,
+	0x86, 0x0b, 0xb9, 0xfe, 0x0c, 0xbf, 0x43, 0x78, 0xc1, 0x06, 0x94, 0xca, 0xb1, 0x57, 0x0c, 0x4c,
+	0x1a, 0x27, 0xe0, 0x94, 0xb7, 0x6a, 0x5a, 0xba, 0xfb, 0x95, 0xbf, 0x71, 0xa2, 0xaf, 0x9b, 0x0f,
+	0xa1, 0x88, 0xaf, 0xf8, 0xc3, 0xc0, 0xf2, 0x7d, 0x12, 0xe8, 0x9b, 0x90, 0x62, 0x33, 0x79, 0x56,
+	0x8a, 0xcd, 0xe6, 0xf3, 0x17, 0x4e, 0x00, 0xf8, 0x4d, 0x95, 0x8e, 0xe7, 0xaf, 0x8e, 0xa0, 0x71,
+	0x5f, 0xb8, 0xa7, 0xd1, 0x4d, 0x9d, 0x37, 0xb2, 0x7c, 0xdb, 0xb1, 0x1b, 0x16, 0x64, 0xf9, 0xf0,
+	0xd7, 0x9f, 0x2d, 0xe9, 0x6d, 0x42, 0x66, 0xe0, 0xd2, 0x81, 0xd0, 0x57, 0x38, 0xa8, 0xac, 0xc9,
+	0xce, 0xc0, 0x10, 0xb0, 0xf5, 0x47, 0xfc, 0x4b, 0x01, 0xe8, 0x71, 0x63, 0x44, 0xc8, 0xa2, 0xa8,
+	0x88, 0x49, 0x16, 0xd7, 0xfa, 0x3b, 0x20, 0xcc, 0x35, 0xd1, 0xe5, 0xe8, 0xc8, 0x57, 0x56, 0x1d,
+	0xd9, 0x3d, 0xed, 0x8b, 0xf0, 0x14, 0xc2, 0x58, 0x67, 0xb8, 0x34, 0xbb, 0xa6, 0x97, 0x67, 0xd7,
+	0xb7, 0x79, 0xaa, 0xa6, 0xe2, 0x04, 0x39, 0xe8, 0xad, 0x3c, 0xc0, 0xa0, 0x53, 0x71, 0x80, 0x16,
+	0xc8, 0xd5, 0xea, 0xe9, 0x35, 0xb3, 0x66, 0x7a, 0xfd, 0x9d, 0x02, 0x5a, 0xa4, 0x43, 0xd4, 0xc7,
+	0xd4, 0xe4, 0x25, 0x11, 0xcd, 0xee, 0x5c, 0xad, 0xc1, 0xf7, 0xbc, 0xc3, 0x13, 0xfe, 0x7e, 0x51,
+	0x31, 0x48, 0x24, 0x8f, 0x1e, 0x57, 0x26, 0x1d, 0xc4, 0x35, 0x3f, 0x24, 0x64, 0xfc, 0x0b, 0x37,
+	0xa0, 0x53, 0x39, 0x54, 0x6b, 0x48, 0x30, 0xe8, 0x94, 0xa7, 0x85, 0x78, 0x36, 0xb2, 0x84, 0xc5,
+	0x59, 0xe2, 0xd9, 0x06, 0x9d, 0x36, 0x08, 0x68, 0x51, 0x2c, 0xf9, 0x6d, 0x8c, 0x02, 0x98, 0xfe,
+	0x8c, 0x21, 0x36, 0xfc, 0x93, 0x83, 0xc4, 0x6f, 0x3e, 0x5f, 0x72, 0x9c, 0x47, 0x6d, 0x12, 0x56,
+	0xd2, 0xe8, 0x8a, 0xd8, 0xf0, 0xf3, 0x5d, 0x62, 0x3d, 0x12, 0x4d, 0x20, 0x1e, 0x25, 0x8d, 0x13,
+	0x78, 0x13, 0xbc, 0xf9, 0x57, 0x05, 0x0a, 0x0b, 0xef, 0xa7, 0xfe, 0x43, 0x78, 0xa1, 0x75, 0x72,
+	0x76, 0xf4, 0xc0, 0xec, 0x1c, 0x9b, 0xef, 0x9e, 0x1c, 0xde, 0x33, 0xdf, 0xef, 0x3e, 0xe8, 0x9e,
+	0x3d, 0xec, 0x96, 0x37, 0xaa, 0x2f, 0x5e, 0xdf, 0xd4, 0xf5, 0x05, 0xec, 0xfb, 0xde, 0x63, 0x8f,
+	0x4e, 0xf9, 0xc3, 0xb5, 0x93, 0x14, 0x39, 0x6c, 0xf5, 0xda, 0xdd, 0x7e, 0x59, 0xa9, 0xbe, 0x70,
+	0x7d, 0x53, 0xdf, 0x5e, 0x90, 0x38, 0x1c, 0x84, 0xc4, 0x63, 0xcb, 0x02, 0x47, 0x67, 0xa7, 0xa7,
+	0x9d, 0x7e, 0x39, 0xb5, 0x24, 0x20, 0xe7, 0x9e, 0x37, 0x60, 0x3b, 0x29, 0xd0, 0xed, 0x9c, 0x94,
+	0xd3, 0x55, 0xfd, 0xfa, 0xa6, 0xbe, 0xb9, 0x80, 0xee, 0x3a, 0x6e, 0x55, 0xfb, 0xe5, 0x6f, 0x6a,
+	0x1b, 0xbf, 0xff, 0x6d, 0x4d, 0xe1, 0x9e, 0x95, 0x12, 0x4f, 0x9e, 0xfe, 0x7d, 0x78, 0xa9, 0xd7,
+	0xb9, 0xd7, 0x6d, 0x1f, 0x9b, 0xa7, 0xbd, 0x7b, 0x66, 0xff, 0x83, 0xf3, 0xf6, 0x82, 0x77, 0x5b,
+	0xd7, 0x37, 0xf5, 0x82, 0x74, 0x69, 0x1d, 0xfa, 0xdc, 0x68, 0x5f, 0x9c, 0xf5, 0xdb, 0x65, 0x45,
+	0xa0, 0xcf, 0x03, 0x72, 0x45, 0x19, 0x41, 0xf4, 0x5d, 0x78, 0x79, 0x05, 0x3a, 0x76, 0x6c, 0xfb,
+	0xfa, 0xa6, 0x5e, 0x3a, 0x0f, 0x88, 0x78, 0x08, 0x50, 0xa2, 0x09, 0x95, 0x65, 0x89, 0xb3, 0xf3,
+	0xb3, 0xde, 0xe1, 0x49, 0xb9, 0x5e, 0x2d, 0x5f, 0xdf, 0xd4, 0x8b, 0xd1, 0xeb, 0xce, 0xf1, 0x73,
+	0xcf, 0x5a, 0x0f, 0x3f, 0xb9, 0xad, 0x29, 0x9f, 0xde, 0xd6, 0x94, 0xbf, 0xdf, 0xd6, 0x94, 0x8f,
+	0x9f, 0xd6, 0x36, 0x3e, 0x7d, 0x5a, 0xdb, 0xf8, 0xdb, 0xd3, 0xda, 0xc6, 0xcf, 0x7e, 0x3a, 0x72,
+	0xd8, 0x78, 0x32, 0x68, 0x0e, 0xe9, 0xe5, 0xfe, 0x83, 0x0f, 0x2e, 0xda, 0x5d, 0xc2, 0xa6, 0x34,
+	0x78, 0xbc, 0x1f, 0x15, 0xee, 0x0f, 0xf0, 0xdf, 0x24, 0xf1, 0x87, 0xd6, 0xf2, 0x1f, 0x4c, 0x83,
+	0x2c, 0x72, 0x7e, 0xf4, 0xef, 0x01, 0x00, 0x10, 0xd3, 0x59, 0x65, 0x2b, 0x13, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1987,6 +2173,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -2149,6 +2349,127 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtendedCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtendedSignatures) > 0 {
+		for iNdEx := len(m.ExtendedSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtendedSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.BlockID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtendedCommitSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommitSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockIdFlag != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockIdFlag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2176,12 +2497,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -2441,20 +2762,20 @@ func (m *IndexWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShareIndexes) > 0 {
-		dAtA20 := make([]byte, len(m.ShareIndexes)*10)
-		var j19 int
+		dAtA22 := make([]byte, len(m.ShareIndexes)*10)
+		var j21 int
 		for _, num := range m.ShareIndexes {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j21++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintTypes(dAtA, i, uint64(j19))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTypes(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2894,6 +3215,14 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2942,32 +3271,85 @@ func (m *CommitSig) Size() (n int) {
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *ExtendedCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
 	if m.Height != 0 {
 		n += 1 + sovTypes(uint64(m.Height))
 	}
 	if m.Round != 0 {
 		n += 1 + sovTypes(uint64(m.Round))
 	}
-	if m.PolRound != 0 {
-		n += 1 + sovTypes(uint64(m.PolRound))
-	}
 	l = m.BlockID.Size()
 	n += 1 + l + sovTypes(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
+	if len(m.ExtendedSignatures) > 0 {
+		for _, e := range m.ExtendedSignatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExtendedCommitSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockIdFlag != 0 {
+		n += 1 + sovTypes(uint64(m.BlockIdFlag))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.PolRound != 0 {
+		n += 1 + sovTypes(uint64(m.PolRound))
+	}
+	l = m.BlockID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -4578,6 +4960,74 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4924,6 +5374,399 @@ func (m *CommitSig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedSignatures = append(m.ExtendedSignatures, ExtendedCommitSig{})
+			if err := m.ExtendedSignatures[len(m.ExtendedSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedCommitSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommitSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommitSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockIdFlag", wireType)
+			}
+			m.BlockIdFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockIdFlag |= BlockIDFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,8 @@ type AppConnConsensus interface {
 
 	PrepareProposalSync(types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error)
 	ProcessProposalSync(types.RequestProcessProposal) (*types.ResponseProcessProposal, error)
+	ExtendVoteSync(types.RequestExtendVote) (*types.ResponseExtendVote, error)
+	VerifyVoteExtensionSync(types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
 }
 
 type AppConnMempool interface {
@@ -106,6 +108,18 @@ func (app *appConnConsensus) ProcessProposalSync(
 	return app.appConn.ProcessProposalSync(req)
 }
 
+func (app *appConnConsensus) ExtendVoteSync(
+	req types.RequestExtendVote,
+) (*types.ResponseExtendVote, error) {
+	return app.appConn.ExtendVoteSync(req)
+}
+
+func (app *appConnConsensus) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension,
+) (*types.ResponseVerifyVoteExtension, error) {
+	return app.appConn.VerifyVoteExtensionSync(req)
+}
+
 //------------------------------------------------
 // Implements AppConnMempool (subset of abcicli.Client)
 
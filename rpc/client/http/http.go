@@ -329,6 +329,15 @@ func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, err
 	return result, nil
 }
 
+func (c *baseRPCClient) NetworkMap(ctx context.Context) (*ctypes.ResultNetworkMap, error) {
+	result := new(ctypes.ResultNetworkMap)
+	_, err := c.caller.Call(ctx, "network_map", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	result := new(ctypes.ResultDumpConsensusState)
 	_, err := c.caller.Call(ctx, "dump_consensus_state", map[string]interface{}{}, result)
@@ -124,6 +124,7 @@ type StatusClient interface {
 // usually.
 type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
+	NetworkMap(context.Context) (*ctypes.ResultNetworkMap, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
@@ -115,6 +115,10 @@ func (c *Local) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo(c.ctx)
 }
 
+func (c *Local) NetworkMap(ctx context.Context) (*ctypes.ResultNetworkMap, error) {
+	return core.NetworkMap(c.ctx)
+}
+
 func (c *Local) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return core.DumpConsensusState(c.ctx)
 }
@@ -145,6 +149,16 @@ func (c *Local) DialPeers(
 	return core.UnsafeDialPeers(c.ctx, peers, persistent, unconditional, private)
 }
 
+func (c *Local) UpdatePeerAdmission(
+	ctx context.Context,
+	allowIDs,
+	denyIDs,
+	allowCIDRs,
+	denyCIDRs []string,
+) (*ctypes.ResultUpdatePeerAdmission, error) {
+	return core.UnsafeUpdatePeerAdmission(c.ctx, allowIDs, denyIDs, allowCIDRs, denyCIDRs)
+}
+
 func (c *Local) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return core.BlockchainInfo(c.ctx, minHeight, maxHeight)
 }
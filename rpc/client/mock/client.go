@@ -119,6 +119,10 @@ func (c Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo(&rpctypes.Context{})
 }
 
+func (c Client) NetworkMap(ctx context.Context) (*ctypes.ResultNetworkMap, error) {
+	return core.NetworkMap(&rpctypes.Context{})
+}
+
 func (c Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	return core.ConsensusState(&rpctypes.Context{})
 }
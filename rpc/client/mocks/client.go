@@ -565,6 +565,29 @@ func (_m *Client) NetInfo(_a0 context.Context) (*coretypes.ResultNetInfo, error)
 	return r0, r1
 }
 
+// NetworkMap provides a mock function with given fields: _a0
+func (_m *Client) NetworkMap(_a0 context.Context) (*coretypes.ResultNetworkMap, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultNetworkMap
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultNetworkMap); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultNetworkMap)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NumUnconfirmedTxs provides a mock function with given fields: _a0
 func (_m *Client) NumUnconfirmedTxs(_a0 context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
 	ret := _m.Called(_a0)
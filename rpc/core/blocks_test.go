@@ -326,7 +326,13 @@ func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { retur
 func (mockBlockStore) PruneBlocks(height int64) (uint64, error)          { return 0, nil }
 func (mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
-func (mockBlockStore) DeleteLatestBlock() error { return nil }
+func (mockBlockStore) SaveBlockWithExtendedCommit(
+	block *types.Block, blockParts *types.PartSet, seenCommit *types.ExtendedCommit,
+) error {
+	return nil
+}
+func (mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit { return nil }
+func (mockBlockStore) DeleteLatestBlock() error                                   { return nil }
 
 func (store mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	if height > store.height {
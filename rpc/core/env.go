@@ -13,6 +13,7 @@ import (
 	"github.com/KYVENetwork/celestia-core/libs/log"
 	mempl "github.com/KYVENetwork/celestia-core/mempool"
 	"github.com/KYVENetwork/celestia-core/p2p"
+	"github.com/KYVENetwork/celestia-core/p2p/pex"
 	"github.com/KYVENetwork/celestia-core/proxy"
 	sm "github.com/KYVENetwork/celestia-core/state"
 	"github.com/KYVENetwork/celestia-core/state/indexer"
@@ -78,6 +79,13 @@ type peers interface {
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	PeerTrustScore(p2p.ID) int
+	StopPeerGracefully(p2p.Peer)
+	StopReconnecting(p2p.ID)
+}
+
+type crawler interface {
+	NetworkMap() []pex.NodeRecord
 }
 
 // ----------------------------------------------
@@ -95,6 +103,8 @@ type Environment struct {
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
+	P2PCrawler     crawler // nil unless the node is a seed node
+	P2PAdmission   *p2p.PeerAdmission
 
 	// objects
 	PubKey           crypto.PubKey
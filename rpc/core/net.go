@@ -26,6 +26,7 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
+			TrustScore:       env.P2PPeers.PeerTrustScore(peer.ID()),
 		})
 	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
@@ -39,6 +40,32 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 	}, nil
 }
 
+// NetworkMap returns the nodes discovered by a seed node while crawling the
+// network, together with their NodeInfo and reachability.
+func NetworkMap(ctx *rpctypes.Context) (*ctypes.ResultNetworkMap, error) {
+	env := GetEnvironment()
+	if env.P2PCrawler == nil {
+		return nil, errors.New("network map is only available on seed nodes (p2p.seed_mode)")
+	}
+	records := env.P2PCrawler.NetworkMap()
+	nodes := make([]ctypes.NodeRecord, 0, len(records))
+	for _, record := range records {
+		nodes = append(nodes, ctypes.NodeRecord{
+			ID:          record.ID,
+			Addr:        record.Addr,
+			NodeInfo:    record.NodeInfo,
+			Reachable:   record.Reachable,
+			LastSeen:    record.LastSeen,
+			LastCrawled: record.LastCrawled,
+			LastError:   record.LastError,
+		})
+	}
+	return &ctypes.ResultNetworkMap{
+		NNodes: len(nodes),
+		Nodes:  nodes,
+	}, nil
+}
+
 // UnsafeDialSeeds dials the given seeds (comma-separated id@IP:PORT).
 func UnsafeDialSeeds(ctx *rpctypes.Context, seeds []string) (*ctypes.ResultDialSeeds, error) {
 	if len(seeds) == 0 {
@@ -94,6 +121,45 @@ func UnsafeDialPeers(ctx *rpctypes.Context, peers []string, persistent, uncondit
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeUpdatePeerAdmission replaces the lists of node IDs and CIDRs peers are
+// admitted or rejected by, and disconnects the peers which are no longer
+// admitted, without reconnecting to them even if they are persistent.
+func UnsafeUpdatePeerAdmission(ctx *rpctypes.Context, allowIDs, denyIDs, allowCIDRs, denyCIDRs []string) (
+	*ctypes.ResultUpdatePeerAdmission, error) {
+	env := GetEnvironment()
+	if env.P2PAdmission == nil {
+		return &ctypes.ResultUpdatePeerAdmission{}, errors.New("peer admission is not configured")
+	}
+
+	env.Logger.Info("UpdatePeerAdmission", "allow_ids", allowIDs, "deny_ids", denyIDs,
+		"allow_cidrs", allowCIDRs, "deny_cidrs", denyCIDRs)
+
+	err := env.P2PAdmission.Update(p2p.AdmissionLists{
+		AllowIDs:   allowIDs,
+		DenyIDs:    denyIDs,
+		AllowCIDRs: allowCIDRs,
+		DenyCIDRs:  denyCIDRs,
+	})
+	if err != nil {
+		return &ctypes.ResultUpdatePeerAdmission{}, err
+	}
+
+	disconnected := make([]p2p.ID, 0)
+	for _, peer := range env.P2PPeers.Peers().List() {
+		if err := env.P2PAdmission.Admit(peer.ID(), peer.RemoteIP()); err != nil {
+			env.Logger.Info("Disconnecting peer which is no longer admitted", "peer", peer.ID(), "err", err)
+			env.P2PPeers.StopPeerGracefully(peer)
+			env.P2PPeers.StopReconnecting(peer.ID())
+			disconnected = append(disconnected, peer.ID())
+		}
+	}
+
+	return &ctypes.ResultUpdatePeerAdmission{
+		Log:          "Updated peer admission lists",
+		Disconnected: disconnected,
+	}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.cometbft.com/v0.34/rpc/#/Info/genesis
 func Genesis(ctx *rpctypes.Context) (*ctypes.ResultGenesis, error) {
@@ -9,6 +9,8 @@ import (
 	cfg "github.com/KYVENetwork/celestia-core/config"
 	"github.com/KYVENetwork/celestia-core/libs/log"
 	"github.com/KYVENetwork/celestia-core/p2p"
+	"github.com/KYVENetwork/celestia-core/p2p/pex"
+	ctypes "github.com/KYVENetwork/celestia-core/rpc/core/types"
 	rpctypes "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/types"
 )
 
@@ -87,3 +89,64 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+type testCrawler []pex.NodeRecord
+
+func (c testCrawler) NetworkMap() []pex.NodeRecord { return c }
+
+func TestNetworkMap(t *testing.T) {
+	env := &Environment{}
+	SetEnvironment(env)
+
+	_, err := NetworkMap(&rpctypes.Context{})
+	assert.Error(t, err)
+
+	nodes := testCrawler{{ID: "d51fb70907db1c6c2d5237e78379b25cf1a37ab4", Reachable: true}}
+	env.P2PCrawler = nodes
+
+	res, err := NetworkMap(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.NNodes)
+	assert.Equal(t, []ctypes.NodeRecord{{ID: "d51fb70907db1c6c2d5237e78379b25cf1a37ab4", Reachable: true}}, res.Nodes)
+}
+
+func TestUnsafeUpdatePeerAdmission(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123",
+		func(n int, sw *p2p.Switch) *p2p.Switch { return sw })
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	env := &Environment{Logger: log.TestingLogger(), P2PPeers: sw}
+	SetEnvironment(env)
+
+	_, err = UnsafeUpdatePeerAdmission(&rpctypes.Context{}, nil, nil, nil, nil)
+	assert.Error(t, err)
+
+	env.P2PAdmission, err = p2p.NewPeerAdmission(p2p.AdmissionLists{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		allowIDs, denyIDs, allowCIDRs, denyCIDRs []string
+		isErr                                    bool
+	}{
+		{nil, []string{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4"}, nil, []string{"10.0.0.0/8"}, false},
+		{[]string{"foo"}, nil, nil, nil, true},
+		{nil, nil, []string{"10.0.0.1"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		res, err := UnsafeUpdatePeerAdmission(&rpctypes.Context{}, tc.allowIDs, tc.denyIDs, tc.allowCIDRs, tc.denyCIDRs)
+		if tc.isErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Empty(t, res.Disconnected)
+		}
+	}
+	assert.Error(t, env.P2PAdmission.Admit("d51fb70907db1c6c2d5237e78379b25cf1a37ab4", nil))
+}
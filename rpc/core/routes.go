@@ -17,6 +17,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"health":                    rpc.NewRPCFunc(Health, ""),
 	"status":                    rpc.NewRPCFunc(Status, ""),
 	"net_info":                  rpc.NewRPCFunc(NetInfo, ""),
+	"network_map":               rpc.NewRPCFunc(NetworkMap, ""),
 	"blockchain":                rpc.NewRPCFunc(BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
 	"genesis":                   rpc.NewRPCFunc(Genesis, "", rpc.Cacheable()),
 	"genesis_chunked":           rpc.NewRPCFunc(GenesisChunked, "chunk", rpc.Cacheable()),
@@ -60,5 +61,6 @@ func AddUnsafeRoutes() {
 	// control API
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
+	Routes["update_peer_admission"] = rpc.NewRPCFunc(UnsafeUpdatePeerAdmission, "allow_ids,deny_ids,allow_cidrs,deny_cidrs")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
 }
@@ -143,6 +143,23 @@ type ResultNetInfo struct {
 	Peers     []Peer   `json:"peers"`
 }
 
+// Nodes discovered by a crawling seed node
+type ResultNetworkMap struct {
+	NNodes int          `json:"n_nodes"`
+	Nodes  []NodeRecord `json:"nodes"`
+}
+
+// A node discovered by a crawling seed node
+type NodeRecord struct {
+	ID          p2p.ID               `json:"id"`
+	Addr        *p2p.NetAddress      `json:"addr"`
+	NodeInfo    *p2p.DefaultNodeInfo `json:"node_info,omitempty"`
+	Reachable   bool                 `json:"reachable"`
+	LastSeen    time.Time            `json:"last_seen"`
+	LastCrawled time.Time            `json:"last_crawled"`
+	LastError   string               `json:"last_error,omitempty"`
+}
+
 // Log from dialing seeds
 type ResultDialSeeds struct {
 	Log string `json:"log"`
@@ -153,12 +170,20 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Result of updating the peer admission lists
+type ResultUpdatePeerAdmission struct {
+	Log string `json:"log"`
+	// Peers disconnected because they are no longer admitted
+	Disconnected []p2p.ID `json:"disconnected"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	TrustScore       int                  `json:"trust_score"`
 }
 
 // Validators for a height.
@@ -3,9 +3,15 @@ package coregrpc
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	abci "github.com/KYVENetwork/celestia-core/abci/types"
+	cmtmath "github.com/KYVENetwork/celestia-core/libs/math"
+	cmtproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
 	core "github.com/KYVENetwork/celestia-core/rpc/core"
 	rpctypes "github.com/KYVENetwork/celestia-core/rpc/jsonrpc/types"
+	"github.com/KYVENetwork/celestia-core/types"
 )
 
 type broadcastAPI struct {
@@ -37,3 +43,78 @@ func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcast
 		},
 	}, nil
 }
+
+// MaxLightBlocks is the maximum number of light blocks streamed by
+// LightBlocks, like the block metas returned by the blockchain endpoint.
+const MaxLightBlocks int64 = 20
+
+type lightBlockAPI struct {
+}
+
+func (lapi *lightBlockAPI) LightBlock(ctx context.Context, req *RequestLightBlock) (*ResponseLightBlock, error) {
+	height := req.Height
+	if height == 0 {
+		height = core.GetEnvironment().BlockStore.Height()
+	}
+	lb, err := loadLightBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseLightBlock{LightBlock: lb}, nil
+}
+
+func (lapi *lightBlockAPI) LightBlocks(req *RequestLightBlocks, stream LightBlockAPI_LightBlocksServer) error {
+	if req.FromHeight <= 0 || req.ToHeight < req.FromHeight {
+		return status.Errorf(codes.InvalidArgument, "invalid range of heights [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+	to := cmtmath.MinInt64(req.ToHeight, req.FromHeight+MaxLightBlocks-1)
+	for height := req.FromHeight; height <= to; height++ {
+		lb, err := loadLightBlock(height)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&ResponseLightBlock{LightBlock: lb}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadLightBlock loads the light block at the given height. Like the commit
+// endpoint, it uses the non-canonical seen commit for the latest height.
+func loadLightBlock(height int64) (*cmtproto.LightBlock, error) {
+	env := core.GetEnvironment()
+	latest := env.BlockStore.Height()
+	switch {
+	case height <= 0:
+		return nil, status.Errorf(codes.InvalidArgument, "height must be greater than 0, but got %d", height)
+	case height > latest:
+		return nil, status.Errorf(codes.OutOfRange,
+			"height %d must be less than or equal to the current blockchain height %d", height, latest)
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, status.Errorf(codes.NotFound, "height %d is not available, lowest height is %d",
+			height, env.BlockStore.Base())
+	}
+	var commit *types.Commit
+	if height == latest {
+		commit = env.BlockStore.LoadSeenCommit(height)
+	} else {
+		commit = env.BlockStore.LoadBlockCommit(height)
+	}
+	if commit == nil {
+		return nil, status.Errorf(codes.NotFound, "commit at height %d is not available", height)
+	}
+	vals, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validators at height %d are not available: %v", height, err)
+	}
+
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &blockMeta.Header, Commit: commit},
+		ValidatorSet: vals,
+	}
+	return lb.ToProto()
+}
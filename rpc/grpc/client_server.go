@@ -15,12 +15,13 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// StartGRPCServer starts a new gRPC BroadcastAPIServer and LightBlockAPIServer
+// using the given net.Listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func StartGRPCServer(ln net.Listener) error {
 	grpcServer := grpc.NewServer()
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterLightBlockAPIServer(grpcServer, &lightBlockAPI{})
 	return grpcServer.Serve(ln)
 }
 
@@ -34,6 +35,16 @@ func StartGRPCClient(protoAddr string) BroadcastAPIClient {
 	return NewBroadcastAPIClient(conn)
 }
 
+// StartLightBlockGRPCClient dials the gRPC server using protoAddr and returns a
+// new LightBlockAPIClient.
+func StartLightBlockGRPCClient(protoAddr string) (LightBlockAPIClient, error) {
+	conn, err := grpc.Dial(protoAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithContextDialer(dialerFunc))
+	if err != nil {
+		return nil, err
+	}
+	return NewLightBlockAPIClient(conn), nil
+}
+
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
 	return cmtnet.Connect(addr)
 }
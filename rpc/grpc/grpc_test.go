@@ -2,12 +2,17 @@ package coregrpc_test
 
 import (
 	"context"
+	"io"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/KYVENetwork/celestia-core/abci/example/kvstore"
+	rpcclient "github.com/KYVENetwork/celestia-core/rpc/client"
+	rpchttp "github.com/KYVENetwork/celestia-core/rpc/client/http"
 	core_grpc "github.com/KYVENetwork/celestia-core/rpc/grpc"
 	rpctest "github.com/KYVENetwork/celestia-core/rpc/test"
 )
@@ -33,3 +38,45 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestLightBlock(t *testing.T) {
+	client, err := core_grpc.StartLightBlockGRPCClient(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+
+	res, err := client.LightBlock(context.Background(), &core_grpc.RequestLightBlock{Height: 0})
+	require.NoError(t, err)
+	latest := res.LightBlock.SignedHeader.Header.Height
+	require.Positive(t, latest)
+
+	_, err = client.LightBlock(context.Background(), &core_grpc.RequestLightBlock{Height: latest + 1000})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	to := latest
+	if to > core_grpc.MaxLightBlocks {
+		to = core_grpc.MaxLightBlocks
+	}
+	stream, err := client.LightBlocks(context.Background(), &core_grpc.RequestLightBlocks{FromHeight: 1, ToHeight: to})
+	require.NoError(t, err)
+	for height := int64(1); height <= to; height++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, res.LightBlock.SignedHeader.Header.Height)
+	}
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	// at most MaxLightBlocks light blocks are streamed
+	c, err := rpchttp.New(rpctest.GetConfig().RPC.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	require.NoError(t, rpcclient.WaitForHeight(c, core_grpc.MaxLightBlocks+1, nil))
+	stream, err = client.LightBlocks(context.Background(),
+		&core_grpc.RequestLightBlocks{FromHeight: 1, ToHeight: core_grpc.MaxLightBlocks + 1})
+	require.NoError(t, err)
+	for height := int64(1); height <= core_grpc.MaxLightBlocks; height++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, res.LightBlock.SignedHeader.Header.Height)
+	}
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+}
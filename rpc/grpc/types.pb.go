@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/KYVENetwork/celestia-core/abci/types"
+	types1 "github.com/KYVENetwork/celestia-core/proto/celestiacore/types"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -108,6 +109,102 @@ func (m *RequestBroadcastTx) GetTx() []byte {
 	return nil
 }
 
+type RequestLightBlock struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestLightBlock) Reset()         { *m = RequestLightBlock{} }
+func (m *RequestLightBlock) String() string { return proto.CompactTextString(m) }
+func (*RequestLightBlock) ProtoMessage()    {}
+func (*RequestLightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d4ae276869a4fcb, []int{2}
+}
+func (m *RequestLightBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLightBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLightBlock.Merge(m, src)
+}
+func (m *RequestLightBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLightBlock proto.InternalMessageInfo
+
+func (m *RequestLightBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type RequestLightBlocks struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *RequestLightBlocks) Reset()         { *m = RequestLightBlocks{} }
+func (m *RequestLightBlocks) String() string { return proto.CompactTextString(m) }
+func (*RequestLightBlocks) ProtoMessage()    {}
+func (*RequestLightBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d4ae276869a4fcb, []int{3}
+}
+func (m *RequestLightBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLightBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLightBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLightBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLightBlocks.Merge(m, src)
+}
+func (m *RequestLightBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLightBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLightBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLightBlocks proto.InternalMessageInfo
+
+func (m *RequestLightBlocks) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *RequestLightBlocks) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
 type ResponsePing struct {
 }
 
@@ -115,7 +212,7 @@ func (m *ResponsePing) Reset()         { *m = ResponsePing{} }
 func (m *ResponsePing) String() string { return proto.CompactTextString(m) }
 func (*ResponsePing) ProtoMessage()    {}
 func (*ResponsePing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d4ae276869a4fcb, []int{2}
+	return fileDescriptor_0d4ae276869a4fcb, []int{4}
 }
 func (m *ResponsePing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +250,7 @@ func (m *ResponseBroadcastTx) Reset()         { *m = ResponseBroadcastTx{} }
 func (m *ResponseBroadcastTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseBroadcastTx) ProtoMessage()    {}
 func (*ResponseBroadcastTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d4ae276869a4fcb, []int{3}
+	return fileDescriptor_0d4ae276869a4fcb, []int{5}
 }
 func (m *ResponseBroadcastTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,38 +293,94 @@ func (m *ResponseBroadcastTx) GetDeliverTx() *types.ResponseDeliverTx {
 	return nil
 }
 
+type ResponseLightBlock struct {
+	LightBlock *types1.LightBlock `protobuf:"bytes,1,opt,name=light_block,json=lightBlock,proto3" json:"light_block,omitempty"`
+}
+
+func (m *ResponseLightBlock) Reset()         { *m = ResponseLightBlock{} }
+func (m *ResponseLightBlock) String() string { return proto.CompactTextString(m) }
+func (*ResponseLightBlock) ProtoMessage()    {}
+func (*ResponseLightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d4ae276869a4fcb, []int{6}
+}
+func (m *ResponseLightBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseLightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseLightBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseLightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseLightBlock.Merge(m, src)
+}
+func (m *ResponseLightBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseLightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseLightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseLightBlock proto.InternalMessageInfo
+
+func (m *ResponseLightBlock) GetLightBlock() *types1.LightBlock {
+	if m != nil {
+		return m.LightBlock
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RequestPing)(nil), "celestiacore.rpc.grpc.RequestPing")
 	proto.RegisterType((*RequestBroadcastTx)(nil), "celestiacore.rpc.grpc.RequestBroadcastTx")
+	proto.RegisterType((*RequestLightBlock)(nil), "celestiacore.rpc.grpc.RequestLightBlock")
+	proto.RegisterType((*RequestLightBlocks)(nil), "celestiacore.rpc.grpc.RequestLightBlocks")
 	proto.RegisterType((*ResponsePing)(nil), "celestiacore.rpc.grpc.ResponsePing")
 	proto.RegisterType((*ResponseBroadcastTx)(nil), "celestiacore.rpc.grpc.ResponseBroadcastTx")
+	proto.RegisterType((*ResponseLightBlock)(nil), "celestiacore.rpc.grpc.ResponseLightBlock")
 }
 
 func init() { proto.RegisterFile("celestiacore/rpc/grpc/types.proto", fileDescriptor_0d4ae276869a4fcb) }
 
 var fileDescriptor_0d4ae276869a4fcb = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0x4c, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x2a, 0x48, 0xd6, 0x4f, 0x07, 0x11,
-	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0xc8, 0x4a, 0xf4,
-	0x8a, 0x0a, 0x92, 0xf5, 0x40, 0x4a, 0xa4, 0x64, 0x51, 0x74, 0x26, 0x26, 0x25, 0x67, 0x22, 0xeb,
-	0x52, 0xe2, 0xe5, 0xe2, 0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x09, 0xc8, 0xcc, 0x4b, 0x57,
-	0x52, 0xe1, 0x12, 0x82, 0x72, 0x9d, 0x8a, 0xf2, 0x13, 0x53, 0x92, 0x13, 0x8b, 0x4b, 0x42, 0x2a,
-	0x84, 0xf8, 0xb8, 0x98, 0x4a, 0x2a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x78, 0x82, 0x98, 0x4a, 0x2a,
-	0x94, 0xf8, 0xb8, 0x78, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0xc1, 0xba, 0x66, 0x32,
-	0x72, 0x09, 0xc3, 0x04, 0x90, 0xf5, 0xd9, 0x72, 0x71, 0x24, 0x67, 0xa4, 0x26, 0x67, 0xc7, 0x43,
-	0x75, 0x73, 0x1b, 0x29, 0xe9, 0xa1, 0xb8, 0x12, 0xe4, 0x1c, 0x3d, 0x98, 0x4e, 0x67, 0x90, 0xd2,
-	0x90, 0x8a, 0x20, 0xf6, 0x64, 0x08, 0x43, 0xc8, 0x99, 0x8b, 0x2b, 0x25, 0x35, 0x27, 0xb3, 0x2c,
-	0xb5, 0x08, 0x64, 0x00, 0x13, 0xd8, 0x00, 0x15, 0x3c, 0x06, 0xb8, 0x40, 0x14, 0x87, 0x54, 0x04,
-	0x71, 0xa6, 0xc0, 0x98, 0x46, 0x47, 0x19, 0xb9, 0x78, 0xe0, 0x6e, 0x72, 0x0c, 0xf0, 0x14, 0xf2,
-	0xe7, 0x62, 0x01, 0x39, 0x5a, 0x08, 0xcd, 0x29, 0xb0, 0x00, 0xd3, 0x43, 0x0a, 0x0e, 0x29, 0x65,
-	0x9c, 0x6a, 0x10, 0xbe, 0x17, 0x4a, 0xe1, 0xe2, 0x46, 0xf6, 0xb4, 0x26, 0x7e, 0x73, 0x91, 0x94,
-	0x4a, 0x69, 0x11, 0x30, 0x1e, 0x49, 0xad, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x7b,
-	0x47, 0x86, 0xb9, 0xfa, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xeb, 0xc3, 0xcc, 0xd6, 0x45, 0x49,
-	0x33, 0xd6, 0x20, 0x1e, 0x88, 0x91, 0xc4, 0x06, 0x4e, 0x01, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x45, 0xb3, 0x03, 0x5c, 0x02, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0x6e, 0xaa, 0xac, 0xbb, 0x6f, 0xba, 0x0b, 0x46, 0x14, 0x19, 0x31, 0x6a, 0xdc, 0xc3, 0x56,
+	0x31, 0x95, 0x0a, 0x5e, 0x44, 0xc4, 0xae, 0x82, 0xa2, 0x68, 0x09, 0x55, 0xd0, 0x4b, 0x99, 0xa6,
+	0xb1, 0x1d, 0x3a, 0xbb, 0x19, 0x93, 0xac, 0x8e, 0xff, 0xc2, 0xab, 0x3f, 0x48, 0xf0, 0xb8, 0x47,
+	0xbd, 0x49, 0xfb, 0x47, 0x24, 0xd3, 0x99, 0x9d, 0x0c, 0x6a, 0xab, 0x97, 0xf2, 0x5e, 0xbe, 0xef,
+	0x7d, 0xef, 0xbd, 0x2f, 0xe9, 0xc0, 0x35, 0x21, 0x13, 0x69, 0x6c, 0x1c, 0x09, 0xa5, 0x65, 0x47,
+	0xa7, 0xa2, 0x33, 0x71, 0x3f, 0xf6, 0x53, 0x2a, 0x0d, 0x4b, 0xb5, 0xb2, 0x0a, 0x9f, 0xf7, 0x29,
+	0x4c, 0xa7, 0x82, 0x39, 0x4a, 0x78, 0xb9, 0x56, 0x19, 0x8d, 0x44, 0xec, 0x57, 0x85, 0xa4, 0x06,
+	0xe7, 0x88, 0x8f, 0xd3, 0x6d, 0x08, 0xb8, 0x7c, 0x7f, 0x24, 0x8d, 0xed, 0xc7, 0x87, 0x13, 0xba,
+	0x0b, 0xb8, 0x48, 0x7b, 0x5a, 0x45, 0x63, 0x11, 0x19, 0x3b, 0xc8, 0xf0, 0x0e, 0x34, 0x6d, 0x76,
+	0x11, 0x5d, 0x45, 0x7b, 0x2d, 0xde, 0xb4, 0x19, 0xbd, 0x09, 0x67, 0x0b, 0xd6, 0xf3, 0x78, 0x32,
+	0xb5, 0xbd, 0x44, 0x89, 0x19, 0xbe, 0x00, 0x1b, 0x53, 0xe9, 0xd2, 0x9c, 0x78, 0x8a, 0x17, 0x19,
+	0xe5, 0x80, 0x7f, 0x23, 0x1b, 0x7c, 0x05, 0x82, 0x77, 0x5a, 0x1d, 0x0c, 0x6b, 0x25, 0xe0, 0x8e,
+	0x9e, 0xe4, 0x27, 0xf8, 0x12, 0x6c, 0x59, 0x55, 0xc2, 0xcd, 0x1c, 0xde, 0xb4, 0x6a, 0x09, 0xd2,
+	0x1d, 0x68, 0x71, 0x69, 0x52, 0x75, 0x68, 0x64, 0x3e, 0xf6, 0x17, 0x04, 0xe7, 0xca, 0x03, 0x7f,
+	0xf0, 0xfb, 0xb0, 0x29, 0xa6, 0x52, 0xcc, 0x86, 0xc5, 0xf8, 0x41, 0x97, 0xb2, 0x9a, 0x8d, 0xce,
+	0x2f, 0x56, 0x56, 0xee, 0x3b, 0xea, 0x20, 0xe3, 0x67, 0xc4, 0x32, 0xc0, 0xfb, 0x00, 0x63, 0x99,
+	0xc4, 0x1f, 0xa4, 0x76, 0x02, 0xcd, 0x5c, 0x60, 0x77, 0x85, 0xc0, 0xa3, 0x25, 0x79, 0x90, 0xf1,
+	0xad, 0x71, 0x19, 0xd2, 0x57, 0x80, 0x4b, 0xdc, 0x73, 0xeb, 0x01, 0x04, 0x89, 0xcb, 0x86, 0x23,
+	0x97, 0x16, 0xc3, 0x91, 0xba, 0xf6, 0xf2, 0x9e, 0xaa, 0x22, 0x0e, 0xc9, 0x49, 0xdc, 0xfd, 0x8a,
+	0xa0, 0x75, 0xb2, 0xea, 0xc3, 0xfe, 0x53, 0xfc, 0x12, 0x4e, 0x3b, 0x2f, 0x30, 0x65, 0x7f, 0x7c,
+	0x28, 0xcc, 0xbb, 0xe6, 0xf0, 0xfa, 0x5f, 0x39, 0x95, 0xa9, 0x78, 0x0c, 0x81, 0xef, 0x65, 0x7b,
+	0xb5, 0xae, 0x47, 0x0d, 0x6f, 0xac, 0x91, 0xf7, 0xb8, 0xdd, 0x1f, 0x08, 0xb6, 0xab, 0x15, 0xdd,
+	0x22, 0x11, 0x80, 0x67, 0xd4, 0xde, 0xea, 0xb6, 0x15, 0x33, 0x6c, 0xaf, 0xe9, 0xea, 0x89, 0x4a,
+	0x08, 0xfc, 0xc7, 0xd8, 0xfe, 0xd7, 0x1e, 0xe6, 0x3f, 0x9a, 0xdc, 0x46, 0xbd, 0xfe, 0xb7, 0x39,
+	0x41, 0xc7, 0x73, 0x82, 0x7e, 0xce, 0x09, 0xfa, 0xbc, 0x20, 0x8d, 0xe3, 0x05, 0x69, 0x7c, 0x5f,
+	0x90, 0xc6, 0xdb, 0xbb, 0x93, 0xd8, 0x4e, 0x8f, 0x46, 0x4c, 0xa8, 0x83, 0xce, 0xb3, 0x37, 0xaf,
+	0x1f, 0xbf, 0x90, 0xf6, 0xa3, 0xd2, 0xb3, 0x4e, 0x29, 0x7e, 0xab, 0xf6, 0x1d, 0xb8, 0xe7, 0x32,
+	0x17, 0x8c, 0x36, 0xf2, 0x7f, 0xed, 0x9d, 0x5f, 0x03, 0x00, 0x0c, 0x73, 0x60, 0x24, 0x30, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -347,6 +500,143 @@ var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 	Metadata: "celestiacore/rpc/grpc/types.proto",
 }
 
+// LightBlockAPIClient is the client API for LightBlockAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LightBlockAPIClient interface {
+	LightBlock(ctx context.Context, in *RequestLightBlock, opts ...grpc.CallOption) (*ResponseLightBlock, error)
+	LightBlocks(ctx context.Context, in *RequestLightBlocks, opts ...grpc.CallOption) (LightBlockAPI_LightBlocksClient, error)
+}
+
+type lightBlockAPIClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewLightBlockAPIClient(cc grpc1.ClientConn) LightBlockAPIClient {
+	return &lightBlockAPIClient{cc}
+}
+
+func (c *lightBlockAPIClient) LightBlock(ctx context.Context, in *RequestLightBlock, opts ...grpc.CallOption) (*ResponseLightBlock, error) {
+	out := new(ResponseLightBlock)
+	err := c.cc.Invoke(ctx, "/celestiacore.rpc.grpc.LightBlockAPI/LightBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightBlockAPIClient) LightBlocks(ctx context.Context, in *RequestLightBlocks, opts ...grpc.CallOption) (LightBlockAPI_LightBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LightBlockAPI_serviceDesc.Streams[0], "/celestiacore.rpc.grpc.LightBlockAPI/LightBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightBlockAPILightBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LightBlockAPI_LightBlocksClient interface {
+	Recv() (*ResponseLightBlock, error)
+	grpc.ClientStream
+}
+
+type lightBlockAPILightBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightBlockAPILightBlocksClient) Recv() (*ResponseLightBlock, error) {
+	m := new(ResponseLightBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LightBlockAPIServer is the server API for LightBlockAPI service.
+type LightBlockAPIServer interface {
+	LightBlock(context.Context, *RequestLightBlock) (*ResponseLightBlock, error)
+	LightBlocks(*RequestLightBlocks, LightBlockAPI_LightBlocksServer) error
+}
+
+// UnimplementedLightBlockAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedLightBlockAPIServer struct {
+}
+
+func (*UnimplementedLightBlockAPIServer) LightBlock(ctx context.Context, req *RequestLightBlock) (*ResponseLightBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LightBlock not implemented")
+}
+func (*UnimplementedLightBlockAPIServer) LightBlocks(req *RequestLightBlocks, srv LightBlockAPI_LightBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method LightBlocks not implemented")
+}
+
+func RegisterLightBlockAPIServer(s grpc1.Server, srv LightBlockAPIServer) {
+	s.RegisterService(&_LightBlockAPI_serviceDesc, srv)
+}
+
+func _LightBlockAPI_LightBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLightBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightBlockAPIServer).LightBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestiacore.rpc.grpc.LightBlockAPI/LightBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightBlockAPIServer).LightBlock(ctx, req.(*RequestLightBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LightBlockAPI_LightBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RequestLightBlocks)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightBlockAPIServer).LightBlocks(m, &lightBlockAPILightBlocksServer{stream})
+}
+
+type LightBlockAPI_LightBlocksServer interface {
+	Send(*ResponseLightBlock) error
+	grpc.ServerStream
+}
+
+type lightBlockAPILightBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightBlockAPILightBlocksServer) Send(m *ResponseLightBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var LightBlockAPI_serviceDesc = _LightBlockAPI_serviceDesc
+var _LightBlockAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestiacore.rpc.grpc.LightBlockAPI",
+	HandlerType: (*LightBlockAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LightBlock",
+			Handler:    _LightBlockAPI_LightBlock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LightBlocks",
+			Handler:       _LightBlockAPI_LightBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "celestiacore/rpc/grpc/types.proto",
+}
+
 func (m *RequestPing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -400,6 +690,67 @@ func (m *RequestBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestLightBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestLightBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLightBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLightBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -470,6 +821,41 @@ func (m *ResponseBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseLightBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseLightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseLightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		{
+			size, err := m.LightBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -503,6 +889,33 @@ func (m *RequestBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *RequestLightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestLightBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ToHeight))
+	}
+	return n
+}
+
 func (m *ResponsePing) Size() (n int) {
 	if m == nil {
 		return 0
@@ -529,6 +942,19 @@ func (m *ResponseBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *ResponseLightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		l = m.LightBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -669,6 +1095,163 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RequestLightBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLightBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLightBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestLightBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLightBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLightBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResponsePing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -841,6 +1424,92 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseLightBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseLightBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseLightBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LightBlock == nil {
+				m.LightBlock = &types1.LightBlock{}
+			}
+			if err := m.LightBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
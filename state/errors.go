@@ -104,3 +104,5 @@ func (e ErrNoABCIResponsesForHeight) Error() string {
 }
 
 var ErrABCIResponsesNotPersisted = errors.New("node is not persisting abci responses")
+
+var ErrInvalidVoteExtension = errors.New("application rejected the vote extension")
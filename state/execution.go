@@ -90,13 +90,15 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allocated for maximum sized evidence.
-// The rest is given to txs, up to the max gas.
+// The rest is given to txs, up to the max gas. proposerTime is the local time
+// of the proposer (see State.MakeBlock).
 //
 // Contract: application will not return more bytes than are sent over the wire.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
-	state State, commit *types.Commit,
+	state State, lastExtCommit *types.ExtendedCommit,
 	proposerAddr []byte,
+	proposerTime time.Time,
 ) (*types.Block, *types.PartSet) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
@@ -107,14 +109,15 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	// Fetch a limited amount of valid txs
 	maxDataBytes := types.MaxDataBytes(maxBytes, evSize, state.Validators.Size())
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
-	block, _ := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	commit := lastExtCommit.ToCommit()
+	block, _ := state.MakeBlock(height, txs, commit, evidence, proposerAddr, proposerTime)
 
 	localLastCommit := buildLastCommitInfo(block, blockExec.store, state.InitialHeight)
 	rpp, err := blockExec.proxyApp.PrepareProposalSync(
 		abci.RequestPrepareProposal{
 			MaxTxBytes:         maxDataBytes,
 			Txs:                block.Txs.ToSliceOfBytes(),
-			LocalLastCommit:    extendedCommitInfo(localLastCommit),
+			LocalLastCommit:    extendedCommitInfo(localLastCommit, lastExtCommit),
 			Misbehavior:        block.Evidence.Evidence.ToABCI(),
 			Height:             block.Height,
 			Time:               block.Time,
@@ -159,6 +162,38 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	return block, block.MakePartSet(types.BlockPartSizeBytes)
 }
 
+// ExtendVote asks the application for the vote extension to attach to the
+// given precommit.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	resp, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the application to validate the vote extension
+// attached to the given precommit. It returns an error if the application
+// rejects it.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) error {
+	resp, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.IsAccepted() {
+		return ErrInvalidVoteExtension
+	}
+	return nil
+}
+
 func (blockExec *BlockExecutor) ProcessProposal(
 	block *types.Block,
 	state State,
@@ -467,16 +502,18 @@ func buildLastCommitInfo(block *types.Block, store Store, initialHeight int64) a
 	}
 }
 
-func extendedCommitInfo(c abci.CommitInfo) abci.ExtendedCommitInfo {
+// extendedCommitInfo extends the CommitInfo with the vote extensions found in
+// the given ExtendedCommit. The signatures of the extended commit are ordered
+// like the validators of the commit info.
+func extendedCommitInfo(c abci.CommitInfo, ec *types.ExtendedCommit) abci.ExtendedCommitInfo {
 	vs := make([]abci.ExtendedVoteInfo, len(c.Votes))
 	for i := range vs {
 		vs[i] = abci.ExtendedVoteInfo{
 			Validator:       c.Votes[i].Validator,
 			SignedLastBlock: c.Votes[i].SignedLastBlock,
-			/*
-				TODO: Include vote extensions information when implementing vote extensions.
-				VoteExtension:   []byte{},
-			*/
+		}
+		if ec != nil && i < len(ec.ExtendedSignatures) {
+			vs[i].VoteExtension = ec.ExtendedSignatures[i].Extension
 		}
 	}
 	return abci.ExtendedCommitInfo{
@@ -541,9 +578,13 @@ func updateState(
 	nextParams := state.ConsensusParams
 	lastHeightParamsChanged := state.LastHeightConsensusParamsChanged
 	if abciResponses.EndBlock.ConsensusParamUpdates != nil {
+		err := state.ConsensusParams.ValidateUpdate(abciResponses.EndBlock.ConsensusParamUpdates, header.Height)
+		if err != nil {
+			return state, fmt.Errorf("error updating consensus params: %v", err)
+		}
 		// NOTE: must not mutate s.ConsensusParams
 		nextParams = state.ConsensusParams.Update(abciResponses.EndBlock.ConsensusParamUpdates)
-		err := nextParams.ValidateBasic()
+		err = nextParams.ValidateBasic()
 		if err != nil {
 			return state, fmt.Errorf("error updating consensus params: %v", err)
 		}
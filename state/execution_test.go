@@ -122,6 +122,7 @@ func TestBeginBlockValidators(t *testing.T) {
 			lastCommit,
 			nil,
 			state.Validators.GetProposer().Address,
+			cmttime.Now(),
 		)
 
 		_, err = sm.ExecCommitBlock(proxyApp.Consensus(), block, log.TestingLogger(), stateStore, 1)
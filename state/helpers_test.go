@@ -61,6 +61,7 @@ func makeAndApplyGoodBlock(state sm.State, height int64, lastCommit *types.Commi
 		lastCommit,
 		evidence,
 		proposerAddr,
+		cmttime.Now(),
 	)
 	if err := blockExec.ValidateBlock(state, block); err != nil {
 		return state, types.BlockID{}, err
@@ -147,6 +148,7 @@ func makeBlock(state sm.State, height int64) *types.Block {
 		new(types.Commit),
 		nil,
 		state.Validators.GetProposer().Address,
+		cmttime.Now(),
 	)
 	return block
 }
@@ -26,6 +26,7 @@ type BlockStore interface {
 	LoadBlock(height int64) *types.Block
 
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet, seenCommit *types.ExtendedCommit) error
 
 	PruneBlocks(height int64) (uint64, error)
 
@@ -35,6 +36,7 @@ type BlockStore interface {
 
 	LoadBlockCommit(height int64) *types.Commit
 	LoadSeenCommit(height int64) *types.Commit
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 
 	LoadTxInfo(hash []byte) *cmtstore.TxInfo
 
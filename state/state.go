@@ -229,19 +229,25 @@ func FromProto(pb *cmtstate.State) (*State, error) { //nolint:golint
 // MakeBlock builds a block from the current state with the given txs, commit,
 // and evidence. Note it also takes a proposerAddress because the state does not
 // track rounds, and hence does not know the correct proposer. TODO: fix this!
+// The block time is proposerTime, the local time of the proposer, if
+// proposer-based timestamps are enabled at the given height, and the median
+// time of the commit otherwise.
 func (state State) MakeBlock(
 	height int64,
 	txs types.Txs,
 	commit *types.Commit,
 	evidence []types.Evidence,
 	proposerAddress []byte,
+	proposerTime time.Time,
 ) (*types.Block, *types.PartSet) {
 	// Build base block with block data.
 	block := types.MakeBlock(height, txs, commit, evidence)
 
 	// Set time.
 	var timestamp time.Time
-	if height == state.InitialHeight {
+	if state.ConsensusParams.PbtsEnabled(height) {
+		timestamp = proposerTime
+	} else if height == state.InitialHeight {
 		timestamp = state.LastBlockTime // genesis time
 	} else {
 		timestamp = MedianTime(commit, state.LastValidators)
@@ -72,6 +72,9 @@ type Store interface {
 	SaveABCIResponses(int64, *cmtstate.ABCIResponses) error
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(State) error
+	// SaveValidatorSets saves the validator set for a range of heights, e.g. when bootstrapping
+	// the validator history of recent blocks after state sync
+	SaveValidatorSets(int64, int64, *types.ValidatorSet) error
 	// PruneStates takes the height from which to start prning and which height stop at
 	PruneStates(int64, int64) error
 	// Close closes the connection with the database
@@ -232,6 +235,21 @@ func (store dbStore) Bootstrap(state State) error {
 	return store.db.SetSync(stateKey, state.Bytes())
 }
 
+// SaveValidatorSets saves the given validator set for all heights between lowerHeight and
+// upperHeight (inclusive). It is used by state sync to populate the validator sets of the recent
+// blocks it bootstraps the block store with, e.g. to verify evidence or serve light clients.
+func (store dbStore) SaveValidatorSets(lowerHeight, upperHeight int64, vals *types.ValidatorSet) error {
+	if lowerHeight <= 0 || lowerHeight > upperHeight {
+		return fmt.Errorf("invalid height range %v-%v", lowerHeight, upperHeight)
+	}
+	for height := lowerHeight; height <= upperHeight; height++ {
+		if err := store.saveValidatorsInfo(height, lowerHeight, vals); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PruneStates deletes states between the given heights (including from, excluding to). It is not
 // guaranteed to delete all states, since the last checkpointed state and states being pointed to by
 // e.g. `LastHeightChanged` must remain. The state at to must also exist.
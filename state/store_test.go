@@ -47,6 +47,27 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestStoreSaveValidatorSets(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	val, _ := types.RandValidator(true, 10)
+	vals := types.NewValidatorSet([]*types.Validator{val})
+
+	err := stateStore.SaveValidatorSets(5, 7, vals)
+	require.NoError(t, err)
+	for height := int64(5); height <= 7; height++ {
+		loadedVals, err := stateStore.LoadValidators(height)
+		require.NoError(t, err)
+		assert.Equal(t, vals.Hash(), loadedVals.Hash())
+	}
+	_, err = stateStore.LoadValidators(4)
+	assert.Error(t, err)
+
+	assert.Error(t, stateStore.SaveValidatorSets(0, 1, vals))
+	assert.Error(t, stateStore.SaveValidatorSets(3, 2, vals))
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
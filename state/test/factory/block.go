@@ -44,6 +44,7 @@ func MakeBlock(state sm.State, height int64, c *types.Commit) *types.Block {
 		c,
 		nil,
 		state.Validators.GetProposer().Address,
+		time.Now(),
 	)
 	return block
 }
@@ -63,7 +64,7 @@ func makeBlockAndPartSet(state sm.State, lastBlock *types.Block, lastBlockMeta *
 			lastBlockMeta.BlockID, []types.CommitSig{vote.CommitSig()})
 	}
 
-	return state.MakeBlock(height, []types.Tx{}, lastCommit, nil, state.Validators.GetProposer().Address)
+	return state.MakeBlock(height, []types.Tx{}, lastCommit, nil, state.Validators.GetProposer().Address, time.Now())
 }
 
 func MakeVote(
@@ -91,7 +92,7 @@ func MakeVote(
 	}
 
 	vpb := v.ToProto()
-	err = val.SignVote(chainID, vpb)
+	err = val.SignVote(chainID, vpb, false)
 	if err != nil {
 		panic(err)
 	}
@@ -80,7 +80,7 @@ func TestValidateBlockHeader(t *testing.T) {
 			Invalid blocks don't pass
 		*/
 		for _, tc := range testCases {
-			block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr)
+			block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr, cmttime.Now())
 			tc.malleateBlock(block)
 			err := blockExec.ValidateBlock(state, block)
 			require.Error(t, err, tc.name)
@@ -101,6 +101,7 @@ func TestValidateBlockHeader(t *testing.T) {
 		lastCommit,
 		nil,
 		state.Validators.GetProposer().Address,
+		cmttime.Now(),
 	)
 	state.InitialHeight = nextHeight + 1
 	err := blockExec.ValidateBlock(state, block)
@@ -108,6 +109,47 @@ func TestValidateBlockHeader(t *testing.T) {
 	assert.Contains(t, err.Error(), "lower than initial height")
 }
 
+func TestValidateBlockTimePBTS(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(3, 1)
+	state.ConsensusParams.Feature.PbtsEnableHeight = 2
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.EmptyEvidencePool{},
+	)
+
+	// BFT time at the initial height: the block time is the genesis time
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	proposerAddr := state.Validators.GetProposer().Address
+	state, _, lastCommit, err := makeAndCommitGoodBlock(state, 1, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+
+	// PBTS: any block time after the last block time is valid
+	block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, state.Validators.GetProposer().Address, cmttime.Now())
+	assert.NotEqual(t, sm.MedianTime(lastCommit, state.LastValidators), block.Time)
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	block.Time = state.LastBlockTime.Add(time.Hour)
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	block.Time = state.LastBlockTime
+	require.Error(t, blockExec.ValidateBlock(state, block))
+
+	// the median time is required again once PBTS is disabled
+	state.ConsensusParams.Feature.PbtsEnableHeight = 0
+	block.Time = state.LastBlockTime.Add(time.Hour)
+	require.Error(t, blockExec.ValidateBlock(state, block))
+}
+
 func TestValidateBlockCommit(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
@@ -156,6 +198,7 @@ func TestValidateBlockCommit(t *testing.T) {
 				wrongHeightCommit,
 				nil,
 				proposerAddr,
+				cmttime.Now(),
 			)
 			err = blockExec.ValidateBlock(state, block)
 			_, isErrInvalidCommitHeight := err.(types.ErrInvalidCommitHeight)
@@ -170,6 +213,7 @@ func TestValidateBlockCommit(t *testing.T) {
 				wrongSigsCommit,
 				nil,
 				proposerAddr,
+				cmttime.Now(),
 			)
 			err = blockExec.ValidateBlock(state, block)
 			_, isErrInvalidCommitSignatures := err.(types.ErrInvalidCommitSignatures)
@@ -224,9 +268,9 @@ func TestValidateBlockCommit(t *testing.T) {
 		g := goodVote.ToProto()
 		b := badVote.ToProto()
 
-		err = badPrivVal.SignVote(chainID, g)
+		err = badPrivVal.SignVote(chainID, g, false)
 		require.NoError(t, err, "height %d", height)
-		err = badPrivVal.SignVote(chainID, b)
+		err = badPrivVal.SignVote(chainID, b, false)
 		require.NoError(t, err, "height %d", height)
 
 		goodVote.Signature, badVote.Signature = g.Signature, b.Signature
@@ -286,6 +330,7 @@ func TestValidateBlockEvidence(t *testing.T) {
 				lastCommit,
 				evidence,
 				proposerAddr,
+				cmttime.Now(),
 			)
 			err := blockExec.ValidateBlock(state, block)
 			if assert.Error(t, err) {
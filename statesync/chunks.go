@@ -1,6 +1,8 @@
 package statesync
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -8,13 +10,27 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
 	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+	"github.com/KYVENetwork/celestia-core/libs/tempfile"
 	"github.com/KYVENetwork/celestia-core/p2p"
 )
 
 // errDone is returned by chunkQueue.Next() when all chunks have been returned.
 var errDone = errors.New("chunk queue has completed")
 
+// progressFile is the name of the file a persistent chunk queue records the snapshot and the
+// applied chunks in.
+const progressFile = "progress.json"
+
+// progress is the state of a restoration persisted by a persistent chunk queue. The hashes of the
+// stored chunks are recorded so that chunk files which were not fully written are refetched.
+type progress struct {
+	Snapshot *snapshot         `json:"snapshot"`
+	Applied  []uint32          `json:"applied"`
+	Hashes   map[uint32][]byte `json:"hashes"`
+}
+
 // chunk contains data for a chunk.
 type chunk struct {
 	Height uint64
@@ -32,10 +48,13 @@ type chunkQueue struct {
 	snapshot       *snapshot                  // if this is nil, the queue has been closed
 	dir            string                     // temp dir for on-disk chunk storage
 	chunkFiles     map[uint32]string          // path to temporary chunk file
+	chunkHashes    map[uint32][]byte          // hash of the chunk files, if the queue is persistent
 	chunkSenders   map[uint32]p2p.ID          // the peer who sent the given chunk
 	chunkAllocated map[uint32]bool            // chunks that have been allocated via Allocate()
 	chunkReturned  map[uint32]bool            // chunks returned via Next()
+	chunkApplied   map[uint32]bool            // chunks applied to the app via MarkApplied()
 	waiters        map[uint32][]chan<- uint32 // signals WaitFor() waiters about chunk arrival
+	persistent     bool                       // if true, the progress is persisted in dir
 }
 
 // newChunkQueue creates a new chunk queue for a snapshot, using a temp dir for storage.
@@ -55,10 +74,118 @@ func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
 		chunkSenders:   make(map[uint32]p2p.ID, snapshot.Chunks),
 		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
 		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
+		chunkApplied:   make(map[uint32]bool, snapshot.Chunks),
 		waiters:        make(map[uint32][]chan<- uint32),
 	}, nil
 }
 
+// newPersistentChunkQueue creates a new chunk queue for a snapshot, storing its chunks and the
+// progress of the restoration in dir so that it can be resumed after a restart. The chunks of the
+// same snapshot found in dir are loaded if they match their recorded hash, and the applied ones can
+// be skipped with Resume(). Any other content of dir is removed. Callers must call Close() when
+// done, which removes dir.
+func newPersistentChunkQueue(snapshot *snapshot, dir string) (*chunkQueue, error) {
+	if snapshot.Chunks == 0 {
+		return nil, errors.New("snapshot has no chunks")
+	}
+	q := &chunkQueue{
+		snapshot:       snapshot,
+		dir:            dir,
+		chunkFiles:     make(map[uint32]string, snapshot.Chunks),
+		chunkHashes:    make(map[uint32][]byte, snapshot.Chunks),
+		chunkSenders:   make(map[uint32]p2p.ID, snapshot.Chunks),
+		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
+		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
+		chunkApplied:   make(map[uint32]bool, snapshot.Chunks),
+		waiters:        make(map[uint32][]chan<- uint32),
+		persistent:     true,
+	}
+
+	prev, err := loadProgress(dir)
+	if err != nil || prev == nil || prev.Snapshot.Key() != snapshot.Key() {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf("failed to clean up state sync dir %v: %w", dir, err)
+		}
+		prev = nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create dir for state sync chunks: %w", err)
+	}
+
+	if prev != nil {
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			path := filepath.Join(dir, strconv.FormatUint(uint64(i), 10))
+			body, err := os.ReadFile(path)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil || prev.Hashes[i] == nil || !bytes.Equal(tmhash.Sum(body), prev.Hashes[i]) {
+				// Fetch the chunk again.
+				if err := os.Remove(path); err != nil {
+					return nil, fmt.Errorf("failed to remove chunk %v: %w", i, err)
+				}
+				continue
+			}
+			q.chunkFiles[i] = path
+			q.chunkHashes[i] = prev.Hashes[i]
+			q.chunkAllocated[i] = true
+		}
+		for _, index := range prev.Applied {
+			if index < snapshot.Chunks {
+				q.chunkApplied[index] = true
+			}
+		}
+	}
+	if err := q.saveProgress(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// loadProgress loads the progress persisted in dir, if any.
+func loadProgress(dir string) (*progress, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, progressFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	p := &progress{}
+	if err := json.Unmarshal(bz, p); err != nil {
+		return nil, fmt.Errorf("invalid state sync progress: %w", err)
+	}
+	if p.Snapshot == nil {
+		return nil, errors.New("invalid state sync progress: no snapshot")
+	}
+	return p, nil
+}
+
+// saveProgress persists the snapshot and the applied chunks, if the queue is persistent. The caller
+// must hold the mutex lock.
+func (q *chunkQueue) saveProgress() error {
+	if !q.persistent {
+		return nil
+	}
+	p := progress{
+		Snapshot: q.snapshot,
+		Applied:  make([]uint32, 0, len(q.chunkApplied)),
+		Hashes:   q.chunkHashes,
+	}
+	for i := uint32(0); i < q.snapshot.Chunks; i++ {
+		if q.chunkApplied[i] {
+			p.Applied = append(p.Applied, i)
+		}
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(filepath.Join(q.dir, progressFile), bz, 0o600); err != nil {
+		return fmt.Errorf("failed to save state sync progress: %w", err)
+	}
+	return nil
+}
+
 // Add adds a chunk to the queue. It ignores chunks that already exist, returning false.
 func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 	if chunk == nil || chunk.Chunk == nil {
@@ -83,12 +210,18 @@ func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 	}
 
 	path := filepath.Join(q.dir, strconv.FormatUint(uint64(chunk.Index), 10))
-	err := os.WriteFile(path, chunk.Chunk, 0o600)
+	err := tempfile.WriteFileAtomic(path, chunk.Chunk, 0o600)
 	if err != nil {
 		return false, fmt.Errorf("failed to save chunk %v to file %v: %w", chunk.Index, path, err)
 	}
 	q.chunkFiles[chunk.Index] = path
 	q.chunkSenders[chunk.Index] = chunk.Sender
+	if q.persistent {
+		q.chunkHashes[chunk.Index] = tmhash.Sum(chunk.Chunk)
+		if err := q.saveProgress(); err != nil {
+			return false, err
+		}
+	}
 
 	// Signal any waiters that the chunk has arrived.
 	for _, waiter := range q.waiters[chunk.Index] {
@@ -164,6 +297,7 @@ func (q *chunkQueue) discard(index uint32) error {
 		return fmt.Errorf("failed to remove chunk %v: %w", index, err)
 	}
 	delete(q.chunkFiles, index)
+	delete(q.chunkHashes, index)
 	delete(q.chunkReturned, index)
 	delete(q.chunkAllocated, index)
 	return nil
@@ -271,6 +405,38 @@ func (q *chunkQueue) nextUp() (uint32, error) {
 	return 0, errDone
 }
 
+// MarkApplied records that a chunk was applied to the app, persisting it if the queue is persistent.
+func (q *chunkQueue) MarkApplied(index uint32) error {
+	q.Lock()
+	defer q.Unlock()
+	if q.snapshot == nil {
+		return nil
+	}
+	q.chunkApplied[index] = true
+	return q.saveProgress()
+}
+
+// ResetApplied forgets about the applied chunks, e.g. because the app restarts the restoration.
+func (q *chunkQueue) ResetApplied() error {
+	q.Lock()
+	defer q.Unlock()
+	if q.snapshot == nil {
+		return nil
+	}
+	q.chunkApplied = make(map[uint32]bool, q.snapshot.Chunks)
+	return q.saveProgress()
+}
+
+// Resume skips the chunks applied before a restart of the restoration, such that Next() only
+// returns the chunks following them.
+func (q *chunkQueue) Resume() {
+	q.Lock()
+	defer q.Unlock()
+	for index := range q.chunkApplied {
+		q.chunkReturned[index] = true
+	}
+}
+
 // Retry schedules a chunk to be retried, without refetching it.
 func (q *chunkQueue) Retry(index uint32) {
 	q.Lock()
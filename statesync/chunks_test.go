@@ -2,6 +2,7 @@ package statesync
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -548,3 +549,85 @@ func TestChunkQueue_WaitFor(t *testing.T) {
 	_, ok = <-w
 	assert.False(t, ok)
 }
+
+func TestPersistentChunkQueue(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "statesync")
+	s := &snapshot{Height: 3, Format: 1, Chunks: 3, Hash: []byte{7}}
+
+	queue, err := newPersistentChunkQueue(s, dir)
+	require.NoError(t, err)
+	for i := uint32(0); i < 2; i++ {
+		_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: i, Chunk: []byte{3, 1, byte(i)}})
+		require.NoError(t, err)
+	}
+	c, err := queue.Next()
+	require.NoError(t, err)
+	require.NoError(t, queue.MarkApplied(c.Index))
+
+	// After a restart, the fetched chunks are loaded and the applied ones skipped on resume
+	queue, err = newPersistentChunkQueue(s, dir)
+	require.NoError(t, err)
+	assert.True(t, queue.Has(0))
+	assert.True(t, queue.Has(1))
+	assert.False(t, queue.Has(2))
+	index, err := queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, index)
+
+	queue.Resume()
+	c, err = queue.Next()
+	require.NoError(t, err)
+	assert.Equal(t, &chunk{Height: 3, Format: 1, Index: 1, Chunk: []byte{3, 1, 1}}, c)
+
+	p, err := loadProgress(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{0}, p.Applied)
+	require.NoError(t, queue.ResetApplied())
+	p, err = loadProgress(dir)
+	require.NoError(t, err)
+	assert.Empty(t, p.Applied)
+
+	// The chunks of another snapshot are removed
+	other := &snapshot{Height: 4, Format: 1, Chunks: 3, Hash: []byte{8}}
+	queue, err = newPersistentChunkQueue(other, dir)
+	require.NoError(t, err)
+	assert.False(t, queue.Has(0))
+	p, err = loadProgress(dir)
+	require.NoError(t, err)
+	assert.Equal(t, other.Key(), p.Snapshot.Key())
+
+	require.NoError(t, queue.Close())
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPersistentChunkQueueCorruptChunk(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "statesync")
+	s := &snapshot{Height: 3, Format: 1, Chunks: 3, Hash: []byte{7}}
+
+	queue, err := newPersistentChunkQueue(s, dir)
+	require.NoError(t, err)
+	for i := uint32(0); i < 2; i++ {
+		_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: i, Chunk: []byte{3, 1, byte(i)}})
+		require.NoError(t, err)
+	}
+
+	// A chunk file which doesn't match its recorded hash, e.g. because it was truncated by a crash,
+	// is removed and fetched again after a restart
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1"), []byte{3}, 0o600))
+	// as is a chunk file whose hash was never recorded
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2"), []byte{3, 1, 2}, 0o600))
+
+	queue, err = newPersistentChunkQueue(s, dir)
+	require.NoError(t, err)
+	assert.True(t, queue.Has(0))
+	assert.False(t, queue.Has(1))
+	assert.False(t, queue.Has(2))
+	_, err = os.Stat(filepath.Join(dir, "1"))
+	assert.True(t, os.IsNotExist(err))
+
+	index, err := queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, index)
+	require.NoError(t, queue.Close())
+}
@@ -0,0 +1,231 @@
+package statesync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
+	cmtbytes "github.com/KYVENetwork/celestia-core/libs/bytes"
+	"github.com/KYVENetwork/celestia-core/p2p"
+)
+
+const (
+	// httpSnapshotsPath is the path of the snapshot list, relative to the base URL of an HTTP
+	// snapshot server. Chunks are served at <base URL>/<height>/<format>/<index>.
+	httpSnapshotsPath = "snapshots.json"
+	// httpSnapshotsTimeout is the timeout for fetching the snapshot list.
+	httpSnapshotsTimeout = 10 * time.Second
+	// httpChunkAttempts is the number of attempts to download a chunk, resuming partial downloads.
+	httpChunkAttempts = 3
+)
+
+// httpSnapshot is the JSON encoding of a snapshot in the snapshot list of an HTTP snapshot server.
+type httpSnapshot struct {
+	Height   uint64            `json:"height"`
+	Format   uint32            `json:"format"`
+	Chunks   uint32            `json:"chunks"`
+	Hash     cmtbytes.HexBytes `json:"hash"`
+	Metadata cmtbytes.HexBytes `json:"metadata"`
+}
+
+// httpSnapshotSource fetches snapshots and chunks from an HTTP server, e.g. a static file server
+// of archived snapshots. Like snapshots from peers, they are verified by the application against
+// the app hash obtained through the StateProvider.
+type httpSnapshotSource struct {
+	baseURL      string
+	client       *http.Client
+	dir          string        // directory for partially downloaded chunks
+	chunkTimeout time.Duration // timeout of each chunk request
+	filePrefix   string        // prefix of the files of the partially downloaded chunks of the source
+}
+
+// newHTTPSnapshotSource creates a new snapshot source for the server at baseURL, storing partial
+// chunk downloads in dir. Each chunk request, including the download of the chunk, times out
+// after chunkTimeout.
+func newHTTPSnapshotSource(baseURL string, dir string, chunkTimeout time.Duration) *httpSnapshotSource {
+	return &httpSnapshotSource{
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		client:       &http.Client{},
+		dir:          dir,
+		chunkTimeout: chunkTimeout,
+		filePrefix:   fmt.Sprintf("chunk-%X", tmhash.SumTruncated([]byte(baseURL))),
+	}
+}
+
+// ID returns the ID the source is identified by in the snapshot pool, and as the sender of its
+// chunks.
+func (s *httpSnapshotSource) ID() p2p.ID {
+	return p2p.ID(s.baseURL)
+}
+
+// Snapshots fetches the list of snapshots from the server.
+func (s *httpSnapshotSource) Snapshots(ctx context.Context) ([]*snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, httpSnapshotsTimeout)
+	defer cancel()
+
+	resp, err := s.get(ctx, httpSnapshotsPath, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %v", resp.Status)
+	}
+
+	var list []httpSnapshot
+	if err := json.NewDecoder(io.LimitReader(resp.Body, int64(snapshotMsgSize))).Decode(&list); err != nil {
+		return nil, fmt.Errorf("invalid snapshot list: %w", err)
+	}
+	snapshots := make([]*snapshot, 0, len(list))
+	for _, item := range list {
+		if item.Height == 0 || item.Chunks == 0 {
+			return nil, fmt.Errorf("invalid snapshot at height %v with %v chunks", item.Height, item.Chunks)
+		}
+		snapshots = append(snapshots, &snapshot{
+			Height:   item.Height,
+			Format:   item.Format,
+			Chunks:   item.Chunks,
+			Hash:     item.Hash,
+			Metadata: item.Metadata,
+		})
+	}
+	return snapshots, nil
+}
+
+// Chunk downloads a chunk from the server. Interrupted downloads are resumed with range requests
+// if the server supports them, and restarted otherwise. Partial downloads are kept until the chunk
+// is downloaded, so that they are also resumed by the following calls for the chunk.
+func (s *httpSnapshotSource) Chunk(ctx context.Context, height uint64, format uint32, index uint32) (*chunk, error) {
+	path := filepath.Join(s.dir, fmt.Sprintf("%v-%v-%v-%v", s.filePrefix, height, format, index))
+
+	var err error
+	for attempt := 0; attempt < httpChunkAttempts; attempt++ {
+		err = s.download(ctx, fmt.Sprintf("%v/%v/%v", height, format, index), path)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := os.ReadFile(path)
+	os.Remove(path)
+	if err != nil {
+		return nil, err
+	}
+	return &chunk{
+		Height: height,
+		Format: format,
+		Index:  index,
+		Chunk:  body,
+		Sender: s.ID(),
+	}, nil
+}
+
+// download downloads the file at the given path of the server to dest, resuming from the data
+// already in dest.
+func (s *httpSnapshotSource) download(ctx context.Context, path string, dest string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.chunkTimeout)
+	defer cancel()
+
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.get(ctx, path, offset)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+		start, err := contentRangeStart(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return err
+		}
+		if start != offset {
+			// Restart the download with the next attempt.
+			if err := f.Truncate(0); err != nil {
+				return err
+			}
+			return fmt.Errorf("expected content range starting at %v, got %v", offset, start)
+		}
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The partial download is not a prefix of the chunk, restart it with the next attempt.
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		return fmt.Errorf("unexpected status %v", resp.Status)
+	case resp.StatusCode == http.StatusOK:
+		// The server ignored the range, or there was nothing to resume.
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		offset = 0
+	default:
+		return fmt.Errorf("unexpected status %v", resp.Status)
+	}
+
+	n, err := io.Copy(f, io.LimitReader(resp.Body, int64(chunkMsgSize)-offset+1))
+	if err != nil {
+		return err
+	}
+	if offset+n > int64(chunkMsgSize) {
+		return errors.New("chunk is too large")
+	}
+	return f.Sync()
+}
+
+// contentRangeStart returns the first byte position of a Content-Range header of the form
+// "bytes <first>-<last>/<length>".
+func contentRangeStart(contentRange string) (int64, error) {
+	unit, byteRange, ok := strings.Cut(contentRange, " ")
+	if !ok || unit != "bytes" {
+		return 0, fmt.Errorf("invalid content range %q", contentRange)
+	}
+	first, _, ok := strings.Cut(byteRange, "-")
+	if !ok {
+		return 0, fmt.Errorf("invalid content range %q", contentRange)
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid content range %q: %w", contentRange, err)
+	}
+	return start, nil
+}
+
+// get sends a GET request for the given path of the server, starting at offset.
+func (s *httpSnapshotSource) get(ctx context.Context, path string, offset int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+"/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%v-", offset))
+	}
+	return s.client.Do(req)
+}
@@ -0,0 +1,192 @@
+package statesync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
+)
+
+// newTestSnapshotServer serves the given snapshot list and chunks, keyed by
+// <height>/<format>/<index>. The first download of every chunk is interrupted halfway through.
+// The Range headers of the chunk requests are recorded by path.
+func newTestSnapshotServer(t *testing.T, list string, chunks map[string][]byte) (*httptest.Server, map[string][]string) {
+	var (
+		mtx    cmtsync.Mutex
+		ranges = make(map[string][]string)
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/snapshots.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, list)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path[1:]
+		body, ok := chunks[path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		mtx.Lock()
+		ranges[path] = append(ranges[path], r.Header.Get("Range"))
+		first := len(ranges[path]) == 1
+		mtx.Unlock()
+
+		if first {
+			w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+			_, _ = w.Write(body[:len(body)/2])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		http.ServeContent(w, r, path, time.Time{}, bytes.NewReader(body))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, ranges
+}
+
+func TestHTTPSnapshotSource_Snapshots(t *testing.T) {
+	srv, _ := newTestSnapshotServer(t,
+		`[{"height":2,"format":1,"chunks":3,"hash":"010203","metadata":"04"},{"height":1,"format":1,"chunks":1,"hash":"01"}]`,
+		nil)
+
+	source := newHTTPSnapshotSource(srv.URL+"/", t.TempDir(), time.Second)
+	assert.EqualValues(t, srv.URL, source.ID())
+
+	snapshots, err := source.Snapshots(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*snapshot{
+		{Height: 2, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}, Metadata: []byte{4}},
+		{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}},
+	}, snapshots)
+
+	// invalid snapshots are rejected
+	srv, _ = newTestSnapshotServer(t, `[{"height":0,"format":1,"chunks":3,"hash":"01"}]`, nil)
+	_, err = newHTTPSnapshotSource(srv.URL, t.TempDir(), time.Second).Snapshots(context.Background())
+	assert.Error(t, err)
+
+	srv, _ = newTestSnapshotServer(t, `{`, nil)
+	_, err = newHTTPSnapshotSource(srv.URL, t.TempDir(), time.Second).Snapshots(context.Background())
+	assert.Error(t, err)
+}
+
+func TestHTTPSnapshotSource_Chunk(t *testing.T) {
+	body := bytes.Repeat([]byte{1, 2, 3, 4}, 1024)
+	srv, ranges := newTestSnapshotServer(t, `[]`, map[string][]byte{"2/1/0": body})
+	source := newHTTPSnapshotSource(srv.URL, t.TempDir(), time.Second)
+
+	// the interrupted download is resumed
+	c, err := source.Chunk(context.Background(), 2, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &chunk{Height: 2, Format: 1, Index: 0, Chunk: body, Sender: source.ID()}, c)
+	assert.Equal(t, []string{"", "bytes=2048-"}, ranges["2/1/0"])
+
+	_, err = source.Chunk(context.Background(), 2, 1, 1)
+	assert.Error(t, err)
+}
+
+func TestHTTPSnapshotSource_ChunkResumedAcrossCalls(t *testing.T) {
+	body := bytes.Repeat([]byte{1, 2, 3, 4}, 1024)
+	var (
+		mtx    cmtsync.Mutex
+		ranges []string
+	)
+	// every response is interrupted after 1000 bytes
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		ranges = append(ranges, r.Header.Get("Range"))
+		mtx.Unlock()
+
+		var start int
+		if r.Header.Get("Range") != "" {
+			_, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &start)
+			require.NoError(t, err)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(body)-1, len(body)))
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		if len(body)-start <= 1000 {
+			_, _ = w.Write(body[start:])
+			return
+		}
+		_, _ = w.Write(body[start : start+1000])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}))
+	t.Cleanup(srv.Close)
+	source := newHTTPSnapshotSource(srv.URL, t.TempDir(), time.Second)
+
+	_, err := source.Chunk(context.Background(), 2, 1, 0)
+	require.Error(t, err)
+
+	// the download is resumed where the previous call stopped
+	c, err := source.Chunk(context.Background(), 2, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, body, c.Chunk)
+	assert.Equal(t, []string{"", "bytes=1000-", "bytes=2000-", "bytes=3000-", "bytes=4000-"}, ranges)
+}
+
+func TestHTTPSnapshotSource_ChunkPartialContentFromStart(t *testing.T) {
+	body := bytes.Repeat([]byte{1, 2, 3, 4}, 1024)
+	// the server always responds with a content range, even to requests without a range
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(body)-1, len(body)))
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := newHTTPSnapshotSource(srv.URL, t.TempDir(), time.Second).Chunk(context.Background(), 2, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, body, c.Chunk)
+}
+
+func TestHTTPSnapshotSource_ChunkContentRange(t *testing.T) {
+	body := bytes.Repeat([]byte{1, 2, 3, 4}, 1024)
+	var (
+		mtx      cmtsync.Mutex
+		requests int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		requests++
+		first := requests == 1
+		mtx.Unlock()
+
+		if first {
+			w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+			_, _ = w.Write(body[:len(body)/2])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		// the resumed download is served from the start
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(body)-1, len(body)))
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	// the response to the resumed download is rejected, and the download restarted
+	c, err := newHTTPSnapshotSource(srv.URL, t.TempDir(), time.Second).Chunk(context.Background(), 2, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, body, c.Chunk)
+	assert.Equal(t, 3, requests)
+}
+
+func TestHTTPSnapshotSource_ChunkTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(srv.Close)
+
+	start := time.Now()
+	_, err := newHTTPSnapshotSource(srv.URL, t.TempDir(), 100*time.Millisecond).Chunk(context.Background(), 2, 1, 0)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
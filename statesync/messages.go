@@ -47,6 +47,19 @@ func validateMsg(pb proto.Message) error {
 		if msg.Chunks == 0 {
 			return errors.New("snapshot has no chunks")
 		}
+	case *ssproto.NodeSnapshotRequest:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
+	case *ssproto.NodeSnapshotResponse:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
+		for _, block := range msg.Blocks {
+			if block.BlockMeta == nil || block.Commit == nil || block.ValidatorSet == nil {
+				return errors.New("node snapshot block is incomplete")
+			}
+		}
 	default:
 		return fmt.Errorf("unknown message type %T", msg)
 	}
@@ -70,6 +70,23 @@ func TestValidateMsg(t *testing.T) {
 		"SnapshotsResponse no hash": {
 			&ssproto.SnapshotsResponse{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}},
 			false},
+
+		"NodeSnapshotRequest valid":    {&ssproto.NodeSnapshotRequest{Height: 1}, true},
+		"NodeSnapshotRequest 0 height": {&ssproto.NodeSnapshotRequest{Height: 0}, false},
+
+		"NodeSnapshotResponse valid": {
+			&ssproto.NodeSnapshotResponse{Height: 1, Blocks: []*ssproto.NodeSnapshotBlock{
+				{BlockMeta: &cmtproto.BlockMeta{}, Commit: &cmtproto.Commit{}, ValidatorSet: &cmtproto.ValidatorSet{}},
+			}},
+			true},
+		"NodeSnapshotResponse missing": {&ssproto.NodeSnapshotResponse{Height: 1}, true},
+		"NodeSnapshotResponse 0 height": {
+			&ssproto.NodeSnapshotResponse{Height: 0}, false},
+		"NodeSnapshotResponse incomplete block": {
+			&ssproto.NodeSnapshotResponse{Height: 1, Blocks: []*ssproto.NodeSnapshotBlock{
+				{BlockMeta: &cmtproto.BlockMeta{}, ValidatorSet: &cmtproto.ValidatorSet{}},
+			}},
+			false},
 	}
 	for name, tc := range testcases {
 		tc := tc
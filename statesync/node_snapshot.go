@@ -0,0 +1,176 @@
+package statesync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KYVENetwork/celestia-core/p2p"
+	ssproto "github.com/KYVENetwork/celestia-core/proto/celestiacore/statesync"
+	sm "github.com/KYVENetwork/celestia-core/state"
+	"github.com/KYVENetwork/celestia-core/types"
+)
+
+const (
+	// nodeSnapshotBlocks is the maximum number of recent blocks in a node snapshot.
+	nodeSnapshotBlocks = 10
+	// nodeSnapshotTimeout is the time to wait for a node snapshot after restoring an app snapshot.
+	nodeSnapshotTimeout = 15 * time.Second
+	// nodeSnapshotRequestInterval is the minimum time between two node snapshot requests served
+	// to the same peer. Loading a node snapshot reads several blocks and validator sets, and a
+	// syncing node only requests one per state sync.
+	nodeSnapshotRequestInterval = time.Minute
+)
+
+// NodeSnapshot holds the block metas, commits and validator sets of the most recent blocks up to
+// the height of a snapshot, in ascending height order. Unlike app snapshots, node snapshots are
+// produced by the node itself from its block store and state database. They are used to bootstrap
+// the block store and state database after state sync, such that the node has the same recent
+// block history as if it had synced the blocks.
+type NodeSnapshot struct {
+	BlockMetas    []*types.BlockMeta
+	Commits       []*types.Commit
+	ValidatorSets []*types.ValidatorSet
+}
+
+// nodeSnapshotResponse is a node snapshot response received from a peer.
+type nodeSnapshotResponse struct {
+	peer p2p.ID
+	msg  *ssproto.NodeSnapshotResponse
+}
+
+// loadNodeSnapshot loads the node snapshot at the given height from the state store and block
+// store. It holds up to nodeSnapshotBlocks blocks, fewer if older blocks have been pruned or the
+// message would be too large, and none if the block at the given height is unavailable.
+func loadNodeSnapshot(stateStore sm.Store, blockStore sm.BlockStore, height int64) (*ssproto.NodeSnapshotResponse, error) {
+	blocks := make([]*ssproto.NodeSnapshotBlock, 0, nodeSnapshotBlocks)
+	for h := height; h > height-nodeSnapshotBlocks && h > 0; h-- {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			break
+		}
+		commit := blockStore.LoadBlockCommit(h)
+		if commit == nil {
+			commit = blockStore.LoadSeenCommit(h)
+		}
+		if commit == nil {
+			break
+		}
+		vals, err := stateStore.LoadValidators(h)
+		if err != nil {
+			break
+		}
+		pbvals, err := vals.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, &ssproto.NodeSnapshotBlock{
+			BlockMeta:    meta.ToProto(),
+			Commit:       commit.ToProto(),
+			ValidatorSet: pbvals,
+		})
+	}
+
+	// Blocks were loaded from the top, but are sent in ascending height order.
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	msg := &ssproto.NodeSnapshotResponse{Height: uint64(height), Blocks: blocks}
+	for len(msg.Blocks) > 0 && msg.Wrap().(*ssproto.Message).Size() > snapshotMsgSize {
+		msg.Blocks = msg.Blocks[1:]
+	}
+	return msg, nil
+}
+
+// nodeSnapshotFromProto converts a node snapshot response into a node snapshot.
+func nodeSnapshotFromProto(msg *ssproto.NodeSnapshotResponse) (*NodeSnapshot, error) {
+	ns := &NodeSnapshot{
+		BlockMetas:    make([]*types.BlockMeta, 0, len(msg.Blocks)),
+		Commits:       make([]*types.Commit, 0, len(msg.Blocks)),
+		ValidatorSets: make([]*types.ValidatorSet, 0, len(msg.Blocks)),
+	}
+	for _, block := range msg.Blocks {
+		meta, err := types.BlockMetaFromProto(block.BlockMeta)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block meta: %w", err)
+		}
+		commit, err := types.CommitFromProto(block.Commit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit: %w", err)
+		}
+		vals, err := types.ValidatorSetFromProto(block.ValidatorSet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator set: %w", err)
+		}
+		ns.BlockMetas = append(ns.BlockMetas, meta)
+		ns.Commits = append(ns.Commits, commit)
+		ns.ValidatorSets = append(ns.ValidatorSets, vals)
+	}
+	return ns, nil
+}
+
+// Height returns the height of the node snapshot, i.e. the height of its last block.
+func (ns *NodeSnapshot) Height() int64 {
+	if len(ns.BlockMetas) == 0 {
+		return 0
+	}
+	return ns.BlockMetas[len(ns.BlockMetas)-1].Header.Height
+}
+
+// verify verifies the node snapshot against a trusted commit at the snapshot height, obtained
+// from the StateProvider. The last block must be the committed one, and the earlier blocks must
+// be linked to it through the hashes of the block headers, which commit to the previous block ID,
+// the previous block's commit and the validator set.
+func (ns *NodeSnapshot) verify(chainID string, commit *types.Commit) error {
+	if len(ns.BlockMetas) == 0 {
+		return errors.New("node snapshot has no blocks")
+	}
+	if len(ns.Commits) != len(ns.BlockMetas) || len(ns.ValidatorSets) != len(ns.BlockMetas) {
+		return errors.New("node snapshot has mismatched blocks, commits and validator sets")
+	}
+	if ns.Height() != commit.Height {
+		return fmt.Errorf("node snapshot height %v does not match commit height %v",
+			ns.Height(), commit.Height)
+	}
+
+	for i, meta := range ns.BlockMetas {
+		header := meta.Header
+		if err := header.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid header at height %v: %w", header.Height, err)
+		}
+		if header.ChainID != chainID {
+			return fmt.Errorf("header at height %v has chain ID %q, expected %q",
+				header.Height, header.ChainID, chainID)
+		}
+		if !bytes.Equal(header.Hash(), meta.BlockID.Hash) {
+			return fmt.Errorf("header at height %v does not match block ID %v", header.Height, meta.BlockID)
+		}
+		if !bytes.Equal(ns.ValidatorSets[i].Hash(), header.ValidatorsHash) {
+			return fmt.Errorf("validator set at height %v does not match header", header.Height)
+		}
+		if ns.Commits[i].Height != header.Height || !ns.Commits[i].BlockID.Equals(meta.BlockID) {
+			return fmt.Errorf("commit at height %v does not match block ID %v", header.Height, meta.BlockID)
+		}
+
+		if i == len(ns.BlockMetas)-1 {
+			if !meta.BlockID.Equals(commit.BlockID) {
+				return fmt.Errorf("block ID %v at height %v does not match the committed block ID %v",
+					meta.BlockID, header.Height, commit.BlockID)
+			}
+			continue
+		}
+
+		next := ns.BlockMetas[i+1].Header
+		if next.Height != header.Height+1 {
+			return fmt.Errorf("block at height %v is followed by height %v", header.Height, next.Height)
+		}
+		if !next.LastBlockID.Equals(meta.BlockID) {
+			return fmt.Errorf("block ID %v at height %v does not match the next header", meta.BlockID, header.Height)
+		}
+		if !bytes.Equal(ns.Commits[i].Hash(), next.LastCommitHash) {
+			return fmt.Errorf("commit at height %v does not match the next header", header.Height)
+		}
+	}
+	return nil
+}
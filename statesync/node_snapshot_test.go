@@ -0,0 +1,186 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KYVENetwork/celestia-core/crypto/tmhash"
+	cmtversion "github.com/KYVENetwork/celestia-core/proto/celestiacore/version"
+	sm "github.com/KYVENetwork/celestia-core/state"
+	"github.com/KYVENetwork/celestia-core/store"
+	"github.com/KYVENetwork/celestia-core/types"
+	"github.com/KYVENetwork/celestia-core/version"
+)
+
+const testChainID = "test-chain"
+
+// makeNodeSnapshot makes a node snapshot of a chain of blocks with the given heights, whose
+// headers are linked like those of a real chain. The returned commit is the commit of the last
+// block, as obtained from the StateProvider.
+func makeNodeSnapshot(t *testing.T, from, to int64) (*NodeSnapshot, *types.Commit) {
+	val, _ := types.RandValidator(true, 10)
+	vals := types.NewValidatorSet([]*types.Validator{val})
+	ns := &NodeSnapshot{}
+	var (
+		lastBlockID types.BlockID
+		lastCommit  *types.Commit
+	)
+	for height := from; height <= to; height++ {
+		header := types.Header{
+			Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+			ChainID:            testChainID,
+			Height:             height,
+			Time:               time.Unix(height, 0).UTC(),
+			LastBlockID:        lastBlockID,
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+			ProposerAddress:    val.Address,
+		}
+		if lastCommit != nil {
+			header.LastCommitHash = lastCommit.Hash()
+		}
+		blockID := types.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte{byte(height)})},
+		}
+		commit := types.NewCommit(height, 0, blockID, []types.CommitSig{{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        header.Time,
+			Signature:        []byte("signature"),
+		}})
+		ns.BlockMetas = append(ns.BlockMetas, &types.BlockMeta{BlockID: blockID, Header: header})
+		ns.Commits = append(ns.Commits, commit)
+		ns.ValidatorSets = append(ns.ValidatorSets, vals)
+		lastBlockID, lastCommit = blockID, commit
+	}
+	return ns, lastCommit
+}
+
+// makeNodeSnapshotStores makes a state store and block store holding the blocks of the given
+// node snapshot.
+func makeNodeSnapshotStores(t *testing.T, ns *NodeSnapshot) (sm.Store, sm.BlockStore) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	require.NoError(t, blockStore.Bootstrap(ns.BlockMetas, ns.Commits))
+	for i, vals := range ns.ValidatorSets {
+		height := ns.BlockMetas[i].Header.Height
+		require.NoError(t, stateStore.SaveValidatorSets(height, height, vals))
+	}
+	return stateStore, blockStore
+}
+
+func TestLoadNodeSnapshot(t *testing.T) {
+	ns, commit := makeNodeSnapshot(t, 1, 12)
+	stateStore, blockStore := makeNodeSnapshotStores(t, ns)
+
+	testcases := map[string]struct {
+		height       int64
+		expectBlocks int
+	}{
+		"latest height":        {12, nodeSnapshotBlocks},
+		"earlier height":       {11, nodeSnapshotBlocks},
+		"few blocks available": {4, 4},
+		"unknown height":       {13, 0},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := loadNodeSnapshot(stateStore, blockStore, tc.height)
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.height, msg.Height)
+			require.Len(t, msg.Blocks, tc.expectBlocks)
+			if tc.expectBlocks == 0 {
+				return
+			}
+
+			loaded, err := nodeSnapshotFromProto(msg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.height, loaded.Height())
+			assert.EqualValues(t, tc.height-int64(tc.expectBlocks)+1, loaded.BlockMetas[0].Header.Height)
+			require.NoError(t, loaded.verify(testChainID, ns.Commits[tc.height-1]))
+		})
+	}
+
+	loaded, err := loadNodeSnapshot(stateStore, blockStore, 12)
+	require.NoError(t, err)
+	ns12, err := nodeSnapshotFromProto(loaded)
+	require.NoError(t, err)
+	assert.Equal(t, commit.Hash(), ns12.Commits[len(ns12.Commits)-1].Hash())
+}
+
+func TestNodeSnapshot_verify(t *testing.T) {
+	testcases := map[string]struct {
+		modify func(ns *NodeSnapshot, commit *types.Commit) *types.Commit
+		valid  bool
+	}{
+		"valid": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			return commit
+		}, true},
+		"no blocks": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			ns.BlockMetas, ns.Commits, ns.ValidatorSets = nil, nil, nil
+			return commit
+		}, false},
+		"missing validator set": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			ns.ValidatorSets = ns.ValidatorSets[1:]
+			return commit
+		}, false},
+		"height mismatch": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			ns.BlockMetas, ns.Commits, ns.ValidatorSets = ns.BlockMetas[:2], ns.Commits[:2], ns.ValidatorSets[:2]
+			return commit
+		}, false},
+		"other committed block": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			_, otherCommit := makeNodeSnapshot(t, 3, 3)
+			return otherCommit
+		}, false},
+		"wrong chain ID": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			ns.BlockMetas[0].Header.ChainID = "other-chain"
+			ns.BlockMetas[0].BlockID.Hash = ns.BlockMetas[0].Header.Hash()
+			return commit
+		}, false},
+		"header does not match block ID": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			ns.BlockMetas[0].Header.Time = time.Unix(100, 0).UTC()
+			return commit
+		}, false},
+		"wrong validator set": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			other, _ := makeNodeSnapshot(t, 1, 1)
+			ns.ValidatorSets[1] = other.ValidatorSets[0]
+			return commit
+		}, false},
+		"forged earlier commit": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			forged := ns.Commits[0].Signatures[0]
+			forged.Signature = []byte("forged")
+			ns.Commits[0] = types.NewCommit(1, 0, ns.Commits[0].BlockID, []types.CommitSig{forged})
+			return commit
+		}, false},
+		"unlinked earlier block": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			other, _ := makeNodeSnapshot(t, 1, 1)
+			ns.BlockMetas[0], ns.Commits[0], ns.ValidatorSets[0] = other.BlockMetas[0],
+				other.Commits[0], other.ValidatorSets[0]
+			return commit
+		}, false},
+		"gap": {func(ns *NodeSnapshot, commit *types.Commit) *types.Commit {
+			ns.BlockMetas = []*types.BlockMeta{ns.BlockMetas[0], ns.BlockMetas[2]}
+			ns.Commits = []*types.Commit{ns.Commits[0], ns.Commits[2]}
+			ns.ValidatorSets = []*types.ValidatorSet{ns.ValidatorSets[0], ns.ValidatorSets[2]}
+			return commit
+		}, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ns, commit := makeNodeSnapshot(t, 1, 3)
+			commit = tc.modify(ns, commit)
+			err := ns.verify(testChainID, commit)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
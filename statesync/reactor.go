@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	abci "github.com/KYVENetwork/celestia-core/abci/types"
+	"github.com/KYVENetwork/celestia-core/behaviour"
 	"github.com/KYVENetwork/celestia-core/config"
 	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
 	"github.com/KYVENetwork/celestia-core/p2p"
@@ -36,6 +37,14 @@ type Reactor struct {
 	connQuery proxy.AppConnQuery
 	tempDir   string
 
+	// The stores node snapshots are served from, if enabled.
+	stateStore sm.Store
+	blockStore sm.BlockStore
+
+	// The time of the last node snapshot request served to each peer, used to rate limit them.
+	nodeSnapshotMtx      cmtsync.Mutex
+	nodeSnapshotRequests map[p2p.ID]time.Time
+
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
 	mtx    cmtsync.RWMutex
@@ -51,15 +60,23 @@ func NewReactor(
 ) *Reactor {
 
 	r := &Reactor{
-		cfg:       cfg,
-		conn:      conn,
-		connQuery: connQuery,
+		cfg:                  cfg,
+		conn:                 conn,
+		connQuery:            connQuery,
+		nodeSnapshotRequests: make(map[p2p.ID]time.Time),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r)
 
 	return r
 }
 
+// SetStores sets the state store and block store that node snapshots are served from, if
+// node snapshots are enabled.
+func (r *Reactor) SetStores(stateStore sm.Store, blockStore sm.BlockStore) {
+	r.stateStore = stateStore
+	r.blockStore = blockStore
+}
+
 // GetChannels implements p2p.Reactor.
 func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	return []*p2p.ChannelDescriptor{
@@ -85,6 +102,15 @@ func (r *Reactor) OnStart() error {
 	return nil
 }
 
+// OnStop implements p2p.Reactor.
+func (r *Reactor) OnStop() {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.syncer != nil {
+		r.syncer.Stop()
+	}
+}
+
 // AddPeer implements p2p.Reactor.
 func (r *Reactor) AddPeer(peer p2p.Peer) {
 	r.mtx.RLock()
@@ -96,6 +122,10 @@ func (r *Reactor) AddPeer(peer p2p.Peer) {
 
 // RemovePeer implements p2p.Reactor.
 func (r *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	r.nodeSnapshotMtx.Lock()
+	delete(r.nodeSnapshotRequests, peer.ID())
+	r.nodeSnapshotMtx.Unlock()
+
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.syncer != nil {
@@ -112,7 +142,7 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	err := validateMsg(e.Message)
 	if err != nil {
 		r.Logger.Error("Invalid message", "peer", e.Src, "msg", e.Message, "err", err)
-		r.Switch.StopPeerForError(e.Src, err)
+		r.Switch.StopPeerForFault(e.Src, err)
 		return
 	}
 
@@ -162,6 +192,38 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 				return
 			}
 
+		case *ssproto.NodeSnapshotRequest:
+			if !r.allowNodeSnapshotRequest(e.Src.ID()) {
+				r.Logger.Debug("Ignoring node snapshot request, peer requested one too recently",
+					"height", msg.Height, "peer", e.Src.ID())
+				return
+			}
+			resp := &ssproto.NodeSnapshotResponse{Height: msg.Height}
+			if r.cfg.NodeSnapshots && r.stateStore != nil && r.blockStore != nil {
+				resp, err = loadNodeSnapshot(r.stateStore, r.blockStore, int64(msg.Height))
+				if err != nil {
+					r.Logger.Error("Failed to load node snapshot", "height", msg.Height, "err", err)
+					return
+				}
+			}
+			r.Logger.Debug("Sending node snapshot", "height", msg.Height, "blocks", len(resp.Blocks),
+				"peer", e.Src.ID())
+			p2p.SendEnvelopeShim(e.Src, p2p.Envelope{ //nolint: staticcheck
+				ChannelID: e.ChannelID,
+				Message:   resp,
+			}, r.Logger)
+
+		case *ssproto.NodeSnapshotResponse:
+			r.mtx.RLock()
+			defer r.mtx.RUnlock()
+			if r.syncer == nil {
+				r.Logger.Debug("Received unexpected node snapshot, no state sync in progress", "peer", e.Src.ID())
+				return
+			}
+			r.Logger.Debug("Received node snapshot", "height", msg.Height, "blocks", len(msg.Blocks),
+				"peer", e.Src.ID())
+			r.syncer.AddNodeSnapshot(e.Src, msg)
+
 		default:
 			r.Logger.Error("Received unknown message %T", msg)
 		}
@@ -277,15 +339,35 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	return snapshots, nil
 }
 
-// Sync runs a state sync, returning the new state and last commit at the snapshot height.
-// The caller must store the state and commit in the state database and block store.
-func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration) (sm.State, *types.Commit, error) {
+// allowNodeSnapshotRequest returns whether a node snapshot request from the given peer should be
+// served, i.e. whether at least nodeSnapshotRequestInterval has passed since the last one.
+func (r *Reactor) allowNodeSnapshotRequest(peerID p2p.ID) bool {
+	r.nodeSnapshotMtx.Lock()
+	defer r.nodeSnapshotMtx.Unlock()
+	now := time.Now()
+	if last, ok := r.nodeSnapshotRequests[peerID]; ok && now.Sub(last) < nodeSnapshotRequestInterval {
+		return false
+	}
+	r.nodeSnapshotRequests[peerID] = now
+	return true
+}
+
+// Sync runs a state sync, returning the new state and last commit at the snapshot height, and
+// the node snapshot at that height if node snapshots are enabled and one could be fetched. The
+// caller must store the state and commit in the state database and block store, and bootstrap
+// them with the node snapshot, if any.
+func (r *Reactor) Sync(
+	stateProvider StateProvider,
+	discoveryTime time.Duration,
+) (sm.State, *types.Commit, *NodeSnapshot, error) {
 	r.mtx.Lock()
 	if r.syncer != nil {
 		r.mtx.Unlock()
-		return sm.State{}, nil, errors.New("a state sync is already in progress")
+		return sm.State{}, nil, nil, errors.New("a state sync is already in progress")
 	}
-	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	r.syncer = newSyncer(r.cfg, r.Logger, behaviour.NewSwitchReporter(r.Switch), r.conn, r.connQuery,
+		stateProvider, r.tempDir)
+	r.syncer.resumeDir = r.cfg.ResumeDir()
 	r.mtx.Unlock()
 
 	hook := func() {
@@ -302,8 +384,19 @@ func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration)
 
 	state, commit, err := r.syncer.SyncAny(discoveryTime, hook)
 
+	var nodeSnapshot *NodeSnapshot
+	if err == nil && r.cfg.NodeSnapshots {
+		r.Logger.Info("Requesting node snapshot from peers", "height", state.LastBlockHeight)
+		r.Switch.BroadcastEnvelope(p2p.Envelope{
+			ChannelID: SnapshotChannel,
+			Message:   &ssproto.NodeSnapshotRequest{Height: uint64(state.LastBlockHeight)},
+		})
+		nodeSnapshot = r.syncer.FetchNodeSnapshot(state.ChainID, commit, nodeSnapshotTimeout)
+	}
+
 	r.mtx.Lock()
+	r.syncer.Stop()
 	r.syncer = nil
 	r.mtx.Unlock()
-	return state, commit, err
+	return state, commit, nodeSnapshot, err
 }
@@ -184,6 +184,78 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 	}
 }
 
+func TestReactor_Receive_NodeSnapshotRequest(t *testing.T) {
+	ns, _ := makeNodeSnapshot(t, 1, 3)
+	stateStore, blockStore := makeNodeSnapshotStores(t, ns)
+
+	testcases := map[string]struct {
+		enabled      bool
+		height       uint64
+		expectBlocks int
+	}{
+		"node snapshot is returned": {true, 3, 3},
+		"unknown height":            {true, 4, 0},
+		"node snapshots disabled":   {false, 3, 0},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// Mock peer to store response
+			peer := &p2pmocks.PeerEnvelopeSender{}
+			peer.On("ID").Return(p2p.ID("id"))
+			var response *ssproto.NodeSnapshotResponse
+			peer.On("SendEnvelope", mock.MatchedBy(func(i interface{}) bool {
+				e, ok := i.(p2p.Envelope)
+				return ok && e.ChannelID == SnapshotChannel
+			})).Run(func(args mock.Arguments) {
+				e := args[0].(p2p.Envelope)
+
+				// Marshal to simulate a wire roundtrip.
+				bz, err := proto.Marshal(e.Message)
+				require.NoError(t, err)
+				err = proto.Unmarshal(bz, e.Message)
+				require.NoError(t, err)
+				response = e.Message.(*ssproto.NodeSnapshotResponse)
+			}).Return(true)
+
+			// Start a reactor and send a ssproto.NodeSnapshotRequest, then wait for and check response
+			cfg := config.DefaultStateSyncConfig()
+			cfg.NodeSnapshots = tc.enabled
+			r := NewReactor(*cfg, &proxymocks.AppConnSnapshot{}, nil, "")
+			r.SetStores(stateStore, blockStore)
+			err := r.Start()
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				if err := r.Stop(); err != nil {
+					t.Error(err)
+				}
+			})
+
+			r.ReceiveEnvelope(p2p.Envelope{
+				ChannelID: SnapshotChannel,
+				Src:       peer,
+				Message:   &ssproto.NodeSnapshotRequest{Height: tc.height},
+			})
+			time.Sleep(100 * time.Millisecond)
+			require.NotNil(t, response)
+			assert.Equal(t, tc.height, response.Height)
+			assert.Len(t, response.Blocks, tc.expectBlocks)
+
+			// a second request from the same peer is rate limited
+			r.ReceiveEnvelope(p2p.Envelope{
+				ChannelID: SnapshotChannel,
+				Src:       peer,
+				Message:   &ssproto.NodeSnapshotRequest{Height: tc.height},
+			})
+			time.Sleep(100 * time.Millisecond)
+			peer.AssertNumberOfCalls(t, "SendEnvelope", 1)
+
+			peer.AssertExpectations(t)
+		})
+	}
+}
+
 func TestLegacyReactorReceiveBasic(t *testing.T) {
 	cfg := config.DefaultStateSyncConfig()
 	conn := &proxymocks.AppConnSnapshot{}
@@ -43,6 +43,8 @@ type snapshotPool struct {
 	cmtsync.Mutex
 	snapshots     map[snapshotKey]*snapshot
 	snapshotPeers map[snapshotKey]map[p2p.ID]p2p.Peer
+	// HTTP snapshot sources, indexed by their ID alongside the peers
+	snapshotSources map[snapshotKey]map[p2p.ID]*httpSnapshotSource
 
 	// indexes for fast searches
 	formatIndex map[uint32]map[snapshotKey]bool
@@ -60,6 +62,7 @@ func newSnapshotPool() *snapshotPool {
 	return &snapshotPool{
 		snapshots:         make(map[snapshotKey]*snapshot),
 		snapshotPeers:     make(map[snapshotKey]map[p2p.ID]p2p.Peer),
+		snapshotSources:   make(map[snapshotKey]map[p2p.ID]*httpSnapshotSource),
 		formatIndex:       make(map[uint32]map[snapshotKey]bool),
 		heightIndex:       make(map[uint64]map[snapshotKey]bool),
 		peerIndex:         make(map[p2p.ID]map[snapshotKey]bool),
@@ -94,13 +97,46 @@ func (p *snapshotPool) Add(peer p2p.Peer, snapshot *snapshot) (bool, error) {
 	}
 	p.snapshotPeers[key][peer.ID()] = peer
 
-	if p.peerIndex[peer.ID()] == nil {
-		p.peerIndex[peer.ID()] = make(map[snapshotKey]bool)
+	return p.addSnapshot(peer.ID(), key, snapshot), nil
+}
+
+// AddSource adds a snapshot served by an HTTP snapshot source to the pool, with the same
+// restrictions as Add.
+func (p *snapshotPool) AddSource(source *httpSnapshotSource, snapshot *snapshot) (bool, error) {
+	key := snapshot.Key()
+
+	p.Lock()
+	defer p.Unlock()
+
+	switch {
+	case p.formatBlacklist[snapshot.Format]:
+		return false, nil
+	case p.peerBlacklist[source.ID()]:
+		return false, nil
+	case p.snapshotBlacklist[key]:
+		return false, nil
+	case len(p.peerIndex[source.ID()]) >= recentSnapshots:
+		return false, nil
+	}
+
+	if p.snapshotSources[key] == nil {
+		p.snapshotSources[key] = make(map[p2p.ID]*httpSnapshotSource)
+	}
+	p.snapshotSources[key][source.ID()] = source
+
+	return p.addSnapshot(source.ID(), key, snapshot), nil
+}
+
+// addSnapshot indexes a snapshot offered by the peer or source with the given ID. It returns true
+// if the snapshot is new. The caller must hold the mutex lock.
+func (p *snapshotPool) addSnapshot(id p2p.ID, key snapshotKey, snapshot *snapshot) bool {
+	if p.peerIndex[id] == nil {
+		p.peerIndex[id] = make(map[snapshotKey]bool)
 	}
-	p.peerIndex[peer.ID()][key] = true
+	p.peerIndex[id][key] = true
 
 	if p.snapshots[key] != nil {
-		return false, nil
+		return false
 	}
 	p.snapshots[key] = snapshot
 
@@ -114,7 +150,7 @@ func (p *snapshotPool) Add(peer p2p.Peer, snapshot *snapshot) (bool, error) {
 	}
 	p.heightIndex[snapshot.Height][key] = true
 
-	return true, nil
+	return true
 }
 
 // Best returns the "best" currently known snapshot, if any.
@@ -152,6 +188,31 @@ func (p *snapshotPool) GetPeers(snapshot *snapshot) []p2p.Peer {
 	return peers
 }
 
+// GetSource returns a random HTTP snapshot source for a snapshot, if any.
+func (p *snapshotPool) GetSource(snapshot *snapshot) *httpSnapshotSource {
+	sources := p.GetSources(snapshot)
+	if len(sources) == 0 {
+		return nil
+	}
+	return sources[rand.Intn(len(sources))] //nolint:gosec // G404: Use of weak random number generator
+}
+
+// GetSources returns the HTTP snapshot sources for a snapshot.
+func (p *snapshotPool) GetSources(snapshot *snapshot) []*httpSnapshotSource {
+	key := snapshot.Key()
+	p.Lock()
+	defer p.Unlock()
+
+	sources := make([]*httpSnapshotSource, 0, len(p.snapshotSources[key]))
+	for _, source := range p.snapshotSources[key] {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(a int, b int) bool {
+		return sources[a].ID() < sources[b].ID()
+	})
+	return sources
+}
+
 // Ranked returns a list of snapshots ranked by preference. The current heuristic is very naïve,
 // preferring the snapshot with the greatest height, then greatest format, then greatest number of
 // peers. This can be improved quite a lot.
@@ -177,7 +238,7 @@ func (p *snapshotPool) Ranked() []*snapshot {
 			return true
 		case a.Format < b.Format:
 			return false
-		case len(p.snapshotPeers[a.Key()]) > len(p.snapshotPeers[b.Key()]):
+		case p.providers(a.Key()) > p.providers(b.Key()):
 			return true
 		default:
 			return false
@@ -187,6 +248,12 @@ func (p *snapshotPool) Ranked() []*snapshot {
 	return candidates
 }
 
+// providers returns the number of peers and sources of a snapshot. The caller must hold the mutex
+// lock.
+func (p *snapshotPool) providers(key snapshotKey) int {
+	return len(p.snapshotPeers[key]) + len(p.snapshotSources[key])
+}
+
 // Reject rejects a snapshot. Rejected snapshots will never be used again.
 func (p *snapshotPool) Reject(snapshot *snapshot) {
 	key := snapshot.Key()
@@ -231,7 +298,8 @@ func (p *snapshotPool) RemovePeer(peerID p2p.ID) {
 func (p *snapshotPool) removePeer(peerID p2p.ID) {
 	for key := range p.peerIndex[peerID] {
 		delete(p.snapshotPeers[key], peerID)
-		if len(p.snapshotPeers[key]) == 0 {
+		delete(p.snapshotSources[key], peerID)
+		if p.providers(key) == 0 {
 			p.removeSnapshot(key)
 		}
 	}
@@ -251,5 +319,9 @@ func (p *snapshotPool) removeSnapshot(key snapshotKey) {
 	for peerID := range p.snapshotPeers[key] {
 		delete(p.peerIndex[peerID], key)
 	}
+	for sourceID := range p.snapshotSources[key] {
+		delete(p.peerIndex[sourceID], key)
+	}
 	delete(p.snapshotPeers, key)
+	delete(p.snapshotSources, key)
 }
@@ -2,6 +2,7 @@ package statesync
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -301,3 +302,38 @@ func TestSnapshotPool_RemovePeer(t *testing.T) {
 	assert.EqualValues(t, "a", peers1[0].ID())
 	assert.EqualValues(t, "b", peers1[1].ID())
 }
+
+func TestSnapshotPool_AddSource(t *testing.T) {
+	pool := newSnapshotPool()
+
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("a"))
+	source := newHTTPSnapshotSource("https://snapshots.example.com", "", time.Second)
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+
+	added, err := pool.AddSource(source, s1)
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = pool.Add(peer, s1)
+	require.NoError(t, err)
+	assert.False(t, added)
+	added, err = pool.AddSource(source, s2)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	assert.Equal(t, source, pool.GetSource(s1))
+	assert.Len(t, pool.GetPeers(s1), 1)
+	assert.Equal(t, []*snapshot{s2, s1}, pool.Ranked())
+
+	// rejecting the source removes the snapshots only it provides
+	pool.RejectPeer(source.ID())
+	assert.Nil(t, pool.GetSource(s1))
+	assert.Equal(t, []*snapshot{s1}, pool.Ranked())
+
+	added, err = pool.AddSource(source, s2)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Nil(t, pool.GetSource(s2))
+}
@@ -12,6 +12,7 @@ import (
 	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
 	"github.com/KYVENetwork/celestia-core/light"
 	lightprovider "github.com/KYVENetwork/celestia-core/light/provider"
+	lightgrpc "github.com/KYVENetwork/celestia-core/light/provider/grpc"
 	lighthttp "github.com/KYVENetwork/celestia-core/light/provider/http"
 	lightrpc "github.com/KYVENetwork/celestia-core/light/rpc"
 	lightdb "github.com/KYVENetwork/celestia-core/light/store/db"
@@ -45,27 +46,42 @@ type lightClientStateProvider struct {
 }
 
 // NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
+// If gRPC servers are given, one for each RPC server in the same order, light blocks are fetched
+// from them instead of the RPC servers. Evidence is still reported through the RPC servers.
 func NewLightClientStateProvider(
 	ctx context.Context,
 	chainID string,
 	version cmtstate.Version,
 	initialHeight int64,
 	servers []string,
+	grpcServers []string,
 	trustOptions light.TrustOptions,
 	logger log.Logger,
 ) (StateProvider, error) {
 	if len(servers) < 2 {
 		return nil, fmt.Errorf("at least 2 RPC servers are required, got %v", len(servers))
 	}
+	if len(grpcServers) > 0 && len(grpcServers) != len(servers) {
+		return nil, fmt.Errorf("expected %v gRPC servers, got %v", len(servers), len(grpcServers))
+	}
 
 	providers := make([]lightprovider.Provider, 0, len(servers))
 	providerRemotes := make(map[lightprovider.Provider]string)
-	for _, server := range servers {
+	for i, server := range servers {
 		client, err := rpcClient(server)
 		if err != nil {
 			return nil, fmt.Errorf("failed to set up RPC client: %w", err)
 		}
-		provider := lighthttp.NewWithClient(chainID, client)
+		var provider lightprovider.Provider
+		if len(grpcServers) > 0 {
+			// evidence is reported through the RPC server, as the gRPC server can't take it
+			provider, err = lightgrpc.New(chainID, grpcServers[i], client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set up gRPC client: %w", err)
+			}
+		} else {
+			provider = lighthttp.NewWithClient(chainID, client)
+		}
 		providers = append(providers, provider)
 		// We store the RPC addresses keyed by provider, so we can find the address of the primary
 		// provider used by the light client and use it to fetch consensus parameters.
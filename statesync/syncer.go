@@ -8,6 +8,7 @@ import (
 	"time"
 
 	abci "github.com/KYVENetwork/celestia-core/abci/types"
+	"github.com/KYVENetwork/celestia-core/behaviour"
 	"github.com/KYVENetwork/celestia-core/config"
 	"github.com/KYVENetwork/celestia-core/libs/log"
 	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
@@ -52,13 +53,21 @@ var (
 // snapshot. Snapshots and chunks are fed via AddSnapshot() and AddChunk() as appropriate.
 type syncer struct {
 	logger        log.Logger
+	reporter      behaviour.Reporter
 	stateProvider StateProvider
 	conn          proxy.AppConnSnapshot
 	connQuery     proxy.AppConnQuery
 	snapshots     *snapshotPool
+	sources       []*httpSnapshotSource
 	tempDir       string
+	resumeDir     string // if set, the progress is persisted there to resume after a restart
 	chunkFetchers int32
 	retryTimeout  time.Duration
+	nodeSnapshots chan nodeSnapshotResponse
+
+	// ctx is cancelled by Stop, aborting the requests of the sync.
+	ctx    context.Context
+	cancel context.CancelFunc
 
 	mtx    cmtsync.RWMutex
 	chunks *chunkQueue
@@ -68,24 +77,41 @@ type syncer struct {
 func newSyncer(
 	cfg config.StateSyncConfig,
 	logger log.Logger,
+	reporter behaviour.Reporter,
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
 	stateProvider StateProvider,
 	tempDir string,
 ) *syncer {
 
+	sources := make([]*httpSnapshotSource, 0, len(cfg.SnapshotHTTPServers))
+	for _, server := range cfg.SnapshotHTTPServers {
+		sources = append(sources, newHTTPSnapshotSource(server, tempDir, cfg.ChunkRequestTimeout))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
 	return &syncer{
 		logger:        logger,
+		reporter:      reporter,
 		stateProvider: stateProvider,
 		conn:          conn,
 		connQuery:     connQuery,
 		snapshots:     newSnapshotPool(),
+		sources:       sources,
 		tempDir:       tempDir,
 		chunkFetchers: cfg.ChunkFetchers,
 		retryTimeout:  cfg.ChunkRequestTimeout,
+		nodeSnapshots: make(chan nodeSnapshotResponse, 16),
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 }
 
+// Stop aborts the requests of the sync to the state provider and snapshot sources.
+func (s *syncer) Stop() {
+	s.cancel()
+}
+
 // AddChunk adds a chunk to the chunk queue, if any. It returns false if the chunk has already
 // been added to the queue, or an error if there's no sync in progress.
 func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
@@ -122,6 +148,54 @@ func (s *syncer) AddSnapshot(peer p2p.Peer, snapshot *snapshot) (bool, error) {
 	return added, nil
 }
 
+// AddNodeSnapshot adds a node snapshot received from a peer, to be verified by FetchNodeSnapshot.
+// Node snapshots received while no node snapshot is being fetched are dropped once the buffer is full.
+func (s *syncer) AddNodeSnapshot(peer p2p.Peer, msg *ssproto.NodeSnapshotResponse) {
+	select {
+	case s.nodeSnapshots <- nodeSnapshotResponse{peer: peer.ID(), msg: msg}:
+	default:
+		s.logger.Debug("Dropping node snapshot", "height", msg.Height, "peer", peer.ID())
+	}
+}
+
+// FetchNodeSnapshot waits for the node snapshots at the height of the given commit, which the
+// caller must have requested from peers, and returns the first one that is verified against the
+// commit. It returns nil if none is received within the timeout.
+func (s *syncer) FetchNodeSnapshot(chainID string, commit *types.Commit, timeout time.Duration) *NodeSnapshot {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case resp := <-s.nodeSnapshots:
+			if int64(resp.msg.Height) != commit.Height {
+				continue
+			}
+			if len(resp.msg.Blocks) == 0 {
+				s.logger.Debug("Peer has no node snapshot", "height", commit.Height, "peer", resp.peer)
+				continue
+			}
+			ns, err := nodeSnapshotFromProto(resp.msg)
+			if err == nil {
+				err = ns.verify(chainID, commit)
+			}
+			if err != nil {
+				s.logger.Error("Invalid node snapshot", "height", commit.Height, "peer", resp.peer, "err", err)
+				s.report(behaviour.BadMessage(resp.peer, err.Error()))
+				continue
+			}
+			// The commit of the last block was only checked for its block ID, so use the trusted one.
+			ns.Commits[len(ns.Commits)-1] = commit
+			s.logger.Info("Fetched node snapshot", "height", commit.Height, "blocks", len(ns.BlockMetas),
+				"peer", resp.peer)
+			return ns
+
+		case <-timer.C:
+			s.logger.Info("Timed out waiting for node snapshot", "height", commit.Height)
+			return nil
+		}
+	}
+}
+
 // AddPeer adds a peer to the pool. For now we just keep it simple and send a single request
 // to discover snapshots, later we may want to do retries and stuff.
 func (s *syncer) AddPeer(peer p2p.Peer) {
@@ -139,6 +213,29 @@ func (s *syncer) RemovePeer(peer p2p.Peer) {
 	s.snapshots.RemovePeer(peer.ID())
 }
 
+// discoverSources fetches the snapshots of all HTTP snapshot sources and adds them to the pool.
+func (s *syncer) discoverSources() {
+	for _, source := range s.sources {
+		s.logger.Debug("Requesting snapshots from source", "source", source.ID())
+		snapshots, err := source.Snapshots(s.ctx)
+		if err != nil {
+			s.logger.Error("Failed to fetch snapshots from source", "source", source.ID(), "err", err)
+			continue
+		}
+		for _, snapshot := range snapshots {
+			added, err := s.snapshots.AddSource(source, snapshot)
+			if err != nil {
+				s.logger.Error("Failed to add snapshot", "source", source.ID(), "err", err)
+				continue
+			}
+			if added {
+				s.logger.Info("Discovered new snapshot", "height", snapshot.Height, "format", snapshot.Format,
+					"hash", snapshot.Hash, "source", source.ID())
+			}
+		}
+	}
+}
+
 // SyncAny tries to sync any of the snapshots in the snapshot pool, waiting to discover further
 // snapshots if none were found and discoveryTime > 0. It returns the latest state and block commit
 // which the caller must use to bootstrap the node.
@@ -147,6 +244,7 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		discoveryTime = 5 * minimumDiscoveryTime
 	}
 
+	s.discoverSources()
 	if discoveryTime > 0 {
 		s.logger.Info("sync any", "msg", log.NewLazySprintf("Discovering snapshots for %v", discoveryTime))
 		time.Sleep(discoveryTime)
@@ -159,10 +257,16 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		chunks   *chunkQueue
 		err      error
 	)
+	// If the node was restarted during a restoration, try the same snapshot first.
+	resumed := s.resumableSnapshot()
 	for {
 		// If not nil, we're going to retry restoration of the same snapshot.
 		if snapshot == nil {
-			snapshot = s.snapshots.Best()
+			if resumed != nil {
+				snapshot, resumed = resumed, nil
+			} else {
+				snapshot = s.snapshots.Best()
+			}
 			chunks = nil
 		}
 		if snapshot == nil {
@@ -170,12 +274,17 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 				return sm.State{}, nil, errNoSnapshots
 			}
 			retryHook()
+			s.discoverSources()
 			s.logger.Info("sync any", "msg", log.NewLazySprintf("Discovering snapshots for %v", discoveryTime))
 			time.Sleep(discoveryTime)
 			continue
 		}
 		if chunks == nil {
-			chunks, err = newChunkQueue(snapshot, s.tempDir)
+			if s.resumeDir != "" {
+				chunks, err = newPersistentChunkQueue(snapshot, s.resumeDir)
+			} else {
+				chunks, err = newChunkQueue(snapshot, s.tempDir)
+			}
 			if err != nil {
 				return sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
 			}
@@ -217,6 +326,10 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 				s.snapshots.RejectPeer(peer.ID())
 				s.logger.Info("Snapshot sender rejected", "peer", peer.ID())
 			}
+			for _, source := range s.snapshots.GetSources(snapshot) {
+				s.snapshots.RejectPeer(source.ID())
+				s.logger.Info("Snapshot source rejected", "source", source.ID())
+			}
 
 		case errors.Is(err, context.DeadlineExceeded):
 			s.logger.Info("Timed out validating snapshot, rejecting", "height", snapshot.Height, "err", err)
@@ -236,6 +349,24 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 	}
 }
 
+// resumableSnapshot returns the snapshot of a restoration interrupted by a restart, if any.
+func (s *syncer) resumableSnapshot() *snapshot {
+	if s.resumeDir == "" {
+		return nil
+	}
+	p, err := loadProgress(s.resumeDir)
+	if err != nil {
+		s.logger.Error("Failed to load state sync progress", "err", err)
+		return nil
+	}
+	if p == nil {
+		return nil
+	}
+	s.logger.Info("Found interrupted snapshot restoration", "height", p.Snapshot.Height,
+		"format", p.Snapshot.Format, "hash", p.Snapshot.Hash, "applied", len(p.Applied))
+	return p.Snapshot
+}
+
 // Sync executes a sync for a specific snapshot, returning the latest state and block commit which
 // the caller must use to bootstrap the node.
 func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.Commit, error) {
@@ -252,7 +383,7 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 		s.mtx.Unlock()
 	}()
 
-	hctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	hctx, cancel := context.WithTimeout(s.ctx, 30*time.Second)
 	defer cancel()
 
 	appHash, err := s.stateProvider.AppHash(hctx, snapshot.Height)
@@ -266,19 +397,24 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	snapshot.trustedAppHash = appHash
 
 	// Offer snapshot to ABCI app.
-	err = s.offerSnapshot(snapshot)
+	resume, err := s.offerSnapshot(snapshot)
 	if err != nil {
 		return sm.State{}, nil, err
 	}
+	if resume {
+		chunks.Resume()
+	} else if err := chunks.ResetApplied(); err != nil {
+		return sm.State{}, nil, err
+	}
 
 	// Spawn chunk fetchers. They will terminate when the chunk queue is closed or context cancelled.
-	fetchCtx, cancel := context.WithCancel(context.TODO())
+	fetchCtx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 	for i := int32(0); i < s.chunkFetchers; i++ {
 		go s.fetchChunks(fetchCtx, snapshot, chunks)
 	}
 
-	pctx, pcancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	pctx, pcancel := context.WithTimeout(s.ctx, 30*time.Second)
 	defer pcancel()
 
 	// Optimistically build new state, so we don't discover any light client failures at the end.
@@ -318,8 +454,9 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 }
 
 // offerSnapshot offers a snapshot to the app. It returns various errors depending on the app's
-// response, or nil if the snapshot was accepted.
-func (s *syncer) offerSnapshot(snapshot *snapshot) error {
+// response, or nil if the snapshot was accepted. resume is true if the app asked to resume a
+// previously interrupted restoration of the snapshot.
+func (s *syncer) offerSnapshot(snapshot *snapshot) (resume bool, err error) {
 	s.logger.Info("Offering snapshot to ABCI app", "height", snapshot.Height,
 		"format", snapshot.Format, "hash", snapshot.Hash)
 	resp, err := s.conn.OfferSnapshotSync(abci.RequestOfferSnapshot{
@@ -333,23 +470,27 @@ func (s *syncer) offerSnapshot(snapshot *snapshot) error {
 		AppHash: snapshot.trustedAppHash,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to offer snapshot: %w", err)
+		return false, fmt.Errorf("failed to offer snapshot: %w", err)
 	}
 	switch resp.Result {
 	case abci.ResponseOfferSnapshot_ACCEPT:
 		s.logger.Info("Snapshot accepted, restoring", "height", snapshot.Height,
 			"format", snapshot.Format, "hash", snapshot.Hash)
-		return nil
+		return false, nil
+	case abci.ResponseOfferSnapshot_RESUME:
+		s.logger.Info("Snapshot accepted, resuming restoration", "height", snapshot.Height,
+			"format", snapshot.Format, "hash", snapshot.Hash)
+		return true, nil
 	case abci.ResponseOfferSnapshot_ABORT:
-		return errAbort
+		return false, errAbort
 	case abci.ResponseOfferSnapshot_REJECT:
-		return errRejectSnapshot
+		return false, errRejectSnapshot
 	case abci.ResponseOfferSnapshot_REJECT_FORMAT:
-		return errRejectFormat
+		return false, errRejectFormat
 	case abci.ResponseOfferSnapshot_REJECT_SENDER:
-		return errRejectSender
+		return false, errRejectSender
 	default:
-		return fmt.Errorf("unknown ResponseOfferSnapshot result %v", resp.Result)
+		return false, fmt.Errorf("unknown ResponseOfferSnapshot result %v", resp.Result)
 	}
 }
 
@@ -386,6 +527,7 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 		// Reject any senders as requested by the app
 		for _, sender := range resp.RejectSenders {
 			if sender != "" {
+				s.report(behaviour.RejectedMessage(p2p.ID(sender), "snapshot chunk sender rejected by the application"))
 				s.snapshots.RejectPeer(p2p.ID(sender))
 				err := chunks.DiscardSender(p2p.ID(sender))
 				if err != nil {
@@ -396,6 +538,10 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 
 		switch resp.Result {
 		case abci.ResponseApplySnapshotChunk_ACCEPT:
+			s.report(behaviour.SnapshotChunk(chunk.Sender, "snapshot chunk accepted by the application"))
+			if err := chunks.MarkApplied(chunk.Index); err != nil {
+				return err
+			}
 		case abci.ResponseApplySnapshotChunk_ABORT:
 			return errAbort
 		case abci.ResponseApplySnapshotChunk_RETRY:
@@ -410,6 +556,14 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 	}
 }
 
+// report reports the behaviour of a peer. Peers may disconnect at any time, so
+// errors are only logged.
+func (s *syncer) report(pb behaviour.PeerBehaviour) {
+	if err := s.reporter.Report(pb); err != nil {
+		s.logger.Debug("Failed to report peer behaviour", "err", err)
+	}
+}
+
 // fetchChunks requests chunks from peers, receiving allocations from the chunk queue. Chunks
 // will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
@@ -441,6 +595,20 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
 			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
 
+		// Prefer HTTP snapshot sources, falling back to peers if the download fails.
+		if source := s.snapshots.GetSource(snapshot); source != nil {
+			err := s.fetchChunkFromSource(ctx, source, snapshot, index, chunks)
+			if err == nil {
+				next = true
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			s.logger.Error("Failed to fetch snapshot chunk from source", "height", snapshot.Height,
+				"format", snapshot.Format, "chunk", index, "source", source.ID(), "err", err)
+		}
+
 		ticker := time.NewTicker(s.retryTimeout)
 		defer ticker.Stop()
 
@@ -461,6 +629,20 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 	}
 }
 
+// fetchChunkFromSource downloads a chunk from an HTTP snapshot source and adds it to the queue.
+func (s *syncer) fetchChunkFromSource(ctx context.Context, source *httpSnapshotSource, snapshot *snapshot,
+	index uint32, chunks *chunkQueue,
+) error {
+	s.logger.Debug("Downloading snapshot chunk", "height", snapshot.Height,
+		"format", snapshot.Format, "chunk", index, "source", source.ID())
+	chunk, err := source.Chunk(ctx, snapshot.Height, snapshot.Format, index)
+	if err != nil {
+		return err
+	}
+	_, err = chunks.Add(chunk)
+	return err
+}
+
 // requestChunk requests a chunk from a peer.
 func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 	peer := s.snapshots.GetPeer(snapshot)
@@ -2,6 +2,8 @@ package statesync
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/KYVENetwork/celestia-core/abci/types"
+	"github.com/KYVENetwork/celestia-core/behaviour"
 	"github.com/KYVENetwork/celestia-core/config"
 	"github.com/KYVENetwork/celestia-core/libs/log"
 	cmtsync "github.com/KYVENetwork/celestia-core/libs/sync"
@@ -35,7 +38,7 @@ func setupOfferSyncer(t *testing.T) (*syncer, *proxymocks.AppConnSnapshot) {
 	stateProvider := &mocks.StateProvider{}
 	stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "")
 
 	return syncer, connSnapshot
 }
@@ -89,7 +92,7 @@ func TestSyncer_SyncAny(t *testing.T) {
 	connQuery := &proxymocks.AppConnQuery{}
 
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "")
 
 	// Adding a chunk should error when no sync is in progress
 	_, err := syncer.AddChunk(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{1}})
@@ -231,6 +234,123 @@ func TestSyncer_SyncAny(t *testing.T) {
 	peerB.AssertExpectations(t)
 }
 
+func TestSyncer_SyncAny_httpSource(t *testing.T) {
+	state := sm.State{
+		ChainID: "chain",
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   testAppVersion,
+			},
+		},
+		LastBlockHeight: 1,
+		AppHash:         []byte("app_hash"),
+	}
+	commit := &types.Commit{BlockID: types.BlockID{Hash: []byte("blockhash")}}
+
+	srv, _ := newTestSnapshotServer(t,
+		`[{"height":1,"format":1,"chunks":2,"hash":"010203"}]`,
+		map[string][]byte{"1/1/0": {1, 1, 0}, "1/1/1": {1, 1, 1}})
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, uint64(1)).Return(state.AppHash, nil)
+	stateProvider.On("Commit", mock.Anything, uint64(1)).Return(commit, nil)
+	stateProvider.On("State", mock.Anything, uint64(1)).Return(state, nil)
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	connQuery := &proxymocks.AppConnQuery{}
+
+	cfg := config.DefaultStateSyncConfig()
+	cfg.SnapshotHTTPServers = []string{srv.URL}
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery,
+		stateProvider, t.TempDir())
+
+	connSnapshot.On("OfferSnapshotSync", abci.RequestOfferSnapshot{
+		Snapshot: &abci.Snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1, 2, 3}},
+		AppHash:  []byte("app_hash"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, nil)
+	connSnapshot.On("ApplySnapshotChunkSync", abci.RequestApplySnapshotChunk{
+		Index: 0, Chunk: []byte{1, 1, 0}, Sender: srv.URL,
+	}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+	connSnapshot.On("ApplySnapshotChunkSync", abci.RequestApplySnapshotChunk{
+		Index: 1, Chunk: []byte{1, 1, 1}, Sender: srv.URL,
+	}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+	connQuery.On("InfoSync", proxy.RequestInfo).Return(&abci.ResponseInfo{
+		AppVersion:       testAppVersion,
+		LastBlockHeight:  1,
+		LastBlockAppHash: []byte("app_hash"),
+	}, nil)
+
+	newState, lastCommit, err := syncer.SyncAny(0, func() {})
+	require.NoError(t, err)
+	assert.Equal(t, state, newState)
+	assert.Equal(t, commit, lastCommit)
+
+	connSnapshot.AssertExpectations(t)
+	connQuery.AssertExpectations(t)
+}
+
+func TestSyncer_SyncAny_resume(t *testing.T) {
+	state := sm.State{
+		ChainID: "chain",
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   testAppVersion,
+			},
+		},
+		LastBlockHeight: 1,
+		AppHash:         []byte("app_hash"),
+	}
+	commit := &types.Commit{BlockID: types.BlockID{Hash: []byte("blockhash")}}
+	s := &snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1, 2, 3}}
+
+	// The node was restarted after fetching both chunks and applying the first one
+	dir := filepath.Join(t.TempDir(), "statesync")
+	queue, err := newPersistentChunkQueue(s, dir)
+	require.NoError(t, err)
+	for i := uint32(0); i < s.Chunks; i++ {
+		_, err = queue.Add(&chunk{Height: 1, Format: 1, Index: i, Chunk: []byte{1, 1, byte(i)}})
+		require.NoError(t, err)
+	}
+	require.NoError(t, queue.MarkApplied(0))
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, uint64(1)).Return(state.AppHash, nil)
+	stateProvider.On("Commit", mock.Anything, uint64(1)).Return(commit, nil)
+	stateProvider.On("State", mock.Anything, uint64(1)).Return(state, nil)
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	connQuery := &proxymocks.AppConnQuery{}
+
+	cfg := config.DefaultStateSyncConfig()
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery,
+		stateProvider, "")
+	syncer.resumeDir = dir
+
+	connSnapshot.On("OfferSnapshotSync", abci.RequestOfferSnapshot{
+		Snapshot: toABCI(s), AppHash: []byte("app_hash"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_RESUME}, nil)
+	connSnapshot.On("ApplySnapshotChunkSync", abci.RequestApplySnapshotChunk{
+		Index: 1, Chunk: []byte{1, 1, 1},
+	}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+	connQuery.On("InfoSync", proxy.RequestInfo).Return(&abci.ResponseInfo{
+		AppVersion:       testAppVersion,
+		LastBlockHeight:  1,
+		LastBlockAppHash: []byte("app_hash"),
+	}, nil)
+
+	newState, lastCommit, err := syncer.SyncAny(0, func() {})
+	require.NoError(t, err)
+	assert.Equal(t, state, newState)
+	assert.Equal(t, commit, lastCommit)
+
+	connSnapshot.AssertExpectations(t)
+	connQuery.AssertExpectations(t)
+
+	// The progress is removed once the restoration completed
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestSyncer_SyncAny_noSnapshots(t *testing.T) {
 	syncer, _ := setupOfferSyncer(t)
 	_, _, err := syncer.SyncAny(0, func() {})
@@ -364,23 +484,63 @@ func TestSyncer_SyncAny_abciError(t *testing.T) {
 	connSnapshot.AssertExpectations(t)
 }
 
+func TestSyncer_FetchNodeSnapshot(t *testing.T) {
+	reporter := behaviour.NewMockReporter()
+	cfg := config.DefaultStateSyncConfig()
+	syncer := newSyncer(*cfg, log.NewNopLogger(), reporter, &proxymocks.AppConnSnapshot{},
+		&proxymocks.AppConnQuery{}, &mocks.StateProvider{}, "")
+
+	ns, commit := makeNodeSnapshot(t, 1, 3)
+	stateStore, blockStore := makeNodeSnapshotStores(t, ns)
+	msg, err := loadNodeSnapshot(stateStore, blockStore, 3)
+	require.NoError(t, err)
+	other, _ := makeNodeSnapshot(t, 1, 3)
+	otherStateStore, otherBlockStore := makeNodeSnapshotStores(t, other)
+	otherMsg, err := loadNodeSnapshot(otherStateStore, otherBlockStore, 3)
+	require.NoError(t, err)
+	earlierMsg, err := loadNodeSnapshot(stateStore, blockStore, 2)
+	require.NoError(t, err)
+
+	// Snapshots at other heights and missing snapshots are skipped, and invalid ones are reported.
+	syncer.AddNodeSnapshot(simplePeer("earlier"), earlierMsg)
+	syncer.AddNodeSnapshot(simplePeer("missing"), &ssproto.NodeSnapshotResponse{Height: 3})
+	syncer.AddNodeSnapshot(simplePeer("invalid"), otherMsg)
+	syncer.AddNodeSnapshot(simplePeer("valid"), msg)
+
+	fetched := syncer.FetchNodeSnapshot(testChainID, commit, time.Second)
+	require.NotNil(t, fetched)
+	assert.EqualValues(t, 3, fetched.Height())
+	assert.Len(t, fetched.BlockMetas, 3)
+	assert.Same(t, commit, fetched.Commits[2])
+
+	assert.Empty(t, reporter.GetBehaviours("earlier"))
+	assert.Empty(t, reporter.GetBehaviours("missing"))
+	assert.Len(t, reporter.GetBehaviours("invalid"), 1)
+	assert.Empty(t, reporter.GetBehaviours("valid"))
+
+	// Without further snapshots, it times out.
+	assert.Nil(t, syncer.FetchNodeSnapshot(testChainID, commit, 10*time.Millisecond))
+}
+
 func TestSyncer_offerSnapshot(t *testing.T) {
 	unknownErr := errors.New("unknown error")
 	boom := errors.New("boom")
 
 	testcases := map[string]struct {
-		result    abci.ResponseOfferSnapshot_Result
-		err       error
-		expectErr error
+		result       abci.ResponseOfferSnapshot_Result
+		err          error
+		expectErr    error
+		expectResume bool
 	}{
-		"accept":           {abci.ResponseOfferSnapshot_ACCEPT, nil, nil},
-		"abort":            {abci.ResponseOfferSnapshot_ABORT, nil, errAbort},
-		"reject":           {abci.ResponseOfferSnapshot_REJECT, nil, errRejectSnapshot},
-		"reject_format":    {abci.ResponseOfferSnapshot_REJECT_FORMAT, nil, errRejectFormat},
-		"reject_sender":    {abci.ResponseOfferSnapshot_REJECT_SENDER, nil, errRejectSender},
-		"unknown":          {abci.ResponseOfferSnapshot_UNKNOWN, nil, unknownErr},
-		"error":            {0, boom, boom},
-		"unknown non-zero": {9, nil, unknownErr},
+		"accept":           {abci.ResponseOfferSnapshot_ACCEPT, nil, nil, false},
+		"resume":           {abci.ResponseOfferSnapshot_RESUME, nil, nil, true},
+		"abort":            {abci.ResponseOfferSnapshot_ABORT, nil, errAbort, false},
+		"reject":           {abci.ResponseOfferSnapshot_REJECT, nil, errRejectSnapshot, false},
+		"reject_format":    {abci.ResponseOfferSnapshot_REJECT_FORMAT, nil, errRejectFormat, false},
+		"reject_sender":    {abci.ResponseOfferSnapshot_REJECT_SENDER, nil, errRejectSender, false},
+		"unknown":          {abci.ResponseOfferSnapshot_UNKNOWN, nil, unknownErr, false},
+		"error":            {0, boom, boom, false},
+		"unknown non-zero": {9, nil, unknownErr, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -391,7 +551,8 @@ func TestSyncer_offerSnapshot(t *testing.T) {
 				Snapshot: toABCI(s),
 				AppHash:  []byte("app_hash"),
 			}).Return(&abci.ResponseOfferSnapshot{Result: tc.result}, tc.err)
-			err := syncer.offerSnapshot(s)
+			resume, err := syncer.offerSnapshot(s)
+			assert.Equal(t, tc.expectResume, resume)
 			if tc.expectErr == unknownErr {
 				require.Error(t, err)
 			} else {
@@ -432,7 +593,7 @@ func TestSyncer_applyChunks_Results(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "")
 
 			body := []byte{1, 2, 3}
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 1}, "")
@@ -485,7 +646,7 @@ func TestSyncer_applyChunks_RefetchChunks(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "")
 
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 3}, "")
 			require.NoError(t, err)
@@ -550,7 +711,7 @@ func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "")
 
 			// Set up three peers across two snapshots, and ask for one of them to be banned.
 			// It should be banned from all snapshots.
@@ -672,7 +833,7 @@ func TestSyncer_verifyApp(t *testing.T) {
 			stateProvider := &mocks.StateProvider{}
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "")
 
 			connQuery.On("InfoSync", proxy.RequestInfo).Return(tc.response, tc.err)
 			err := syncer.verifyApp(s, appVersion)
@@ -264,6 +264,31 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return commit
 }
 
+// LoadBlockExtendedCommit returns the ExtendedCommit for the given height.
+// The extended commit is not guaranteed to contain the same +2/3 precommits
+// data as the commit in the block, and is only stored for heights at which
+// vote extensions were enabled.
+func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	var pbec = new(cmtproto.ExtendedCommit)
+	bz, err := bs.db.Get(calcExtCommitKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	err = proto.Unmarshal(bz, pbec)
+	if err != nil {
+		panic(fmt.Sprintf("error reading block extended commit: %v", err))
+	}
+
+	extCommit, err := types.ExtendedCommitFromProto(pbec)
+	if err != nil {
+		panic(fmt.Errorf("error from proto extended commit: %w", err))
+	}
+	return extCommit
+}
+
 // PruneBlocks removes block up to (but not including) a height. It returns number of blocks pruned.
 func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if height <= 0 {
@@ -322,6 +347,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcExtCommitKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -344,6 +372,57 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
+
+	bootstrapped, err := bs.pruneBootstrapped(height)
+	if err != nil {
+		return 0, err
+	}
+	return pruned + bootstrapped, nil
+}
+
+// pruneBootstrapped removes the bootstrapped block metas and commits below the given height,
+// and returns the number of heights pruned.
+func (bs *BlockStore) pruneBootstrapped(height int64) (uint64, error) {
+	bss := loadBootstrapState(bs.db)
+	if bss.Height == 0 || bss.Base >= height {
+		return 0, nil
+	}
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	pruned := uint64(0)
+	for h := bss.Base; h < height && h <= bss.Height; h++ {
+		if meta := bs.LoadBlockMeta(h); meta != nil {
+			if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+				return 0, err
+			}
+		}
+		if err := batch.Delete(calcBlockMetaKey(h)); err != nil {
+			return 0, err
+		}
+		if err := batch.Delete(calcBlockCommitKey(h)); err != nil {
+			return 0, err
+		}
+		pruned++
+	}
+
+	if height > bss.Height {
+		if err := batch.Delete(bootstrapKey); err != nil {
+			return 0, err
+		}
+	} else {
+		bss.Base = height
+		bz, err := proto.Marshal(&bss)
+		if err != nil {
+			return 0, err
+		}
+		if err := batch.Set(bootstrapKey, bz); err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, fmt.Errorf("failed to prune bootstrapped blocks up to height %v: %w", height, err)
+	}
 	return pruned, nil
 }
 
@@ -424,6 +503,34 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	bs.saveState()
 }
 
+// SaveBlockWithExtendedCommit persists the given block, blockParts, and
+// extended commit to the underlying db. The seen commit is derived from the
+// extended commit, which is stored separately so that the proposer of the next
+// height can hand the vote extensions to the application even after a
+// restart. Nothing is saved, and an error is returned, if a precommit for
+// the block lacks its extension signature.
+func (bs *BlockStore) SaveBlockWithExtendedCommit(
+	block *types.Block,
+	blockParts *types.PartSet,
+	seenExtendedCommit *types.ExtendedCommit,
+) error {
+	if block == nil {
+		panic("BlockStore can only save a non-nil block")
+	}
+	if err := seenExtendedCommit.EnsureExtensions(); err != nil {
+		return fmt.Errorf("saving block with extensions: %w", err)
+	}
+
+	pbec := seenExtendedCommit.ToProto()
+	extCommitBytes := mustEncode(pbec)
+	if err := bs.db.Set(calcExtCommitKey(block.Height), extCommitBytes); err != nil {
+		panic(err)
+	}
+
+	bs.SaveBlock(block, blockParts, seenExtendedCommit.ToCommit())
+	return nil
+}
+
 func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
 	pbp, err := part.ToProto()
 	if err != nil {
@@ -455,6 +562,58 @@ func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) err
 	return bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
 }
 
+// Bootstrap bootstraps an empty block store with the metas and commits of a contiguous range of
+// recent blocks, e.g. obtained through state sync, so that LoadBlockMeta and LoadBlockCommit
+// return them. The block parts are not stored, so the store's base and height are left unset:
+// they only cover the heights of full blocks, which the node serves to its peers, and the node
+// starts fast sync or consensus right after the bootstrapped blocks. The bootstrapped range is
+// recorded separately, so that PruneBlocks removes it along with the full blocks.
+func (bs *BlockStore) Bootstrap(metas []*types.BlockMeta, commits []*types.Commit) error {
+	if len(metas) == 0 || len(metas) != len(commits) {
+		return fmt.Errorf("expected the same non-zero number of block metas and commits, got %v and %v",
+			len(metas), len(commits))
+	}
+	if bs.Height() != 0 {
+		return fmt.Errorf("cannot bootstrap a block store with height %v", bs.Height())
+	}
+	if bss := loadBootstrapState(bs.db); bss.Height != 0 {
+		return fmt.Errorf("block store is already bootstrapped up to height %v", bss.Height)
+	}
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	for i, meta := range metas {
+		height := meta.Header.Height
+		if i > 0 && height != metas[i-1].Header.Height+1 {
+			return fmt.Errorf("block meta at height %v is not contiguous", height)
+		}
+		if commits[i].Height != height {
+			return fmt.Errorf("commit at height %v does not match block meta at height %v",
+				commits[i].Height, height)
+		}
+		if err := batch.Set(calcBlockMetaKey(height), mustEncode(meta.ToProto())); err != nil {
+			return err
+		}
+		if err := batch.Set(calcBlockHashKey(meta.BlockID.Hash), []byte(fmt.Sprintf("%d", height))); err != nil {
+			return err
+		}
+		if err := batch.Set(calcBlockCommitKey(height), mustEncode(commits[i].ToProto())); err != nil {
+			return err
+		}
+	}
+	bz, err := proto.Marshal(&cmtstore.BlockStoreState{
+		Base:   metas[0].Header.Height,
+		Height: metas[len(metas)-1].Header.Height,
+	})
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(bootstrapKey, bz); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
 // SaveTxInfo gets Tx hashes from the block converts them to TxInfo and persists them to the db.
 func (bs *BlockStore) SaveTxInfo(block *types.Block) error {
 	// Create a new batch
@@ -501,6 +660,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcExtCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("EC:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
@@ -511,7 +674,10 @@ func calcTxHashKey(hash []byte) []byte {
 
 //-----------------------------------------------------------------------------
 
-var blockStoreKey = []byte("blockStore")
+var (
+	blockStoreKey = []byte("blockStore")
+	bootstrapKey  = []byte("blockStoreBootstrap")
+)
 
 // SaveBlockStoreState persists the blockStore state to the database.
 func SaveBlockStoreState(bsj *cmtstore.BlockStoreState, db dbm.DB) {
@@ -551,6 +717,23 @@ func LoadBlockStoreState(db dbm.DB) cmtstore.BlockStoreState {
 	return bsj
 }
 
+// loadBootstrapState returns the range of block metas and commits written by Bootstrap that
+// haven't been pruned yet, or the zero value if there are none.
+func loadBootstrapState(db dbm.DB) cmtstore.BlockStoreState {
+	bz, err := db.Get(bootstrapKey)
+	if err != nil {
+		panic(err)
+	}
+	var bss cmtstore.BlockStoreState
+	if len(bz) == 0 {
+		return bss
+	}
+	if err := proto.Unmarshal(bz, &bss); err != nil {
+		panic(fmt.Sprintf("Could not unmarshal bytes: %X", bz))
+	}
+	return bss
+}
+
 // LoadTxInfo loads the TxInfo from disk given its hash.
 func (bs *BlockStore) LoadTxInfo(txHash []byte) *cmtstore.TxInfo {
 	bz, err := bs.db.Get(calcTxHashKey(txHash))
@@ -607,6 +790,9 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 	if err := batch.Delete(calcSeenCommitKey(targetHeight)); err != nil {
 		return err
 	}
+	if err := batch.Delete(calcExtCommitKey(targetHeight)); err != nil {
+		return err
+	}
 	// delete last, so as to not leave keys built on meta.BlockID dangling
 	if err := batch.Delete(calcBlockMetaKey(targetHeight)); err != nil {
 		return err
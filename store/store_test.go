@@ -61,6 +61,7 @@ func makeBlock(height int64, state sm.State, lastCommit *types.Commit) *types.Bl
 		lastCommit,
 		nil,
 		state.Validators.GetProposer().Address,
+		cmttime.Now(),
 	)
 	return block
 }
@@ -624,6 +625,62 @@ func TestPruneBlocksPrunesTxs(t *testing.T) {
 	}
 }
 
+func TestBlockStoreBootstrap(t *testing.T) {
+	bs, _ := freshBlockStore()
+	metas := make([]*types.BlockMeta, 0, 3)
+	commits := make([]*types.Commit, 0, 3)
+	for height := int64(5); height <= 7; height++ {
+		block := makeBlock(height, state, new(types.Commit))
+		metas = append(metas, types.NewBlockMeta(block, block.MakePartSet(2)))
+		commits = append(commits, makeTestCommit(height, cmttime.Now()))
+	}
+
+	require.Error(t, bs.Bootstrap(metas, commits[:2]))
+	require.Error(t, bs.Bootstrap([]*types.BlockMeta{metas[0], metas[2]}, []*types.Commit{commits[0], commits[2]}))
+
+	require.NoError(t, bs.Bootstrap(metas, commits))
+	require.Error(t, bs.Bootstrap(metas, commits))
+	for i, meta := range metas {
+		height := meta.Header.Height
+		assert.Equal(t, meta.BlockID, bs.LoadBlockMeta(height).BlockID)
+		assert.Equal(t, meta.BlockID, bs.LoadBlockMetaByHash(meta.BlockID.Hash).BlockID)
+		assert.Equal(t, commits[i].Hash(), bs.LoadBlockCommit(height).Hash())
+		assert.Nil(t, bs.LoadBlock(height))
+	}
+
+	// the base and height only cover full blocks, so that they aren't
+	// advertised to the peers
+	assert.EqualValues(t, 0, bs.Base())
+	assert.EqualValues(t, 0, bs.Height())
+	bss := LoadBlockStoreState(bs.db)
+	assert.EqualValues(t, 0, bss.Base)
+	assert.EqualValues(t, 0, bss.Height)
+
+	// the first full block is saved right after the bootstrapped blocks
+	block := makeBlock(8, state, makeTestCommit(7, cmttime.Now()))
+	partSet := block.MakePartSet(2)
+	bs.SaveBlock(block, partSet, makeTestCommit(8, cmttime.Now()))
+	assert.EqualValues(t, 8, bs.Base())
+	assert.EqualValues(t, 8, bs.Height())
+	assert.Equal(t, metas[2].BlockID, bs.LoadBlockMeta(7).BlockID)
+
+	// a non-empty store can't be bootstrapped
+	require.Error(t, bs.Bootstrap(metas, commits))
+
+	// the bootstrapped blocks are pruned along with the full blocks
+	pruned, err := bs.PruneBlocks(8)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+	for _, meta := range metas {
+		assert.Nil(t, bs.LoadBlockMeta(meta.Header.Height))
+		assert.Nil(t, bs.LoadBlockMetaByHash(meta.BlockID.Hash))
+	}
+	assert.Nil(t, bs.LoadBlockCommit(5))
+	assert.Zero(t, loadBootstrapState(bs.db).Height)
+	assert.EqualValues(t, 8, bs.Base())
+	assert.NotNil(t, bs.LoadBlock(8))
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
@@ -673,7 +730,7 @@ func TestLoadBlockMetaByHash(t *testing.T) {
 	require.NoError(t, err)
 	bs := NewBlockStore(dbm.NewMemDB())
 
-	b1, partSet := state.MakeBlock(state.LastBlockHeight+1, factory.MakeTxs(state.LastBlockHeight+1, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+	b1, partSet := state.MakeBlock(state.LastBlockHeight+1, factory.MakeTxs(state.LastBlockHeight+1, 10), new(types.Commit), nil, state.Validators.GetProposer().Address, cmttime.Now())
 	seenCommit := makeTestCommit(1, cmttime.Now())
 	bs.SaveBlock(b1, partSet, seenCommit)
 
@@ -711,6 +768,41 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestSaveBlockWithExtendedCommit(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	block := makeBlock(bs.Height()+1, state, new(types.Commit))
+	partSet := block.MakePartSet(2)
+
+	seenCommit := makeTestCommit(block.Height, cmttime.Now())
+	extCommit := &types.ExtendedCommit{
+		Height:  seenCommit.Height,
+		Round:   seenCommit.Round,
+		BlockID: types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()},
+		ExtendedSignatures: []types.ExtendedCommitSig{{
+			CommitSig:          seenCommit.Signatures[0],
+			Extension:          []byte("extension"),
+			ExtensionSignature: []byte("extension signature"),
+		}},
+	}
+
+	// extended commits must carry extension signatures
+	extCommit.ExtendedSignatures[0].ExtensionSignature = nil
+	require.Error(t, bs.SaveBlockWithExtendedCommit(block, partSet, extCommit))
+	require.Equal(t, block.Height-1, bs.Height())
+	require.Nil(t, bs.LoadBlockExtendedCommit(block.Height))
+	extCommit.ExtendedSignatures[0].ExtensionSignature = []byte("extension signature")
+
+	require.NoError(t, bs.SaveBlockWithExtendedCommit(block, partSet, extCommit))
+	require.Equal(t, block.Height, bs.Height())
+	require.Equal(t, extCommit, bs.LoadBlockExtendedCommit(block.Height))
+	require.Equal(t, extCommit.ToCommit(), bs.LoadSeenCommit(block.Height))
+	require.Nil(t, bs.LoadBlockExtendedCommit(block.Height+1))
+
+	require.NoError(t, bs.DeleteLatestBlock())
+	require.Nil(t, bs.LoadBlockExtendedCommit(block.Height))
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
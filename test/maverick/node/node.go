@@ -704,7 +704,7 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 		stateProvider, err = statesync.NewLightClientStateProvider(
 			ctx,
 			state.ChainID, state.Version, state.InitialHeight,
-			config.RPCServers, light.TrustOptions{
+			config.RPCServers, config.GRPCServers, light.TrustOptions{
 				Period: config.TrustPeriod,
 				Height: config.TrustHeight,
 				Hash:   config.TrustHashBytes(),
@@ -715,7 +715,7 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 	}
 
 	go func() {
-		state, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
+		state, commit, nodeSnapshot, err := ssR.Sync(stateProvider, config.DiscoveryTime)
 		if err != nil {
 			ssR.Logger.Error("State sync failed", "err", err)
 			return
@@ -730,6 +730,20 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 			ssR.Logger.Error("Failed to store last seen commit", "err", err)
 			return
 		}
+		if nodeSnapshot != nil {
+			err = blockStore.Bootstrap(nodeSnapshot.BlockMetas, nodeSnapshot.Commits)
+			if err != nil {
+				ssR.Logger.Error("Failed to bootstrap block store with node snapshot", "err", err)
+				return
+			}
+			for i, vals := range nodeSnapshot.ValidatorSets {
+				height := nodeSnapshot.BlockMetas[i].Header.Height
+				if err = stateStore.SaveValidatorSets(height, height, vals); err != nil {
+					ssR.Logger.Error("Failed to store validator sets of node snapshot", "err", err)
+					return
+				}
+			}
+		}
 
 		if fastSync {
 			// FIXME Very ugly to have these metrics bleed through here.
@@ -886,6 +900,7 @@ func NewNode(config *cfg.Config,
 		proxyApp.Query(),
 		config.StateSync.TempDir,
 	)
+	stateSyncReactor.SetStores(stateStore, blockStore)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
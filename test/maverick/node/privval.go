@@ -246,7 +246,7 @@ func (pv *FilePV) GetPubKey() (crypto.PubKey, error) {
 
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
-func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote) error {
+func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
 	if err := pv.signVote(chainID, vote); err != nil {
 		return fmt.Errorf("error signing vote: %v", err)
 	}
@@ -315,6 +315,14 @@ func (pv *FilePV) signVote(chainID string, vote *cmtproto.Vote) error {
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
+
+	if vote.Type == cmtproto.PrecommitType && len(vote.BlockID.Hash) != 0 {
+		extSig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -1005,6 +1005,302 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 	return commit, commit.ValidateBasic()
 }
 
+//-------------------------------------
+
+// ExtendedCommitSig contains a CommitSig along with the vote extension of the
+// precommit and its signature.
+type ExtendedCommitSig struct {
+	CommitSig                 // Commit signature
+	Extension          []byte `json:"extension"`           // Vote extension
+	ExtensionSignature []byte `json:"extension_signature"` // Vote extension signature
+}
+
+// NewExtendedCommitSigAbsent returns new ExtendedCommitSig with
+// BlockIDFlagAbsent.
+func NewExtendedCommitSigAbsent() ExtendedCommitSig {
+	return ExtendedCommitSig{CommitSig: NewCommitSigAbsent()}
+}
+
+// ValidateBasic performs basic validation of the CommitSig and checks that
+// only signatures for the block carry a vote extension.
+func (ecs ExtendedCommitSig) ValidateBasic() error {
+	if err := ecs.CommitSig.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if ecs.BlockIDFlag == BlockIDFlagCommit {
+		if len(ecs.Extension) > MaxVoteExtensionSize {
+			return fmt.Errorf("vote extension is too big (max: %d)", MaxVoteExtensionSize)
+		}
+		if len(ecs.ExtensionSignature) > MaxSignatureSize {
+			return fmt.Errorf("vote extension signature is too big (max: %d)", MaxSignatureSize)
+		}
+		return nil
+	}
+
+	if len(ecs.Extension) > 0 || len(ecs.ExtensionSignature) > 0 {
+		return errors.New("vote extension is only allowed for signatures for the block")
+	}
+	return nil
+}
+
+// ToProto converts ExtendedCommitSig to protobuf
+func (ecs *ExtendedCommitSig) ToProto() *cmtproto.ExtendedCommitSig {
+	if ecs == nil {
+		return nil
+	}
+
+	return &cmtproto.ExtendedCommitSig{
+		BlockIdFlag:        cmtproto.BlockIDFlag(ecs.BlockIDFlag),
+		ValidatorAddress:   ecs.ValidatorAddress,
+		Timestamp:          ecs.Timestamp,
+		Signature:          ecs.Signature,
+		Extension:          ecs.Extension,
+		ExtensionSignature: ecs.ExtensionSignature,
+	}
+}
+
+// FromProto sets a protobuf ExtendedCommitSig to the given pointer.
+// It returns an error if the ExtendedCommitSig is invalid.
+func (ecs *ExtendedCommitSig) FromProto(ecsp cmtproto.ExtendedCommitSig) error {
+	ecs.BlockIDFlag = BlockIDFlag(ecsp.BlockIdFlag)
+	ecs.ValidatorAddress = ecsp.ValidatorAddress
+	ecs.Timestamp = ecsp.Timestamp
+	ecs.Signature = ecsp.Signature
+	ecs.Extension = ecsp.Extension
+	ecs.ExtensionSignature = ecsp.ExtensionSignature
+
+	return ecs.ValidateBasic()
+}
+
+// ExtendedCommit is a Commit whose signatures carry the vote extensions of the
+// precommits. It is built from the precommits seen locally and stored for the
+// proposer of the next height, which passes the extensions to the application
+// in PrepareProposal. It is never part of a block.
+type ExtendedCommit struct {
+	Height             int64               `json:"height"`
+	Round              int32               `json:"round"`
+	BlockID            BlockID             `json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `json:"extended_signatures"`
+
+	bitArray *bits.BitArray
+}
+
+// ToCommit converts the ExtendedCommit to a Commit by dropping the vote
+// extensions.
+func (ec *ExtendedCommit) ToCommit() *Commit {
+	var sigs []CommitSig
+	for _, ecs := range ec.ExtendedSignatures {
+		sigs = append(sigs, ecs.CommitSig)
+	}
+	return NewCommit(ec.Height, ec.Round, ec.BlockID, sigs)
+}
+
+// ToExtendedVoteSet constructs a VoteSet, whose votes carry the vote
+// extensions, from the ExtendedCommit and validator set.
+// Panics if signatures from the commit can't be added to the voteset.
+// Inverse of VoteSet.MakeExtendedCommit().
+func (ec *ExtendedCommit) ToExtendedVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
+	voteSet := NewVoteSet(chainID, ec.Height, ec.Round, cmtproto.PrecommitType, vals)
+	for idx, ecs := range ec.ExtendedSignatures {
+		if ecs.Absent() {
+			continue // OK, some precommits can be missing.
+		}
+		added, err := voteSet.AddVote(ec.GetExtendedVote(int32(idx)))
+		if !added || err != nil {
+			panic(fmt.Sprintf("Failed to reconstruct vote set from extended commit: %v", err))
+		}
+	}
+	return voteSet
+}
+
+// GetExtendedVote converts the ExtendedCommitSig for the given valIdx to a
+// Vote carrying the vote extension.
+// Panics if valIdx >= ec.Size().
+func (ec *ExtendedCommit) GetExtendedVote(valIdx int32) *Vote {
+	ecs := ec.ExtendedSignatures[valIdx]
+	return &Vote{
+		Type:               cmtproto.PrecommitType,
+		Height:             ec.Height,
+		Round:              ec.Round,
+		BlockID:            ecs.BlockID(ec.BlockID),
+		Timestamp:          ecs.Timestamp,
+		ValidatorAddress:   ecs.ValidatorAddress,
+		ValidatorIndex:     valIdx,
+		Signature:          ecs.Signature,
+		Extension:          ecs.Extension,
+		ExtensionSignature: ecs.ExtensionSignature,
+	}
+}
+
+// Type returns the vote type of the extended commit, which is always
+// VoteTypePrecommit
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) Type() byte {
+	return byte(cmtproto.PrecommitType)
+}
+
+// GetHeight returns height of the extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) GetHeight() int64 {
+	return ec.Height
+}
+
+// GetRound returns round of the extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) GetRound() int32 {
+	return ec.Round
+}
+
+// Size returns the number of signatures in the extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) Size() int {
+	if ec == nil {
+		return 0
+	}
+	return len(ec.ExtendedSignatures)
+}
+
+// BitArray returns a BitArray of which validators voted for BlockID or nil in
+// this extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) BitArray() *bits.BitArray {
+	if ec.bitArray == nil {
+		ec.bitArray = bits.NewBitArray(len(ec.ExtendedSignatures))
+		for i, ecs := range ec.ExtendedSignatures {
+			ec.bitArray.SetIndex(i, !ecs.Absent())
+		}
+	}
+	return ec.bitArray
+}
+
+// GetByIndex returns the vote, with its extension, corresponding to a given
+// validator index.
+// Panics if `index >= ec.Size()`.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) GetByIndex(valIdx int32) *Vote {
+	return ec.GetExtendedVote(valIdx)
+}
+
+// IsCommit returns true if there is at least one signature.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) IsCommit() bool {
+	return len(ec.ExtendedSignatures) != 0
+}
+
+// EnsureExtensions checks that all signatures for the block carry a vote
+// extension signature, as required once vote extensions are enabled.
+func (ec *ExtendedCommit) EnsureExtensions() error {
+	for i, ecs := range ec.ExtendedSignatures {
+		if ecs.BlockIDFlag == BlockIDFlagCommit && len(ecs.ExtensionSignature) == 0 {
+			return fmt.Errorf("vote extension signature is missing for signature #%d", i)
+		}
+	}
+	return nil
+}
+
+// VerifyExtensions checks that all signatures for the block carry a vote
+// extension, signed by the respective validator of the given set. It does not
+// check the signatures of the commit itself, see
+// ValidatorSet.VerifyCommitLight.
+func (ec *ExtendedCommit) VerifyExtensions(chainID string, vals *ValidatorSet) error {
+	if vals.Size() != len(ec.ExtendedSignatures) {
+		return NewErrInvalidCommitSignatures(vals.Size(), len(ec.ExtendedSignatures))
+	}
+
+	for idx, ecs := range ec.ExtendedSignatures {
+		if ecs.BlockIDFlag != BlockIDFlagCommit {
+			continue
+		}
+		// The vals and commit have a 1-to-1 correspondence.
+		val := vals.Validators[idx]
+		if err := ec.GetExtendedVote(int32(idx)).VerifyExtension(chainID, val.PubKey); err != nil {
+			return fmt.Errorf("wrong vote extension of signature #%d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation that doesn't involve state data.
+// Does not actually check the cryptographic signatures.
+func (ec *ExtendedCommit) ValidateBasic() error {
+	if ec == nil {
+		return errors.New("nil extended commit")
+	}
+
+	if ec.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if ec.Round < 0 {
+		return errors.New("negative Round")
+	}
+
+	if ec.Height >= 1 {
+		if ec.BlockID.IsZero() {
+			return errors.New("extended commit cannot be for nil block")
+		}
+
+		if len(ec.ExtendedSignatures) == 0 {
+			return errors.New("no signatures in extended commit")
+		}
+		for i, ecs := range ec.ExtendedSignatures {
+			if err := ecs.ValidateBasic(); err != nil {
+				return fmt.Errorf("wrong ExtendedCommitSig #%d: %v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ToProto converts ExtendedCommit to protobuf
+func (ec *ExtendedCommit) ToProto() *cmtproto.ExtendedCommit {
+	if ec == nil {
+		return nil
+	}
+
+	c := new(cmtproto.ExtendedCommit)
+	sigs := make([]cmtproto.ExtendedCommitSig, len(ec.ExtendedSignatures))
+	for i := range ec.ExtendedSignatures {
+		sigs[i] = *ec.ExtendedSignatures[i].ToProto()
+	}
+	c.ExtendedSignatures = sigs
+
+	c.Height = ec.Height
+	c.Round = ec.Round
+	c.BlockID = ec.BlockID.ToProto()
+
+	return c
+}
+
+// ExtendedCommitFromProto constructs an ExtendedCommit from the given
+// protobuf. It returns an error if the extended commit is invalid.
+func ExtendedCommitFromProto(ecp *cmtproto.ExtendedCommit) (*ExtendedCommit, error) {
+	if ecp == nil {
+		return nil, errors.New("nil ExtendedCommit")
+	}
+
+	ec := new(ExtendedCommit)
+
+	bi, err := BlockIDFromProto(&ecp.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]ExtendedCommitSig, len(ecp.ExtendedSignatures))
+	for i := range ecp.ExtendedSignatures {
+		if err := sigs[i].FromProto(ecp.ExtendedSignatures[i]); err != nil {
+			return nil, err
+		}
+	}
+	ec.ExtendedSignatures = sigs
+
+	ec.Height = ecp.Height
+	ec.Round = ecp.Round
+	ec.BlockID = *bi
+
+	return ec, ec.ValidateBasic()
+}
+
 //-----------------------------------------------------------------------------
 
 // Data contains all the Txs in a block.
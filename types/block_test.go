@@ -6,6 +6,7 @@ import (
 	stdbytes "bytes"
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"math"
 	"os"
 	"reflect"
@@ -519,6 +520,73 @@ func TestCommitToVoteSet(t *testing.T) {
 	}
 }
 
+func TestExtendedCommitToVoteSet(t *testing.T) {
+	blockID := makeBlockIDRandom()
+	h := int64(3)
+
+	voteSet, valSet, vals := randVoteSet(h-1, 1, cmtproto.PrecommitType, 10, 1)
+	for i, val := range vals {
+		pubKey, err := val.GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   int32(i),
+			Height:           h - 1,
+			Round:            1,
+			Type:             cmtproto.PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        cmttime.Now(),
+			Extension:        []byte(fmt.Sprintf("extension-%d", i)),
+		}
+		v := vote.ToProto()
+		require.NoError(t, val.SignVote(voteSet.ChainID(), v, true))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		added, err := voteSet.AddVote(vote)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+
+	extCommit := voteSet.MakeExtendedCommit()
+	require.NoError(t, extCommit.ValidateBasic())
+	require.NoError(t, extCommit.EnsureExtensions())
+	require.NoError(t, extCommit.VerifyExtensions(voteSet.ChainID(), valSet))
+	require.Error(t, extCommit.VerifyExtensions("other_chain_id", valSet))
+	assert.Equal(t, voteSet.MakeCommit(), extCommit.ToCommit())
+
+	pb := extCommit.ToProto()
+	extCommit2, err := ExtendedCommitFromProto(pb)
+	require.NoError(t, err)
+
+	voteSet2 := extCommit2.ToExtendedVoteSet(voteSet.ChainID(), valSet)
+	for i := int32(0); int(i) < len(vals); i++ {
+		vote1 := voteSet.GetByIndex(i)
+		vote2 := voteSet2.GetByIndex(i)
+		vote3 := extCommit.GetExtendedVote(i)
+
+		vote1bz, err := vote1.ToProto().Marshal()
+		require.NoError(t, err)
+		vote2bz, err := vote2.ToProto().Marshal()
+		require.NoError(t, err)
+		vote3bz, err := vote3.ToProto().Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, vote1bz, vote2bz)
+		assert.Equal(t, vote1bz, vote3bz)
+		assert.Equal(t, []byte(fmt.Sprintf("extension-%d", i)), vote2.Extension)
+	}
+
+	extCommit.ExtendedSignatures[1].Extension = []byte("modified")
+	require.Error(t, extCommit.VerifyExtensions(voteSet.ChainID(), valSet))
+
+	extCommit.ExtendedSignatures[0].ExtensionSignature = nil
+	require.Error(t, extCommit.EnsureExtensions())
+	require.Error(t, extCommit.VerifyExtensions(voteSet.ChainID(), valSet))
+}
+
+func TestExtendedCommitToCommitEmpty(t *testing.T) {
+	assert.Equal(t, NewCommit(0, 0, BlockID{}, nil), (&ExtendedCommit{}).ToCommit())
+}
+
 func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
 	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
 
@@ -244,7 +244,7 @@ func makeVote(
 	}
 
 	vpb := v.ToProto()
-	err = val.SignVote(chainID, vpb)
+	err = val.SignVote(chainID, vpb, false)
 	if err != nil {
 		panic(err)
 	}
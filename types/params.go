@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/KYVENetwork/celestia-core/crypto/ed25519"
@@ -37,6 +38,8 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `json:"evidence"`
 	Validator ValidatorParams `json:"validator"`
 	Version   VersionParams   `json:"version"`
+	Synchrony SynchronyParams `json:"synchrony"`
+	Feature   FeatureParams   `json:"feature"`
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -64,6 +67,21 @@ type VersionParams struct {
 	App uint64 `json:"app"`
 }
 
+// SynchronyParams bound the clock drift between validators and the delay of
+// proposals, which together determine whether a proposer-based timestamp is
+// timely.
+type SynchronyParams struct {
+	Precision    time.Duration `json:"precision"`
+	MessageDelay time.Duration `json:"message_delay"`
+}
+
+// FeatureParams schedule the heights from which optional consensus features
+// are enabled. A height of 0 leaves the feature disabled.
+type FeatureParams struct {
+	PbtsEnableHeight           int64 `json:"pbts_enable_height"`
+	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -71,6 +89,8 @@ func DefaultConsensusParams() *ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		Synchrony: DefaultSynchronyParams(),
+		Feature:   DefaultFeatureParams(),
 	}
 }
 
@@ -106,6 +126,52 @@ func DefaultVersionParams() VersionParams {
 	}
 }
 
+// DefaultSynchronyParams returns a default SynchronyParams.
+func DefaultSynchronyParams() SynchronyParams {
+	return SynchronyParams{
+		Precision:    505 * time.Millisecond,
+		MessageDelay: 15 * time.Second,
+	}
+}
+
+// DefaultFeatureParams returns a default FeatureParams, which leaves
+// proposer-based timestamps and vote extensions disabled.
+func DefaultFeatureParams() FeatureParams {
+	return FeatureParams{
+		PbtsEnableHeight:           0,
+		VoteExtensionsEnableHeight: 0,
+	}
+}
+
+// PbtsEnabled returns true if the block at the given height uses
+// proposer-based timestamps (PBTS) instead of BFT time.
+func (params ConsensusParams) PbtsEnabled(height int64) bool {
+	return params.Feature.PbtsEnableHeight > 0 && height >= params.Feature.PbtsEnableHeight
+}
+
+// VoteExtensionsEnabled returns true if the precommits of the given height
+// must carry vote extensions.
+func (params ConsensusParams) VoteExtensionsEnabled(height int64) bool {
+	return params.Feature.VoteExtensionsEnableHeight > 0 && height >= params.Feature.VoteExtensionsEnableHeight
+}
+
+// InRound returns the SynchronyParams to use in the given round. The message
+// delay grows by 10% per round so that a chain whose MessageDelay is set too
+// low can still make progress.
+func (sp SynchronyParams) InRound(round int32) SynchronyParams {
+	res := sp
+	delay := float64(sp.MessageDelay)
+	for i := int32(0); i < round; i++ {
+		delay *= 1.1
+		if delay >= float64(math.MaxInt64) {
+			res.MessageDelay = math.MaxInt64
+			return res
+		}
+	}
+	res.MessageDelay = time.Duration(delay)
+	return res
+}
+
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -165,6 +231,63 @@ func (params ConsensusParams) ValidateBasic() error {
 		}
 	}
 
+	if params.Feature.PbtsEnableHeight < 0 {
+		return fmt.Errorf("feature.PbtsEnableHeight must be non negative. Got: %d",
+			params.Feature.PbtsEnableHeight)
+	}
+
+	if params.Feature.VoteExtensionsEnableHeight < 0 {
+		return fmt.Errorf("feature.VoteExtensionsEnableHeight must be non negative. Got: %d",
+			params.Feature.VoteExtensionsEnableHeight)
+	}
+
+	if params.Synchrony.Precision < 0 || params.Synchrony.MessageDelay < 0 {
+		return fmt.Errorf("synchrony params must be non negative. Got precision %v, message delay %v",
+			params.Synchrony.Precision, params.Synchrony.MessageDelay)
+	}
+
+	// Synchrony params are only required once PBTS is scheduled, so that
+	// params predating them remain valid.
+	if params.Feature.PbtsEnableHeight > 0 {
+		if params.Synchrony.Precision <= 0 {
+			return fmt.Errorf("synchrony.Precision must be greater than 0 when PBTS is enabled. Got %v",
+				params.Synchrony.Precision)
+		}
+		if params.Synchrony.MessageDelay <= 0 {
+			return fmt.Errorf("synchrony.MessageDelay must be greater than 0 when PBTS is enabled. Got %v",
+				params.Synchrony.MessageDelay)
+		}
+	}
+
+	return nil
+}
+
+// ValidateUpdate validates the updates returned by the application when
+// committing the block at the given height. Features can only be scheduled for
+// a later height, and their enable height can no longer change once reached.
+func (params ConsensusParams) ValidateUpdate(updates *cmtproto.ConsensusParams, height int64) error {
+	if updates == nil || updates.Feature == nil {
+		return nil
+	}
+
+	if err := validateEnableHeightUpdate("PBTS",
+		params.Feature.PbtsEnableHeight, updates.Feature.PbtsEnableHeight, height); err != nil {
+		return err
+	}
+	return validateEnableHeightUpdate("vote extensions",
+		params.Feature.VoteExtensionsEnableHeight, updates.Feature.VoteExtensionsEnableHeight, height)
+}
+
+func validateEnableHeightUpdate(feature string, current, next, height int64) error {
+	if current == next {
+		return nil
+	}
+	if current > 0 && current <= height {
+		return fmt.Errorf("%s enable height %d was reached and can no longer be changed", feature, current)
+	}
+	if next > 0 && next <= height {
+		return fmt.Errorf("%s enable height %d must be greater than the current height %d", feature, next, height)
+	}
 	return nil
 }
 
@@ -220,6 +343,14 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Version != nil {
 		res.Version.App = params2.Version.App
 	}
+	if params2.Synchrony != nil {
+		res.Synchrony.Precision = params2.Synchrony.Precision
+		res.Synchrony.MessageDelay = params2.Synchrony.MessageDelay
+	}
+	if params2.Feature != nil {
+		res.Feature.PbtsEnableHeight = params2.Feature.PbtsEnableHeight
+		res.Feature.VoteExtensionsEnableHeight = params2.Feature.VoteExtensionsEnableHeight
+	}
 	return res
 }
 
@@ -241,11 +372,19 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Version: &cmtproto.VersionParams{
 			App: params.Version.App,
 		},
+		Synchrony: &cmtproto.SynchronyParams{
+			Precision:    params.Synchrony.Precision,
+			MessageDelay: params.Synchrony.MessageDelay,
+		},
+		Feature: &cmtproto.FeatureParams{
+			PbtsEnableHeight:           params.Feature.PbtsEnableHeight,
+			VoteExtensionsEnableHeight: params.Feature.VoteExtensionsEnableHeight,
+		},
 	}
 }
 
 func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams {
-	return ConsensusParams{
+	c := ConsensusParams{
 		Block: BlockParams{
 			MaxBytes:   pbParams.Block.MaxBytes,
 			MaxGas:     pbParams.Block.MaxGas,
@@ -263,4 +402,19 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			App: pbParams.Version.App,
 		},
 	}
+	// Params stored before PBTS was introduced have no synchrony or feature
+	// params.
+	if pbParams.Synchrony != nil {
+		c.Synchrony = SynchronyParams{
+			Precision:    pbParams.Synchrony.Precision,
+			MessageDelay: pbParams.Synchrony.MessageDelay,
+		}
+	}
+	if pbParams.Feature != nil {
+		c.Feature = FeatureParams{
+			PbtsEnableHeight:           pbParams.Feature.PbtsEnableHeight,
+			VoteExtensionsEnableHeight: pbParams.Feature.VoteExtensionsEnableHeight,
+		}
+	}
+	return c
 }
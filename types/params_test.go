@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"sort"
 	"testing"
 	"time"
@@ -167,3 +168,87 @@ func TestProto(t *testing.T) {
 
 	}
 }
+
+func TestConsensusParamsPBTS(t *testing.T) {
+	params := makeParams(1, 0, 2, 0, valEd25519)
+	assert.False(t, params.PbtsEnabled(1))
+
+	// synchrony params are required once PBTS is scheduled
+	params.Feature.PbtsEnableHeight = 10
+	assert.Error(t, params.ValidateBasic())
+	params.Synchrony = DefaultSynchronyParams()
+	assert.NoError(t, params.ValidateBasic())
+	assert.False(t, params.PbtsEnabled(9))
+	assert.True(t, params.PbtsEnabled(10))
+	assert.True(t, params.PbtsEnabled(11))
+
+	params.Feature.PbtsEnableHeight = -1
+	assert.Error(t, params.ValidateBasic())
+	params.Feature.PbtsEnableHeight = 0
+	params.Synchrony.Precision = -1
+	assert.Error(t, params.ValidateBasic())
+
+	pbParams := DefaultConsensusParams().ToProto()
+	pbParams.Synchrony, pbParams.Feature = nil, nil
+	assert.Equal(t, FeatureParams{}, ConsensusParamsFromProto(pbParams).Feature)
+}
+
+func TestConsensusParamsValidateUpdate(t *testing.T) {
+	feature := func(height int64) *cmtproto.ConsensusParams {
+		return &cmtproto.ConsensusParams{Feature: &cmtproto.FeatureParams{PbtsEnableHeight: height}}
+	}
+	disabled := *DefaultConsensusParams()
+	enabled := *DefaultConsensusParams()
+	enabled.Feature.PbtsEnableHeight = 10
+
+	testCases := []struct {
+		params  ConsensusParams
+		updates *cmtproto.ConsensusParams
+		height  int64
+		valid   bool
+	}{
+		{disabled, nil, 5, true},
+		{disabled, &cmtproto.ConsensusParams{}, 5, true},
+		{disabled, feature(6), 5, true},
+		{disabled, feature(5), 5, false},
+		{enabled, feature(10), 12, true},
+		{enabled, feature(20), 8, true},
+		{enabled, feature(0), 8, true},
+		{enabled, feature(20), 10, false},
+		{enabled, feature(0), 12, false},
+	}
+	extEnabled := *DefaultConsensusParams()
+	extEnabled.Feature.VoteExtensionsEnableHeight = 10
+	extFeature := func(height int64) *cmtproto.ConsensusParams {
+		return &cmtproto.ConsensusParams{Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: height}}
+	}
+	testCases = append(testCases, []struct {
+		params  ConsensusParams
+		updates *cmtproto.ConsensusParams
+		height  int64
+		valid   bool
+	}{
+		{disabled, extFeature(6), 5, true},
+		{disabled, extFeature(5), 5, false},
+		{extEnabled, extFeature(10), 12, true},
+		{extEnabled, extFeature(20), 8, true},
+		{extEnabled, extFeature(20), 10, false},
+		{extEnabled, extFeature(0), 12, false},
+	}...)
+	for i, tc := range testCases {
+		err := tc.params.ValidateUpdate(tc.updates, tc.height)
+		if tc.valid {
+			assert.NoErrorf(t, err, "expected no error (#%d)", i)
+		} else {
+			assert.Errorf(t, err, "expected error (#%d)", i)
+		}
+	}
+}
+
+func TestSynchronyParamsInRound(t *testing.T) {
+	sp := SynchronyParams{Precision: time.Second, MessageDelay: 10 * time.Second}
+	assert.Equal(t, sp, sp.InRound(0))
+	assert.Equal(t, 11*time.Second, sp.InRound(1).MessageDelay)
+	assert.Equal(t, time.Second, sp.InRound(1).Precision)
+	assert.EqualValues(t, math.MaxInt64, sp.InRound(1000).MessageDelay)
+}
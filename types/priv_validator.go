@@ -15,7 +15,10 @@ import (
 type PrivValidator interface {
 	GetPubKey() (crypto.PubKey, error)
 
-	SignVote(chainID string, vote *cmtproto.Vote) error
+	// SignVote signs the vote. If signExtension is true, the vote extension
+	// of a precommit for a block is signed as well, which is only expected
+	// once vote extensions are enabled.
+	SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error
 	SignProposal(chainID string, proposal *cmtproto.Proposal) error
 }
 
@@ -70,7 +73,7 @@ func (pv MockPV) GetPubKey() (crypto.PubKey, error) {
 }
 
 // Implements PrivValidator.
-func (pv MockPV) SignVote(chainID string, vote *cmtproto.Vote) error {
+func (pv MockPV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
 	useChainID := chainID
 	if pv.breakVoteSigning {
 		useChainID = "incorrect-chain-id"
@@ -82,6 +85,14 @@ func (pv MockPV) SignVote(chainID string, vote *cmtproto.Vote) error {
 		return err
 	}
 	vote.Signature = sig
+
+	if signExtension && vote.Type == cmtproto.PrecommitType && len(vote.BlockID.Hash) != 0 {
+		extSig, err := pv.PrivKey.Sign(VoteExtensionSignBytes(useChainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -129,7 +140,7 @@ type ErroringMockPV struct {
 var ErroringMockPVErr = errors.New("erroringMockPV always returns an error")
 
 // Implements PrivValidator.
-func (pv *ErroringMockPV) SignVote(chainID string, vote *cmtproto.Vote) error {
+func (pv *ErroringMockPV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
 	return ErroringMockPVErr
 }
 
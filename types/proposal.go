@@ -79,6 +79,16 @@ func (p *Proposal) ValidateBasic() error {
 	return nil
 }
 
+// IsTimely returns true if a proposal received at recvTime is timely given
+// the synchrony params, i.e. if recvTime lies within
+// [Timestamp - Precision, Timestamp + MessageDelay + Precision]. It is used to
+// decide whether a proposer-based timestamp is acceptable.
+func (p *Proposal) IsTimely(recvTime time.Time, sp SynchronyParams) bool {
+	lhs := p.Timestamp.Add(-sp.Precision)
+	rhs := p.Timestamp.Add(sp.MessageDelay).Add(sp.Precision)
+	return !recvTime.Before(lhs) && !recvTime.After(rhs)
+}
+
 // String returns a string representation of the Proposal.
 //
 // 1. height
@@ -190,3 +190,23 @@ func TestProposalProtoBuf(t *testing.T) {
 		}
 	}
 }
+
+func TestProposalIsTimely(t *testing.T) {
+	ts := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	sp := SynchronyParams{Precision: time.Second, MessageDelay: 2 * time.Second}
+	testCases := []struct {
+		name     string
+		recvTime time.Time
+		timely   bool
+	}{
+		{"at timestamp", ts, true},
+		{"lower bound", ts.Add(-time.Second), true},
+		{"before lower bound", ts.Add(-time.Second - 1), false},
+		{"upper bound", ts.Add(3 * time.Second), true},
+		{"after upper bound", ts.Add(3*time.Second + 1), false},
+	}
+	for _, tc := range testCases {
+		p := &Proposal{Timestamp: ts}
+		assert.Equal(t, tc.timely, p.IsTimely(tc.recvTime, sp), tc.name)
+	}
+}
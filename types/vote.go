@@ -14,6 +14,11 @@ import (
 
 const (
 	nilVoteStr string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of a vote extension. Vote
+	// extensions are gossiped along with the precommits and kept with the
+	// extended commit, so their size is bounded like the signatures.
+	MaxVoteExtensionSize int = 64 * 1024
 )
 
 var (
@@ -21,6 +26,8 @@ var (
 	ErrVoteInvalidValidatorIndex     = errors.New("invalid validator index")
 	ErrVoteInvalidValidatorAddress   = errors.New("invalid validator address")
 	ErrVoteInvalidSignature          = errors.New("invalid signature")
+	ErrVoteInvalidExtensionSignature = errors.New("invalid vote extension signature")
+	ErrVoteExtensionAbsent           = errors.New("vote extension absent")
 	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
 	ErrVoteNil                       = errors.New("nil vote")
@@ -56,6 +63,10 @@ type Vote struct {
 	ValidatorAddress Address                `json:"validator_address"`
 	ValidatorIndex   int32                  `json:"validator_index"`
 	Signature        []byte                 `json:"signature"`
+	// Extension and ExtensionSignature are only set for precommits for a
+	// block, once vote extensions are enabled.
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -82,6 +93,20 @@ func (vote *Vote) CommitSig() CommitSig {
 	}
 }
 
+// ExtendedCommitSig converts the Vote to an ExtendedCommitSig, which also
+// carries the vote extension and its signature.
+func (vote *Vote) ExtendedCommitSig() ExtendedCommitSig {
+	if vote == nil {
+		return NewExtendedCommitSigAbsent()
+	}
+
+	return ExtendedCommitSig{
+		CommitSig:          vote.CommitSig(),
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
+	}
+}
+
 // VoteSignBytes returns the proto-encoding of the canonicalized Vote, for
 // signing. Panics is the marshaling fails.
 //
@@ -100,6 +125,21 @@ func VoteSignBytes(chainID string, vote *cmtproto.Vote) []byte {
 	return bz
 }
 
+// VoteExtensionSignBytes returns the proto-encoding of the canonicalized vote
+// extension, for signing. Panics if the marshaling fails.
+//
+// Similar to VoteSignBytes, the encoded Protobuf message is varint
+// length-prefixed for backwards-compatibility with the Amino encoding.
+func VoteExtensionSignBytes(chainID string, vote *cmtproto.Vote) []byte {
+	pb := CanonicalizeVoteExtension(chainID, vote)
+	bz, err := protoio.MarshalDelimited(&pb)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
@@ -155,6 +195,22 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	return nil
 }
 
+// VerifyExtension checks the signature of the vote extension. Only precommits
+// for a block carry an extension, so any other vote is accepted as is.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey) error {
+	if vote.Type != cmtproto.PrecommitType || vote.BlockID.IsZero() {
+		return nil
+	}
+	if len(vote.ExtensionSignature) == 0 {
+		return ErrVoteExtensionAbsent
+	}
+	v := vote.ToProto()
+	if !pubKey.VerifySignature(VoteExtensionSignBytes(chainID, v), vote.ExtensionSignature) {
+		return ErrVoteInvalidExtensionSignature
+	}
+	return nil
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -198,6 +254,19 @@ func (vote *Vote) ValidateBasic() error {
 		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 	}
 
+	// Whether the extension is required depends on the consensus params, so
+	// it is checked by VerifyExtension.
+	if vote.Type == cmtproto.PrecommitType && !vote.BlockID.IsZero() {
+		if len(vote.Extension) > MaxVoteExtensionSize {
+			return fmt.Errorf("vote extension is too big (max: %d)", MaxVoteExtensionSize)
+		}
+		if len(vote.ExtensionSignature) > MaxSignatureSize {
+			return fmt.Errorf("vote extension signature is too big (max: %d)", MaxSignatureSize)
+		}
+	} else if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+		return errors.New("vote extensions are only allowed for precommits for a block")
+	}
+
 	return nil
 }
 
@@ -209,14 +278,16 @@ func (vote *Vote) ToProto() *cmtproto.Vote {
 	}
 
 	return &cmtproto.Vote{
-		Type:             vote.Type,
-		Height:           vote.Height,
-		Round:            vote.Round,
-		BlockID:          vote.BlockID.ToProto(),
-		Timestamp:        vote.Timestamp,
-		ValidatorAddress: vote.ValidatorAddress,
-		ValidatorIndex:   vote.ValidatorIndex,
-		Signature:        vote.Signature,
+		Type:               vote.Type,
+		Height:             vote.Height,
+		Round:              vote.Round,
+		BlockID:            vote.BlockID.ToProto(),
+		Timestamp:          vote.Timestamp,
+		ValidatorAddress:   vote.ValidatorAddress,
+		ValidatorIndex:     vote.ValidatorIndex,
+		Signature:          vote.Signature,
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
 	}
 }
 
@@ -241,6 +312,8 @@ func VoteFromProto(pv *cmtproto.Vote) (*Vote, error) {
 	vote.ValidatorAddress = pv.ValidatorAddress
 	vote.ValidatorIndex = pv.ValidatorIndex
 	vote.Signature = pv.Signature
+	vote.Extension = pv.Extension
+	vote.ExtensionSignature = pv.ExtensionSignature
 
 	return vote, vote.ValidateBasic()
 }
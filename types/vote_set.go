@@ -635,6 +635,43 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 	return NewCommit(voteSet.GetHeight(), voteSet.GetRound(), *voteSet.maj23, commitSigs)
 }
 
+// MakeExtendedCommit constructs an ExtendedCommit from the VoteSet. Like
+// MakeCommit, it only includes precommits for the block, which has 2/3+
+// majority, and nil, but these also carry their vote extensions.
+//
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) MakeExtendedCommit() *ExtendedCommit {
+	if voteSet.signedMsgType != cmtproto.PrecommitType {
+		panic("Cannot MakeExtendedCommit() unless VoteSet.Type is PrecommitType")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	// Make sure we have a 2/3 majority
+	if voteSet.maj23 == nil {
+		panic("Cannot MakeExtendedCommit() unless a blockhash has +2/3")
+	}
+
+	// For every validator, get the precommit with its extension
+	sigs := make([]ExtendedCommitSig, len(voteSet.votes))
+	for i, v := range voteSet.votes {
+		sig := v.ExtendedCommitSig()
+		// if block ID exists but doesn't match, exclude sig
+		if sig.ForBlock() && !v.BlockID.Equals(*voteSet.maj23) {
+			sig = NewExtendedCommitSigAbsent()
+		}
+		sigs[i] = sig
+	}
+
+	return &ExtendedCommit{
+		Height:             voteSet.GetHeight(),
+		Round:              voteSet.GetRound(),
+		BlockID:            *voteSet.maj23,
+		ExtendedSignatures: sigs,
+	}
+}
+
 //--------------------------------------------------------------------------------
 
 /*
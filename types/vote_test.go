@@ -156,7 +156,7 @@ func TestVoteVerifySignature(t *testing.T) {
 	signBytes := VoteSignBytes("test_chain_id", v)
 
 	// sign it
-	err = privVal.SignVote("test_chain_id", v)
+	err = privVal.SignVote("test_chain_id", v, false)
 	require.NoError(t, err)
 
 	// verify the same vote
@@ -217,6 +217,39 @@ func TestVoteVerify(t *testing.T) {
 	}
 }
 
+func TestVoteVerifyExtension(t *testing.T) {
+	privVal := NewMockPV()
+	pubkey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = pubkey.Address()
+	vote.Extension = []byte("extension")
+	v := vote.ToProto()
+	require.NoError(t, privVal.SignVote("test_chain_id", v, true))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+
+	require.NoError(t, vote.VerifyExtension("test_chain_id", pubkey))
+
+	// the extension is signed separately from the vote
+	valid := pubkey.VerifySignature(VoteExtensionSignBytes("test_chain_id", v), v.ExtensionSignature)
+	require.True(t, valid)
+
+	// a different chain ID invalidates the signature
+	require.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("other_chain_id", pubkey))
+
+	// and so does a modified extension
+	vote.Extension = []byte("modified")
+	require.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("test_chain_id", pubkey))
+
+	vote.ExtensionSignature = nil
+	require.Equal(t, ErrVoteExtensionAbsent, vote.VerifyExtension("test_chain_id", pubkey))
+
+	// prevotes never carry an extension
+	require.NoError(t, examplePrevote().VerifyExtension("test_chain_id", pubkey))
+}
+
 func TestVoteString(t *testing.T) {
 	str := examplePrecommit().String()
 	expected := `Vote{56789:6AF1F4111082 12345/02/SIGNED_MSG_TYPE_PRECOMMIT(Precommit) 8B01023386C3 000000000000 @ 2017-12-25T03:00:01.234Z}` //nolint:lll //ignore line length for tests
@@ -250,13 +283,26 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Extension in precommit", func(v *Vote) { v.Extension = []byte("extension") }, false},
+		{"Too big extension", func(v *Vote) { v.Extension = make([]byte, MaxVoteExtensionSize+1) }, true},
+		{"Too big extension signature", func(v *Vote) {
+			v.ExtensionSignature = make([]byte, MaxSignatureSize+1)
+		}, true},
+		{"Extension in nil precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.Extension = []byte("extension")
+		}, true},
+		{"Extension signature in prevote", func(v *Vote) {
+			v.Type = cmtproto.PrevoteType
+			v.ExtensionSignature = []byte("signature")
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.testName, func(t *testing.T) {
 			vote := examplePrecommit()
 			v := vote.ToProto()
-			err := privVal.SignVote("test_chain_id", v)
+			err := privVal.SignVote("test_chain_id", v, false)
 			vote.Signature = v.Signature
 			require.NoError(t, err)
 			tc.malleateVote(vote)
@@ -269,7 +315,7 @@ func TestVoteProtobuf(t *testing.T) {
 	privVal := NewMockPV()
 	vote := examplePrecommit()
 	v := vote.ToProto()
-	err := privVal.SignVote("test_chain_id", v)
+	err := privVal.SignVote("test_chain_id", v, false)
 	vote.Signature = v.Signature
 	require.NoError(t, err)
 